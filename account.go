@@ -0,0 +1,177 @@
+package stripe
+
+import "net/url"
+
+// Connected Account Types
+const (
+	AccountCustom   = "custom"
+	AccountExpress  = "express"
+	AccountStandard = "standard"
+)
+
+// Account represents a Stripe account connected to your platform through
+// Connect.
+//
+// see https://stripe.com/docs/api#account_object
+type Account struct {
+	APIResource
+
+	ID               string               `json:"id"`
+	Type             string               `json:"type"`
+	BusinessType     string               `json:"business_type,omitempty"`
+	BusinessProfile  *BusinessProfile     `json:"business_profile,omitempty"`
+	Capabilities     map[string]string    `json:"capabilities,omitempty"`
+	ChargesEnabled   bool                 `json:"charges_enabled"`
+	PayoutsEnabled   bool                 `json:"payouts_enabled"`
+	DetailsSubmitted bool                 `json:"details_submitted"`
+	Country          string               `json:"country"`
+	DefaultCurrency  Currency             `json:"default_currency"`
+	Email            string               `json:"email,omitempty"`
+	Created          UnixTime             `json:"created"`
+	Requirements     *AccountRequirements `json:"requirements,omitempty"`
+	TOSAcceptance    *TOSAcceptance       `json:"tos_acceptance,omitempty"`
+	Metadata         map[string]string    `json:"metadata,omitempty"`
+}
+
+// BusinessProfile holds the publicly visible details about a connected
+// account's business.
+type BusinessProfile struct {
+	MCC                string `json:"mcc,omitempty" form:"mcc"`
+	Name               string `json:"name,omitempty" form:"name"`
+	ProductDescription string `json:"product_description,omitempty" form:"product_description"`
+	SupportEmail       string `json:"support_email,omitempty" form:"support_email"`
+	SupportPhone       string `json:"support_phone,omitempty" form:"support_phone"`
+	SupportURL         string `json:"support_url,omitempty" form:"support_url"`
+	URL                string `json:"url,omitempty" form:"url"`
+}
+
+// AccountRequirements describes the information that must be collected for a
+// connected account to remain enabled.
+type AccountRequirements struct {
+	CurrentDeadline     *UnixTime          `json:"current_deadline,omitempty"`
+	CurrentlyDue        []string           `json:"currently_due"`
+	EventuallyDue       []string           `json:"eventually_due"`
+	PastDue             []string           `json:"past_due"`
+	PendingVerification []string           `json:"pending_verification"`
+	DisabledReason      string             `json:"disabled_reason,omitempty"`
+	Errors              []RequirementError `json:"errors,omitempty"`
+}
+
+// RequirementError explains why a piece of submitted information failed
+// verification.
+type RequirementError struct {
+	Code        string `json:"code"`
+	Reason      string `json:"reason"`
+	Requirement string `json:"requirement"`
+}
+
+// TOSAcceptance records when and how the account holder accepted Stripe's
+// Services Agreement.
+type TOSAcceptance struct {
+	Date             *UnixTime `json:"date,omitempty" form:"date"`
+	IP               string    `json:"ip,omitempty" form:"ip"`
+	UserAgent        string    `json:"user_agent,omitempty" form:"user_agent"`
+	ServiceAgreement string    `json:"service_agreement,omitempty" form:"service_agreement"`
+}
+
+// AccountParams encapsulates options for creating and updating connected
+// Accounts.
+type AccountParams struct {
+	Params
+
+	// The type of account to create: custom, express or standard. Can only be
+	// set on creation.
+	Type string `form:"type"`
+
+	// (Optional) The country in which the account holder resides.
+	Country *string `form:"country"`
+
+	// (Optional) The email address of the account holder.
+	Email *string `form:"email"`
+
+	// (Optional) The business type: individual, company, non_profit or
+	// government_entity.
+	BusinessType *string `form:"business_type"`
+
+	// (Optional) Three-letter ISO currency code representing the default
+	// currency for the account.
+	DefaultCurrency Currency `form:"default_currency"`
+
+	// (Optional) Business information about the account.
+	BusinessProfile *BusinessProfile `form:"business_profile"`
+
+	// (Optional) Capabilities to request or un-request, keyed by capability
+	// name, e.g. "card_payments" or "transfers".
+	Capabilities map[string]*CapabilityParams `form:"capabilities"`
+
+	// (Optional) Details on the account holder's acceptance of the Stripe
+	// Services Agreement.
+	TOSAcceptance *TOSAcceptance `form:"tos_acceptance"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// AccountClient encapsulates operations for creating, updating, deleting and
+// querying connected accounts using the Stripe REST API.
+type AccountClient struct{ scope }
+
+// With returns an AccountClient configured with the given options.
+func (c AccountClient) With(opts ...ClientOption) AccountClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new connected Account.
+//
+// see https://stripe.com/docs/api#create_account
+func (c AccountClient) Create(params *AccountParams) (*Account, error) {
+	res := &Account{}
+	return res, c.query("POST", "/accounts", formValues(params), res)
+}
+
+// Retrieves the details of the connected Account with the given ID.
+//
+// see https://stripe.com/docs/api#retrieve_account
+func (c AccountClient) Get(id string) (*Account, error) {
+	res := &Account{}
+	return res, c.query("GET", "/accounts/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the connected Account with the given ID.
+//
+// see https://stripe.com/docs/api#update_account
+func (c AccountClient) Update(id string, params *AccountParams) (*Account, error) {
+	res := &Account{}
+	return res, c.query("POST", "/accounts/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Deletes the connected Account with the given ID. Only Custom and Express
+// accounts, and accounts in test mode, can be deleted.
+//
+// see https://stripe.com/docs/api#delete_account
+func (c AccountClient) Delete(id string) (*Deleted, error) {
+	res := &Deleted{}
+	return res, c.query("DELETE", "/accounts/"+url.QueryEscape(id), nil, res)
+}
+
+// Rejects the connected Account with the given ID, for the reason fraud,
+// terms_of_service or other.
+//
+// see https://stripe.com/docs/api#reject_account
+func (c AccountClient) Reject(id, reason string) (*Account, error) {
+	values := url.Values{"reason": {reason}}
+	res := &Account{}
+	return res, c.query("POST", "/accounts/"+url.QueryEscape(id)+"/reject", values, res)
+}
+
+// Returns a list of the Accounts connected to your platform.
+//
+// see https://stripe.com/docs/api#list_accounts
+func (c AccountClient) List(limit int, before, after string) ([]*Account, bool, error) {
+	res := struct {
+		ListObject
+		Data []*Account
+	}{}
+	err := c.query("GET", "/accounts", listParams(limit, before, after), &res)
+	return res.Data, res.More, err
+}
@@ -0,0 +1,63 @@
+package stripe
+
+import ()
+
+// Account Link Types
+const (
+	AccountLinkOnboarding = "account_onboarding"
+	AccountLinkUpdate     = "account_update"
+)
+
+// AccountLink is a single-use URL that sends a connected account through a
+// Stripe-hosted onboarding or update flow.
+//
+// see https://stripe.com/docs/api#account_link_object
+type AccountLink struct {
+	APIResource
+
+	URL       string   `json:"url"`
+	Created   UnixTime `json:"created"`
+	ExpiresAt UnixTime `json:"expires_at"`
+}
+
+// AccountLinkParams encapsulates options for creating a new Account Link.
+type AccountLinkParams struct {
+	Params
+
+	// The ID of the connected account the link is for.
+	Account string `form:"account"`
+
+	// The URL the user will be redirected to if the link has expired or is
+	// otherwise invalid. Your handler should create a new link and redirect
+	// the user to it.
+	RefreshURL string `form:"refresh_url"`
+
+	// The URL the user will be redirected to upon leaving or completing the
+	// linked flow.
+	ReturnURL string `form:"return_url"`
+
+	// The type of link: account_onboarding or account_update.
+	Type string `form:"type"`
+
+	// (Optional) Which requirements to collect: currently_due (the default)
+	// or eventually_due.
+	Collect *string `form:"collect"`
+}
+
+// AccountLinkClient encapsulates operations for creating account links using
+// the Stripe REST API.
+type AccountLinkClient struct{ scope }
+
+// With returns an AccountLinkClient configured with the given options.
+func (c AccountLinkClient) With(opts ...ClientOption) AccountLinkClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Account Link.
+//
+// see https://stripe.com/docs/api#create_account_link
+func (c AccountLinkClient) Create(params *AccountLinkParams) (*AccountLink, error) {
+	res := &AccountLink{}
+	return res, c.query("POST", "/account_links", formValues(params), res)
+}
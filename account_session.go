@@ -0,0 +1,83 @@
+package stripe
+
+import ()
+
+// Connect Embedded Components
+const (
+	ComponentAccountManagement  = "account_management"
+	ComponentAccountOnboarding  = "account_onboarding"
+	ComponentBalances           = "balances"
+	ComponentDocuments          = "documents"
+	ComponentNotificationBanner = "notification_banner"
+	ComponentPaymentDetails     = "payment_details"
+	ComponentPayments           = "payments"
+	ComponentPayouts            = "payouts"
+	ComponentPayoutsList        = "payouts_list"
+	ComponentTaxRegistrations   = "tax_registrations"
+	ComponentTaxSettings        = "tax_settings"
+)
+
+// AccountSession grants a client-side Connect embedded component temporary
+// access to a connected account.
+//
+// see https://stripe.com/docs/api#account_session_object
+type AccountSession struct {
+	APIResource
+
+	Account      string   `json:"account"`
+	ClientSecret string   `json:"client_secret"`
+	ExpiresAt    UnixTime `json:"expires_at"`
+	Livemode     bool     `json:"livemode"`
+
+	// The configuration of each component, keyed by component name.
+	Components map[string]AccountSessionComponent `json:"components,omitempty"`
+}
+
+// AccountSessionComponent describes whether an embedded component is enabled
+// and which of its features are available.
+type AccountSessionComponent struct {
+	Enabled  bool            `json:"enabled"`
+	Features map[string]bool `json:"features,omitempty"`
+}
+
+// AccountSessionParams encapsulates options for creating a new Account
+// Session.
+type AccountSessionParams struct {
+	Params
+
+	// The ID of the connected account the session is for.
+	Account string `form:"account"`
+
+	// The embedded components to enable or disable, keyed by component name,
+	// e.g. "account_onboarding".
+	Components map[string]*AccountSessionComponentParams `form:"components"`
+}
+
+// AccountSessionComponentParams enables or disables an embedded component of
+// an Account Session.
+type AccountSessionComponentParams struct {
+	// Whether the component is enabled.
+	Enabled *bool `form:"enabled"`
+
+	// (Optional) Features of the component to turn on or off, keyed by
+	// feature name, e.g. "refund_management".
+	Features map[string]bool `form:"features"`
+}
+
+// AccountSessionClient encapsulates operations for creating account sessions
+// using the Stripe REST API.
+type AccountSessionClient struct{ scope }
+
+// With returns an AccountSessionClient configured with the given options.
+func (c AccountSessionClient) With(opts ...ClientOption) AccountSessionClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Account Session.
+//
+// see https://stripe.com/docs/api#create_account_session
+func (c AccountSessionClient) Create(params *AccountSessionParams) (*AccountSession, error) {
+	res := &AccountSession{}
+	return res, c.query("POST", "/account_sessions", formValues(params), res)
+}
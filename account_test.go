@@ -0,0 +1,204 @@
+package stripe
+
+import (
+	"testing"
+)
+
+// Sample Accounts to use when creating, deleting, updating Account data.
+var (
+	// Custom account with the transfers capability requested
+	acct1 = AccountParams{
+		Type:    AccountCustom,
+		Country: String("US"),
+		Email:   String("kramer@vandelay.com"),
+		BusinessProfile: &BusinessProfile{
+			Name: "Kramerica Industries",
+		},
+		Capabilities: map[string]*CapabilityParams{
+			"card_payments": {Requested: Bool(true)},
+			"transfers":     {Requested: Bool(true)},
+		},
+	}
+)
+
+// TestCreateAccount will test that we can successfully Create a connected
+// Account, parse the JSON reponse from Stripe, and that all values are
+// populated as expected.
+func TestCreateAccount(t *testing.T) {
+	acct, err := Accounts.Create(&acct1)
+	if err != nil {
+		t.Errorf("Expected Account, got Error %s", err.Error())
+		return
+	}
+	defer Accounts.Delete(acct.ID)
+
+	if acct.Type != AccountCustom {
+		t.Errorf("Expected Account Type %s, got %s", AccountCustom, acct.Type)
+	}
+	if acct.Email != *acct1.Email {
+		t.Errorf("Expected Account Email %s, got %s", *acct1.Email, acct.Email)
+	}
+	if acct.BusinessProfile == nil || acct.BusinessProfile.Name != acct1.BusinessProfile.Name {
+		t.Errorf("Expected Account Business Name %s", acct1.BusinessProfile.Name)
+	}
+	if _, ok := acct.Capabilities["transfers"]; !ok {
+		t.Errorf("Expected transfers Capability to be requested")
+	}
+	if acct.Requirements == nil || len(acct.Requirements.CurrentlyDue) == 0 {
+		t.Errorf("Expected new Custom Account to have requirements currently due")
+	}
+}
+
+// TestUpdateAccount will test that we can successfully update a connected
+// Account and verify the updated email was returned.
+func TestUpdateAccount(t *testing.T) {
+	resp, err := Accounts.Create(&acct1)
+	if err != nil {
+		t.Errorf("Expected Account, got Error %s", err.Error())
+		return
+	}
+	defer Accounts.Delete(resp.ID)
+
+	acct, err := Accounts.Update(resp.ID, &AccountParams{Email: String("art@vandelay.com")})
+	if err != nil {
+		t.Errorf("Expected Account update, got Error %s", err.Error())
+	}
+	if acct.Email != "art@vandelay.com" {
+		t.Errorf("Expected Updated Account Email")
+	}
+}
+
+// TestDeleteAccount will test that we can successfully remove a connected
+// Account.
+func TestDeleteAccount(t *testing.T) {
+	resp, err := Accounts.Create(&acct1)
+	if err != nil {
+		t.Errorf("Expected Account, got Error %s", err.Error())
+		return
+	}
+
+	deleted, err := Accounts.Delete(resp.ID)
+	if err != nil {
+		t.Errorf("Expected Account deletion, got Error %s", err.Error())
+	}
+	if !deleted.Deleted {
+		t.Errorf("Expected Account deleted true, got false")
+	}
+}
+
+// TestCreateAccountLink will test that we can create an onboarding link for a
+// newly created connected Account.
+func TestCreateAccountLink(t *testing.T) {
+	acct, err := Accounts.Create(&AccountParams{Type: AccountExpress})
+	if err != nil {
+		t.Errorf("Expected Account, got Error %s", err.Error())
+		return
+	}
+	defer Accounts.Delete(acct.ID)
+
+	link, err := AccountLinks.Create(&AccountLinkParams{
+		Account:    acct.ID,
+		RefreshURL: "https://example.com/reauth",
+		ReturnURL:  "https://example.com/return",
+		Type:       AccountLinkOnboarding,
+	})
+	if err != nil {
+		t.Errorf("Expected Account Link, got Error %s", err.Error())
+		return
+	}
+	if link.URL == "" {
+		t.Errorf("Expected Account Link URL, got empty string")
+	}
+}
+
+// TestCreatePerson will test that we can add a representative to a connected
+// Account and list it back.
+func TestCreatePerson(t *testing.T) {
+	acct, err := Accounts.Create(&AccountParams{Type: AccountCustom, Country: String("US"), BusinessType: String("company")})
+	if err != nil {
+		t.Errorf("Expected Account, got Error %s", err.Error())
+		return
+	}
+	defer Accounts.Delete(acct.ID)
+
+	representative := true
+	person, err := Persons.Create(acct.ID, &PersonParams{
+		FirstName:    String("Cosmo"),
+		LastName:     String("Kramer"),
+		DOB:          &DOB{Day: 1, Month: 1, Year: 1955},
+		Relationship: &RelationshipParams{Representative: &representative, Title: "CEO"},
+	})
+	if err != nil {
+		t.Errorf("Expected Person, got Error %s", err.Error())
+		return
+	}
+	if person.Relationship == nil || !person.Relationship.Representative {
+		t.Errorf("Expected Person to be the account representative")
+	}
+
+	persons, _, err := Persons.List(acct.ID, 10, "", "")
+	if err != nil {
+		t.Errorf("Expected Person List, got Error %s", err.Error())
+	}
+	if len(persons) != 1 {
+		t.Errorf("Expected 1 Person, got %d", len(persons))
+	}
+}
+
+// TestUpdateCapability will test that we can request a Capability for a
+// connected Account and that its requirements are reported.
+func TestUpdateCapability(t *testing.T) {
+	acct, err := Accounts.Create(&AccountParams{Type: AccountCustom, Country: String("US")})
+	if err != nil {
+		t.Errorf("Expected Account, got Error %s", err.Error())
+		return
+	}
+	defer Accounts.Delete(acct.ID)
+
+	capability, err := Capabilities.Update(acct.ID, "transfers", true)
+	if err != nil {
+		t.Errorf("Expected Capability, got Error %s", err.Error())
+		return
+	}
+	if !capability.Requested {
+		t.Errorf("Expected Capability to be requested")
+	}
+	if capability.Requirements == nil || len(capability.Requirements.CurrentlyDue) == 0 {
+		t.Errorf("Expected Capability to have requirements currently due")
+	}
+}
+
+// TestCreateExternalAccount will test that we can attach a bank account to a
+// connected Account as its default payout destination.
+func TestCreateExternalAccount(t *testing.T) {
+	acct, err := Accounts.Create(&AccountParams{Type: AccountCustom, Country: String("US")})
+	if err != nil {
+		t.Errorf("Expected Account, got Error %s", err.Error())
+		return
+	}
+	defer Accounts.Delete(acct.ID)
+
+	ext, err := ExternalAccounts.Create(acct.ID, &ExternalAccountParams{
+		BankAccount: &BankAccountParams{
+			Object:        ExternalAccountBank,
+			Country:       "US",
+			Currency:      USD,
+			RoutingNumber: String("110000000"),
+			AccountNumber: "000123456789",
+		},
+	})
+	if err != nil {
+		t.Errorf("Expected External Account, got Error %s", err.Error())
+		return
+	}
+	if ext.BankAccount == nil {
+		t.Errorf("Expected Bank Account, got %s", ext.Object)
+		return
+	}
+	if ext.BankAccount.Last4 != "6789" {
+		t.Errorf("Expected Bank Account Last4 6789, got %s", ext.BankAccount.Last4)
+	}
+	if !ext.BankAccount.DefaultForCurrency {
+		t.Errorf("Expected first Bank Account to be default for currency")
+	}
+}
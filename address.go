@@ -0,0 +1,32 @@
+package stripe
+
+import ()
+
+// Address is a postal address.
+type Address struct {
+	Line1      string `json:"line1,omitempty" form:"line1"`
+	Line2      string `json:"line2,omitempty" form:"line2"`
+	City       string `json:"city,omitempty" form:"city"`
+	State      string `json:"state,omitempty" form:"state"`
+	PostalCode string `json:"postal_code,omitempty" form:"postal_code"`
+	Country    string `json:"country,omitempty" form:"country"`
+}
+
+// ShippingDetails describes who and where an order, such as the goods paid
+// for by a Charge, is shipped to.
+type ShippingDetails struct {
+	Name           string   `json:"name" form:"name"`
+	Address        *Address `json:"address" form:"address"`
+	Phone          string   `json:"phone,omitempty" form:"phone"`
+	Carrier        string   `json:"carrier,omitempty" form:"carrier"`
+	TrackingNumber string   `json:"tracking_number,omitempty" form:"tracking_number"`
+}
+
+// BillingDetails describes the customer a payment method belongs to, as
+// collected when it was attached or charged.
+type BillingDetails struct {
+	Name    string   `json:"name,omitempty" form:"name"`
+	Email   string   `json:"email,omitempty" form:"email"`
+	Phone   string   `json:"phone,omitempty" form:"phone"`
+	Address *Address `json:"address,omitempty" form:"address"`
+}
@@ -0,0 +1,104 @@
+package stripe
+
+import "encoding/json"
+
+// decodeAliases decodes the fields of the JSON object in data that later API
+// versions renamed into the destinations given for their new names, so a
+// struct tagged with the legacy names is populated whichever name the API
+// returned. Fields that are absent or null are left as the legacy field was
+// decoded.
+func decodeAliases(data []byte, aliases map[string]interface{}) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name, dst := range aliases {
+		raw, ok := fields[name]
+		if !ok || string(raw) == "null" {
+			continue
+		}
+		if err := json.Unmarshal(raw, dst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// aliaser is implemented by objects that decode renamed fields with
+// decodeAliases, returning the destinations of the new names.
+type aliaser interface {
+	aliases() map[string]interface{}
+}
+
+// UnmarshalJSON decodes the Customer, accepting the names later API versions
+// gave its fields: balance for account_balance, default_source for
+// default_card, and sources for cards.
+func (c *Customer) UnmarshalJSON(data []byte) error {
+	type customer Customer
+	if err := json.Unmarshal(data, (*customer)(c)); err != nil {
+		return err
+	}
+	return decodeAliases(data, c.aliases())
+}
+
+func (c *Customer) aliases() map[string]interface{} {
+	return map[string]interface{}{
+		"balance":        &c.Balance,
+		"default_source": &c.DefaultCard,
+		"sources":        &c.Cards,
+	}
+}
+
+// UnmarshalJSON decodes the Plan, accepting the names later API versions gave
+// its fields: nickname for name, and statement_descriptor for
+// statement_description.
+func (p *Plan) UnmarshalJSON(data []byte) error {
+	type plan Plan
+	if err := json.Unmarshal(data, (*plan)(p)); err != nil {
+		return err
+	}
+	return decodeAliases(data, p.aliases())
+}
+
+func (p *Plan) aliases() map[string]interface{} {
+	return map[string]interface{}{
+		"nickname":             &p.Name,
+		"statement_descriptor": &p.StatementDescription,
+	}
+}
+
+// TaxRate is a tax applied to Subscriptions and Invoices, which later API
+// versions use in place of a single tax percentage.
+//
+// see https://stripe.com/docs/api#tax_rates
+type TaxRate struct {
+	ID           string  `json:"id"`
+	Active       bool    `json:"active"`
+	DisplayName  string  `json:"display_name"`
+	Inclusive    bool    `json:"inclusive"`
+	Jurisdiction string  `json:"jurisdiction,omitempty"`
+	Percentage   float64 `json:"percentage"`
+}
+
+// taxRates returns the rates, or a single rate of percent if there are none,
+// as API versions before tax rates returned.
+func taxRates(rates []*TaxRate, percent *float64) []*TaxRate {
+	if len(rates) == 0 && percent != nil {
+		return []*TaxRate{{Active: true, Percentage: *percent}}
+	}
+	return rates
+}
+
+// TaxRates returns the tax rates applied to the Subscription. For API
+// versions that return a tax percentage instead, it is returned as a single
+// exclusive rate without an ID.
+func (s *Subscription) TaxRates() []*TaxRate {
+	return taxRates(s.DefaultTaxRates, s.TaxPercent)
+}
+
+// TaxRates returns the tax rates applied to the Invoice. For API versions
+// that return a tax percentage instead, it is returned as a single exclusive
+// rate without an ID.
+func (inv *Invoice) TaxRates() []*TaxRate {
+	return taxRates(inv.DefaultTaxRates, inv.TaxPercent)
+}
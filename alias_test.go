@@ -0,0 +1,40 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestFieldAliases will test that renamed fields are decoded whichever name
+// the API returned them under.
+func TestFieldAliases(t *testing.T) {
+	for _, data := range []string{
+		`{"id":"cus_1","account_balance":-500,"default_card":"card_1","cards":{"data":[{"id":"card_1"}]}}`,
+		`{"id":"cus_1","balance":-500,"default_source":"card_1","sources":{"data":[{"id":"card_1"}]}}`,
+	} {
+		cust := &Customer{}
+		if err := json.Unmarshal([]byte(data), cust); err != nil {
+			t.Fatal(err)
+		}
+		if cust.Balance != -500 || cust.DefaultCard != "card_1" || cust.Cards == nil || len(cust.Cards.Data) != 1 {
+			t.Errorf("Expected the balance and card from %s, got %+v", data, cust)
+		}
+	}
+
+	plan := &Plan{}
+	json.Unmarshal([]byte(`{"id":"gold","nickname":"Gold","statement_descriptor":"GOLD PLAN"}`), plan)
+	if plan.Name != "Gold" || plan.StatementDescription != "GOLD PLAN" {
+		t.Errorf("Expected the plan's nickname and descriptor, got %+v", plan)
+	}
+
+	sub := &Subscription{}
+	json.Unmarshal([]byte(`{"id":"sub_1","tax_percent":8.25}`), sub)
+	if rates := sub.TaxRates(); len(rates) != 1 || rates[0].Percentage != 8.25 {
+		t.Errorf("Expected the tax percentage as a rate, got %v", rates)
+	}
+	inv := &Invoice{}
+	json.Unmarshal([]byte(`{"id":"in_1","default_tax_rates":[{"id":"txr_1","percentage":20,"inclusive":true}]}`), inv)
+	if rates := inv.TaxRates(); len(rates) != 1 || rates[0].ID != "txr_1" || !rates[0].Inclusive {
+		t.Errorf("Expected the invoice's tax rates, got %v", rates)
+	}
+}
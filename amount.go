@@ -0,0 +1,143 @@
+package stripe
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	// InvalidDecimalAmountError is returned by ParseAmount for a string that
+	// is not a decimal amount, or that has more decimal places than the
+	// currency.
+	InvalidDecimalAmountError = errors.New("stripe: amount must be a decimal number with no more decimal places than the currency")
+
+	// AmountBelowMinimumError is returned by ValidateAmount for an amount
+	// below the smallest Stripe charges in the currency.
+	AmountBelowMinimumError = errors.New("stripe: amount is below the minimum charge amount of the currency")
+)
+
+// the currencies whose amounts are in whole units rather than cents, and
+// those whose smallest unit is a thousandth
+var (
+	zeroDecimalCurrencies = map[Currency]bool{
+		BIF: true, CLP: true, DJF: true, GNF: true, JPY: true, KMF: true,
+		KRW: true, MGA: true, PYG: true, RWF: true, UGX: true, VND: true,
+		VUV: true, XAF: true, XOF: true, XPF: true,
+	}
+	threeDecimalCurrencies = map[Currency]bool{
+		BHD: true, JOD: true, KWD: true, OMR: true, TND: true,
+	}
+)
+
+// the symbols amounts are formatted with; other currencies are formatted
+// with their code
+var currencySymbols = map[Currency]string{
+	AUD: "A$", BRL: "R$", CAD: "CA$", CNY: "CN¥", EUR: "€", GBP: "£",
+	HKD: "HK$", INR: "₹", JPY: "¥", KRW: "₩", MXN: "MX$", NZD: "NZ$",
+	USD: "$",
+}
+
+// the smallest amounts Stripe charges in each currency, in its smallest
+// unit; charges in other currencies must be worth at least 50 US cents
+//
+// see https://stripe.com/docs/currencies#minimum-and-maximum-charge-amounts
+var minimumAmounts = map[Currency]int64{
+	USD: 50, AED: 200, AUD: 50, BGN: 100, BRL: 50, CAD: 50, CHF: 50,
+	CZK: 1500, DKK: 250, EUR: 50, GBP: 30, HKD: 400, HUF: 17500, INR: 50,
+	JPY: 50, MXN: 1000, MYR: 200, NOK: 300, NZD: 50, PLN: 200, RON: 200,
+	SEK: 300, SGD: 50, THB: 1000,
+}
+
+// Decimals returns the number of decimal places of the currency, which
+// amounts in its smallest unit are divided by: 0 for zero-decimal currencies
+// such as JPY, 3 for currencies such as BHD, and 2 for all others.
+func (c Currency) Decimals() int {
+	switch {
+	case zeroDecimalCurrencies[c]:
+		return 0
+	case threeDecimalCurrencies[c]:
+		return 3
+	}
+	return 2
+}
+
+// Decimal returns the amount, in the smallest unit of the currency, as a
+// decimal number of the currency's units, e.g. "12.34" for 1234 USD or "1234"
+// for 1234 JPY.
+func (c Currency) Decimal(amount int64) string {
+	s := strconv.FormatInt(amount, 10)
+	sign := ""
+	if amount < 0 {
+		sign, s = "-", s[1:]
+	}
+	decimals := c.Decimals()
+	if decimals == 0 {
+		return sign + s
+	}
+	if len(s) <= decimals {
+		s = strings.Repeat("0", decimals-len(s)+1) + s
+	}
+	return sign + s[:len(s)-decimals] + "." + s[len(s)-decimals:]
+}
+
+// ParseAmount returns the amount in the smallest unit of the currency of a
+// decimal number of its units, e.g. 1234 for "12.34" USD or 1234 for "1234"
+// JPY. It returns InvalidDecimalAmountError if s is not a decimal number or
+// has more decimal places than the currency.
+func (c Currency) ParseAmount(s string) (int64, error) {
+	units, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		units, fraction = s[:i], s[i+1:]
+	}
+	decimals := c.Decimals()
+	if len(fraction) > decimals || strings.ContainsAny(fraction, "+-") {
+		return 0, InvalidDecimalAmountError
+	}
+	amount, err := strconv.ParseInt(units+fraction+strings.Repeat("0", decimals-len(fraction)), 10, 64)
+	if err != nil || units == "" || units == "-" || units == "+" {
+		return 0, InvalidDecimalAmountError
+	}
+	return amount, nil
+}
+
+// Format returns the amount, in the smallest unit of the currency, for
+// display with the currency's symbol, e.g. "$12.34", "-€5.00" or "¥1234", or
+// its code where it has no commonly used symbol, e.g. "CHF 12.34".
+func (c Currency) Format(amount int64) string {
+	decimal := c.Decimal(amount)
+	sign := ""
+	if amount < 0 {
+		sign, decimal = "-", decimal[1:]
+	}
+	if symbol, ok := currencySymbols[c]; ok {
+		return sign + symbol + decimal
+	}
+	return sign + strings.ToUpper(string(c)) + " " + decimal
+}
+
+// MinimumAmount returns the smallest amount Stripe charges in the currency,
+// in its smallest unit, and whether it is known: charges in other currencies
+// must be worth at least 50 US cents once converted to the account's
+// settlement currency.
+func (c Currency) MinimumAmount() (int64, bool) {
+	amount, ok := minimumAmounts[c]
+	return amount, ok
+}
+
+// ValidateAmount checks that Stripe will charge the amount, in the smallest
+// unit of the currency, returning InvalidCurrencyError for a currency Stripe
+// does not support, InvalidAmountError for an amount outside the range
+// Stripe accepts, or AmountBelowMinimumError for an amount below the
+// currency's minimum.
+func (c Currency) ValidateAmount(amount int64) error {
+	switch min, ok := c.MinimumAmount(); {
+	case !c.Supported():
+		return InvalidCurrencyError
+	case amount <= 0 || amount > maxAmount:
+		return InvalidAmountError
+	case ok && amount < min:
+		return AmountBelowMinimumError
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package stripe
+
+import "testing"
+
+// TestCurrencyAmounts will test that amounts are converted between the
+// smallest unit of a currency and decimals with the currency's number of
+// decimal places, and formatted with its symbol or code.
+func TestCurrencyAmounts(t *testing.T) {
+	tests := []struct {
+		currency Currency
+		amount   int64
+		decimal  string
+		format   string
+	}{
+		{USD, 1234, "12.34", "$12.34"},
+		{USD, 5, "0.05", "$0.05"},
+		{EUR, -500, "-5.00", "-€5.00"},
+		{JPY, 1234, "1234", "¥1234"},
+		{KRW, 0, "0", "₩0"},
+		{BHD, 1234, "1.234", "BHD 1.234"},
+		{KWD, 7, "0.007", "KWD 0.007"},
+		{CHF, 1234, "12.34", "CHF 12.34"},
+	}
+	for _, test := range tests {
+		if decimal := test.currency.Decimal(test.amount); decimal != test.decimal {
+			t.Errorf("Expected %d %s as %q, got %q", test.amount, test.currency, test.decimal, decimal)
+		}
+		if format := test.currency.Format(test.amount); format != test.format {
+			t.Errorf("Expected %d %s formatted as %q, got %q", test.amount, test.currency, test.format, format)
+		}
+		if amount, err := test.currency.ParseAmount(test.decimal); err != nil || amount != test.amount {
+			t.Errorf("Expected %q %s to parse as %d, got %d, %v", test.decimal, test.currency, test.amount, amount, err)
+		}
+	}
+
+	if amount, err := USD.ParseAmount("12.3"); err != nil || amount != 1230 {
+		t.Errorf("Expected 12.3 USD to parse as 1230, got %d, %v", amount, err)
+	}
+	for _, s := range []string{"", ".", "12.345", "1.-5", "+.5", "twelve", "1,234"} {
+		if _, err := USD.ParseAmount(s); err != InvalidDecimalAmountError {
+			t.Errorf("Expected %q to fail with InvalidDecimalAmountError, got %v", s, err)
+		}
+	}
+	if _, err := JPY.ParseAmount("12.5"); err != InvalidDecimalAmountError {
+		t.Errorf("Expected a fraction of a yen to fail with InvalidDecimalAmountError, got %v", err)
+	}
+}
+
+// TestCurrencyValidateAmount will test that amounts below the minimum charge
+// amount of their currency are rejected.
+func TestCurrencyValidateAmount(t *testing.T) {
+	if err := USD.ValidateAmount(50); err != nil {
+		t.Errorf("Expected 50 US cents to be valid, got %v", err)
+	}
+	if err := USD.ValidateAmount(49); err != AmountBelowMinimumError {
+		t.Errorf("Expected 49 US cents to fail with AmountBelowMinimumError, got %v", err)
+	}
+	if err := HUF.ValidateAmount(10000); err != AmountBelowMinimumError {
+		t.Errorf("Expected 100 forints to fail with AmountBelowMinimumError, got %v", err)
+	}
+	if err := USD.ValidateAmount(0); err != InvalidAmountError {
+		t.Errorf("Expected 0 to fail with InvalidAmountError, got %v", err)
+	}
+	if err := Currency("xyz").ValidateAmount(1000); err != InvalidCurrencyError {
+		t.Errorf("Expected an unsupported currency to fail with InvalidCurrencyError, got %v", err)
+	}
+	if min, ok := ZAR.MinimumAmount(); ok {
+		t.Errorf("Expected no minimum for ZAR, got %d", min)
+	}
+	if err := ZAR.ValidateAmount(1); err != nil {
+		t.Errorf("Expected an amount in a currency with no known minimum to be valid, got %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package stripe
+
+import "net/url"
+
+// ApplePayDomain represents a web domain registered for Apple Pay on the web.
+//
+// see https://stripe.com/docs/apple-pay#web
+type ApplePayDomain struct {
+	APIResource
+
+	ID         string   `json:"id"`
+	Created    UnixTime `json:"created"`
+	DomainName string   `json:"domain_name"`
+	Livemode   bool     `json:"livemode"`
+}
+
+// ApplePayDomainClient encapsulates operations for registering, deleting and
+// querying Apple Pay domains using the Stripe REST API.
+type ApplePayDomainClient struct{ scope }
+
+// With returns an ApplePayDomainClient configured with the given options.
+func (c ApplePayDomainClient) With(opts ...ClientOption) ApplePayDomainClient {
+	c.apply(opts)
+	return c
+}
+
+// Registers the domain, e.g. "example.com", for Apple Pay. The domain must
+// already serve Stripe's domain association file.
+//
+// see https://stripe.com/docs/apple-pay#web
+func (c ApplePayDomainClient) Create(domain string) (*ApplePayDomain, error) {
+	values := url.Values{"domain_name": {domain}}
+	res := &ApplePayDomain{}
+	return res, c.query("POST", "/apple_pay/domains", values, res)
+}
+
+// Retrieves the Apple Pay Domain with the given ID.
+func (c ApplePayDomainClient) Get(id string) (*ApplePayDomain, error) {
+	res := &ApplePayDomain{}
+	return res, c.query("GET", "/apple_pay/domains/"+url.QueryEscape(id), nil, res)
+}
+
+// Deletes the Apple Pay Domain with the given ID.
+func (c ApplePayDomainClient) Delete(id string) (*Deleted, error) {
+	res := &Deleted{}
+	return res, c.query("DELETE", "/apple_pay/domains/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of your Apple Pay Domains with the specified range. If
+// domain is not empty, only the domain with that name is returned.
+func (c ApplePayDomainClient) List(domain string, limit int, before, after string) ([]*ApplePayDomain, bool, error) {
+	res := struct {
+		ListObject
+		Data []*ApplePayDomain
+	}{}
+	values := listParams(limit, before, after)
+	if domain != "" {
+		values.Add("domain_name", domain)
+	}
+	err := c.query("GET", "/apple_pay/domains", values, &res)
+	return res.Data, res.More, err
+}
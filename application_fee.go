@@ -0,0 +1,159 @@
+package stripe
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ApplicationFee represents the portion of a charge on a connected account
+// collected by your platform.
+//
+// see https://stripe.com/docs/api#application_fee_object
+type ApplicationFee struct {
+	APIResource
+
+	ID                     string         `json:"id"`
+	Account                string         `json:"account"`
+	Amount                 int64          `json:"amount"`
+	AmountRefunded         int64          `json:"amount_refunded"`
+	Application            string         `json:"application"`
+	BalanceTransaction     string         `json:"balance_transaction"`
+	Charge                 string         `json:"charge"`
+	Created                UnixTime       `json:"created"`
+	Currency               Currency       `json:"currency"`
+	OriginatingTransaction string         `json:"originating_transaction,omitempty"`
+	Refunded               bool           `json:"refunded"`
+	Refunds                *FeeRefundList `json:"refunds,omitempty"`
+	Livemode               bool           `json:"livemode"`
+}
+
+// FeeRefundList is a list of fee refunds, as embedded in an ApplicationFee.
+type FeeRefundList struct {
+	ListObject
+	Data []*FeeRefund `json:"data"`
+}
+
+// FeeRefund represents an application fee returned to the connected account.
+//
+// see https://stripe.com/docs/api#fee_refund_object
+type FeeRefund struct {
+	APIResource
+
+	ID                 string            `json:"id"`
+	Amount             int64             `json:"amount"`
+	Currency           Currency          `json:"currency"`
+	Created            UnixTime          `json:"created"`
+	Fee                string            `json:"fee"`
+	BalanceTransaction string            `json:"balance_transaction"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+}
+
+// FeeRefundParams encapsulates options for creating and updating Fee Refunds.
+type FeeRefundParams struct {
+	Params
+
+	// (Optional) A positive integer in cents representing how much of the fee
+	// to refund. Defaults to the entire remaining fee.
+	Amount *int64 `form:"amount"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// ApplicationFeeClient encapsulates operations for querying and refunding
+// application fees using the Stripe REST API.
+type ApplicationFeeClient struct{ scope }
+
+// With returns an ApplicationFeeClient configured with the given options.
+func (c ApplicationFeeClient) With(opts ...ClientOption) ApplicationFeeClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Application Fee with the given ID.
+//
+// see https://stripe.com/docs/api#retrieve_application_fee
+func (c ApplicationFeeClient) Get(id string) (*ApplicationFee, error) {
+	res := &ApplicationFee{}
+	return res, c.query("GET", "/application_fees/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of the Application Fees your platform has collected.
+//
+// see https://stripe.com/docs/api#list_application_fees
+func (c ApplicationFeeClient) List(limit int, before, after string) ([]*ApplicationFee, bool, error) {
+	return c.list("", limit, before, after)
+}
+
+// Returns a list of the Application Fees collected on the given Charge ID.
+//
+// see https://stripe.com/docs/api#list_application_fees
+func (c ApplicationFeeClient) ChargeList(id string, limit int, before, after string) ([]*ApplicationFee, bool, error) {
+	return c.list(id, limit, before, after)
+}
+
+func (c ApplicationFeeClient) list(id string, limit int, before, after string) ([]*ApplicationFee, bool, error) {
+	res := struct {
+		ListObject
+		Data []*ApplicationFee
+	}{}
+	params := listParams(limit, before, after)
+	if id != "" {
+		params.Add("charge", id)
+	}
+	err := c.query("GET", "/application_fees", params, &res)
+	return res.Data, res.More, err
+}
+
+// FeeRefundClient encapsulates operations for refunding application fees using
+// the Stripe REST API.
+type FeeRefundClient struct{ scope }
+
+// With returns a FeeRefundClient configured with the given options.
+func (c FeeRefundClient) With(opts ...ClientOption) FeeRefundClient {
+	c.apply(opts)
+	return c
+}
+
+func (c FeeRefundClient) path(feeID, refundID string) string {
+	p := fmt.Sprintf("/application_fees/%s/refunds", url.QueryEscape(feeID))
+	if refundID != "" {
+		p += "/" + url.QueryEscape(refundID)
+	}
+	return p
+}
+
+// Refunds all or part of the Application Fee with the given ID.
+//
+// see https://stripe.com/docs/api#create_fee_refund
+func (c FeeRefundClient) Create(feeID string, params *FeeRefundParams) (*FeeRefund, error) {
+	res := &FeeRefund{}
+	return res, c.query("POST", c.path(feeID, ""), formValues(params), res)
+}
+
+// Retrieves the Fee Refund with the given ID.
+//
+// see https://stripe.com/docs/api#retrieve_fee_refund
+func (c FeeRefundClient) Get(feeID, refundID string) (*FeeRefund, error) {
+	res := &FeeRefund{}
+	return res, c.query("GET", c.path(feeID, refundID), nil, res)
+}
+
+// Updates the metadata of the Fee Refund with the given ID.
+//
+// see https://stripe.com/docs/api#update_fee_refund
+func (c FeeRefundClient) Update(feeID, refundID string, params *FeeRefundParams) (*FeeRefund, error) {
+	res := &FeeRefund{}
+	return res, c.query("POST", c.path(feeID, refundID), formValues(params), res)
+}
+
+// Returns a list of the refunds of the Application Fee with the given ID.
+//
+// see https://stripe.com/docs/api#list_fee_refunds
+func (c FeeRefundClient) List(feeID string, limit int, before, after string) ([]*FeeRefund, bool, error) {
+	res := struct {
+		ListObject
+		Data []*FeeRefund
+	}{}
+	err := c.query("GET", c.path(feeID, ""), listParams(limit, before, after), &res)
+	return res.Data, res.More, err
+}
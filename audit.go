@@ -0,0 +1,123 @@
+package stripe
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// AuditRecord describes a request made to the Stripe API, with sensitive
+// values redacted, for an audit log.
+type AuditRecord struct {
+	// when the request was first sent
+	Time time.Time
+
+	Method string
+	Path   string
+
+	// the form parameters or query string of the request, with card numbers,
+	// CVCs, bank account and identity numbers, and secrets redacted
+	Params url.Values
+
+	// the connected account the request was made on behalf of, if any
+	Account string
+
+	IdempotencyKey string
+
+	// the status of the response and the ID Stripe assigned the request, or
+	// the error if no response was received
+	Status    int
+	RequestID string
+	Err       error
+}
+
+// the function that receives an AuditRecord for every request, if any
+var _audit func(*AuditRecord)
+
+// SetAuditHook will set a function that is called with an AuditRecord for
+// every request made to the Stripe API, once it completes, including any
+// retries. Setting it to nil, the default, disables auditing. The function is
+// called from the goroutine that made the request and should not block.
+func SetAuditHook(f func(*AuditRecord)) {
+	_audit = f
+}
+
+// redacted is the value sensitive parameters are replaced with.
+const redacted = "REDACTED"
+
+// sensitiveParams are the names of parameters whose values are always
+// redacted, wherever they are nested, e.g. card[number].
+var sensitiveParams = map[string]bool{
+	"number":             true,
+	"cvc":                true,
+	"account_number":     true,
+	"routing_number":     true,
+	"iban":               true,
+	"id_number":          true,
+	"personal_id_number": true,
+	"ssn_last_4":         true,
+	"password":           true,
+}
+
+// secretValues matches values that are redacted whatever their parameter:
+// API keys, webhook signing secrets and client secrets.
+var secretValues = regexp.MustCompile(`\b(?:(?:sk|rk)_(?:test|live)|whsec|ek_(?:test|live))_[0-9A-Za-z]+|\b\w+_\w+_secret_[0-9A-Za-z]+`)
+
+// SanitizeParams returns a copy of the form parameters of a request with
+// card numbers, CVCs, bank account and identity numbers, and secrets
+// redacted.
+func SanitizeParams(values url.Values) url.Values {
+	clean := make(url.Values, len(values))
+	for key, vs := range values {
+		name := key
+		if i := strings.LastIndex(name, "["); i >= 0 {
+			name = strings.TrimSuffix(name[i+1:], "]")
+		}
+		for _, v := range vs {
+			if sensitiveParams[name] {
+				v = redacted
+			} else {
+				v = secretValues.ReplaceAllString(v, redacted)
+			}
+			clean.Add(key, v)
+		}
+	}
+	return clean
+}
+
+// audit passes the AuditRecord of a request that started at start and was
+// answered with r, or failed with err, to the audit hook.
+func audit(req *http.Request, r *http.Response, err error, start time.Time) {
+	if _audit == nil {
+		return
+	}
+	rec := &AuditRecord{
+		Time:           start,
+		Method:         req.Method,
+		Path:           req.URL.Path,
+		Account:        req.Header.Get("Stripe-Account"),
+		IdempotencyKey: req.Header.Get("Idempotency-Key"),
+		Err:            err,
+	}
+	if r != nil {
+		rec.Status = r.StatusCode
+		rec.RequestID = r.Header.Get("Request-Id")
+	}
+	values := req.URL.Query()
+	if req.GetBody != nil && strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if body, err := req.GetBody(); err == nil {
+			var form strings.Builder
+			if _, err := io.Copy(&form, body); err == nil {
+				if parsed, err := url.ParseQuery(form.String()); err == nil {
+					values = parsed
+				}
+			}
+			body.Close()
+		}
+	}
+	rec.Params = SanitizeParams(values)
+	_audit(rec)
+}
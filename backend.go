@@ -0,0 +1,50 @@
+package stripe
+
+import "net/url"
+
+// Backend submits API requests on behalf of the resource clients. By default
+// requests are sent to the Stripe API over HTTP; substituting a Backend, with
+// SetBackend or the WithBackend option, lets tests exercise code that
+// uses the clients without network access.
+//
+// Call receives the request method, the API path without the version prefix
+// (e.g. "/charges/ch_1"), and the encoded parameters, and stores the decoded
+// response in the value pointed to by v, which is a pointer to the resource
+// type the client method returns.
+type Backend interface {
+	Call(method, path string, params url.Values, v interface{}) error
+}
+
+// BackendFunc adapts an ordinary function to a Backend, which is convenient
+// for one-off mocks:
+//
+//	stripe.SetBackend(stripe.BackendFunc(func(method, path string, params url.Values, v interface{}) error {
+//		return json.Unmarshal([]byte(`{"id":"ch_1","paid":true}`), v)
+//	}))
+type BackendFunc func(method, path string, params url.Values, v interface{}) error
+
+// Call calls f(method, path, params, v).
+func (f BackendFunc) Call(method, path string, params url.Values, v interface{}) error {
+	return f(method, path, params, v)
+}
+
+// the Backend all clients send requests through, unless overridden per client
+// with WithBackend. nil sends requests to the Stripe API.
+var _backend Backend
+
+// SetBackend will set the Backend all clients send their requests through.
+// Setting it to nil restores the default of sending requests to the Stripe
+// API. File uploads are passed to the Backend with their form fields only,
+// and file downloads and OAuth requests always use the Stripe API.
+func SetBackend(b Backend) {
+	_backend = b
+}
+
+// backendFor returns the Backend requests made with the scope are sent
+// through, or nil if they are sent to the Stripe API.
+func (s scope) backendFor() Backend {
+	if s.backend != nil {
+		return s.backend
+	}
+	return _backend
+}
@@ -0,0 +1,72 @@
+package stripe
+
+import ()
+
+// Balance represents the funds in your Stripe account, broken down by
+// currency and by whether they are available to be paid out yet.
+//
+// see https://stripe.com/docs/api#balance_object
+type Balance struct {
+	APIResource
+
+	Available       []*BalanceAmount `json:"available"`
+	Pending         []*BalanceAmount `json:"pending"`
+	ConnectReserved []*BalanceAmount `json:"connect_reserved,omitempty"`
+
+	// Funds that can be paid out immediately using an instant payout.
+	InstantAvailable []*BalanceAmount `json:"instant_available,omitempty"`
+	Livemode         bool             `json:"livemode"`
+}
+
+// BalanceAmount is the balance held in a single currency.
+type BalanceAmount struct {
+	Amount      int64            `json:"amount"`
+	Currency    Currency         `json:"currency"`
+	SourceTypes map[string]int64 `json:"source_types,omitempty"`
+}
+
+// BalanceClient encapsulates operations for querying your account balance
+// using the Stripe REST API.
+type BalanceClient struct{ scope }
+
+// With returns a BalanceClient configured with the given options.
+func (c BalanceClient) With(opts ...ClientOption) BalanceClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the current account Balance.
+//
+// see https://stripe.com/docs/api#retrieve_balance
+func (c BalanceClient) Get() (*Balance, error) {
+	res := &Balance{}
+	return res, c.query("GET", "/balance", nil, res)
+}
+
+// AvailableAmount returns the available amount in the given currency, or zero if
+// the balance holds no funds in that currency.
+func (b *Balance) AvailableAmount(currency Currency) int64 {
+	return sumBalance(b.Available, currency)
+}
+
+// PendingAmount returns the pending amount in the given currency, or zero if
+// the balance holds no funds in that currency.
+func (b *Balance) PendingAmount(currency Currency) int64 {
+	return sumBalance(b.Pending, currency)
+}
+
+// InstantAvailableAmount returns the amount in the given currency that can be
+// paid out with an instant payout, or zero if none is available.
+func (b *Balance) InstantAvailableAmount(currency Currency) int64 {
+	return sumBalance(b.InstantAvailable, currency)
+}
+
+func sumBalance(amounts []*BalanceAmount, currency Currency) int64 {
+	var total int64
+	for _, a := range amounts {
+		if a.Currency == currency {
+			total += a.Amount
+		}
+	}
+	return total
+}
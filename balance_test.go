@@ -0,0 +1,62 @@
+package stripe
+
+import (
+	"testing"
+)
+
+// TestGetBalance will test that we can retrieve the account Balance, and that
+// a charge shows up as pending funds.
+func TestGetBalance(t *testing.T) {
+	before, err := Balances.Get()
+	if err != nil {
+		t.Errorf("Expected Balance, got Error %s", err.Error())
+		return
+	}
+	if before.Livemode {
+		t.Errorf("Expected test mode Balance")
+	}
+
+	if _, err := Charges.Create(&charge1); err != nil {
+		t.Errorf("Expected Successful Charge, got Error %s", err.Error())
+		return
+	}
+
+	after, err := Balances.Get()
+	if err != nil {
+		t.Errorf("Expected Balance, got Error %s", err.Error())
+		return
+	}
+	if after.PendingAmount(USD) <= before.PendingAmount(USD) {
+		t.Errorf("Expected pending USD Balance to increase, got %d", after.PendingAmount(USD))
+	}
+}
+
+// TestListBalanceTransactions will test that the Balance Transaction of a
+// charge can be found by filtering on its type and source.
+func TestListBalanceTransactions(t *testing.T) {
+	charge, err := Charges.Create(&charge1)
+	if err != nil {
+		t.Errorf("Expected Successful Charge, got Error %s", err.Error())
+		return
+	}
+
+	txns, _, err := BalanceTransactions.List(&BalanceTransactionListParams{
+		ListParams: ListParams{Limit: 1},
+		Type:       "charge",
+		Source:     charge.ID,
+	})
+	if err != nil {
+		t.Errorf("Expected Balance Transaction List, got Error %s", err.Error())
+		return
+	}
+	if len(txns) != 1 {
+		t.Errorf("Expected 1 Balance Transaction, got %d", len(txns))
+		return
+	}
+	if txns[0].ID != charge.BalanceTransaction {
+		t.Errorf("Expected Balance Transaction %s, got %s", charge.BalanceTransaction, txns[0].ID)
+	}
+	if txns[0].Net != txns[0].Amount-txns[0].Fee {
+		t.Errorf("Expected Net to equal Amount less Fee")
+	}
+}
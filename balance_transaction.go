@@ -0,0 +1,177 @@
+package stripe
+
+import "net/url"
+
+// BalanceTransaction represents a single movement of funds into or out of
+// your Stripe balance, such as a charge, refund, transfer or payout.
+//
+// see https://stripe.com/docs/api#balance_transaction_object
+type BalanceTransaction struct {
+	APIResource
+
+	ID                string       `json:"id"`
+	Amount            int64        `json:"amount"`
+	AvailableOn       UnixTime     `json:"available_on"`
+	Created           UnixTime     `json:"created"`
+	Currency          Currency     `json:"currency"`
+	Description       string       `json:"description,omitempty"`
+	ExchangeRate      float64      `json:"exchange_rate,omitempty"`
+	Fee               int64        `json:"fee"`
+	FeeDetails        []*FeeDetail `json:"fee_details"`
+	Net               int64        `json:"net"`
+	ReportingCategory string       `json:"reporting_category"`
+	Source            string       `json:"source"`
+	Status            string       `json:"status"`
+	Type              string       `json:"type"`
+}
+
+// FeeDetail is one component of the fees taken from a BalanceTransaction.
+type FeeDetail struct {
+	Amount      int64    `json:"amount"`
+	Application string   `json:"application,omitempty"`
+	Currency    Currency `json:"currency"`
+	Description string   `json:"description"`
+	Type        FeeType  `json:"type"`
+}
+
+// FeeType is the kind of fee a FeeDetail is.
+type FeeType string
+
+// Fee Types
+const (
+	// Stripe's processing fees.
+	FeeStripe FeeType = "stripe_fee"
+
+	// The fees a Connect platform took with ApplicationFeeAmount, where the
+	// FeeDetail's Application is the platform's ID.
+	FeeApplication FeeType = "application_fee"
+
+	// Taxes on Stripe's fees, such as VAT.
+	FeeTax FeeType = "tax"
+
+	// Fees of a payment method passed through to you, such as card network
+	// fees on IC+ pricing.
+	FeePaymentMethodPassthrough FeeType = "payment_method_passthrough_fee"
+)
+
+// FeeTotals adds up balance transactions and their fees by type, in the
+// smallest unit of their currency, e.g. for the revenue accounting of a
+// payout.
+type FeeTotals struct {
+	Currency Currency
+
+	// The sums of the transactions' amounts, fees and net amounts.
+	Amount int64
+	Fee    int64
+	Net    int64
+
+	// The sums of the fees by type, of which Other holds those of any other
+	// type.
+	StripeFees      int64
+	ApplicationFees int64
+	Taxes           int64
+	Other           int64
+}
+
+// Add adds the transaction and its fees to the totals. Transactions should
+// all be in the same currency, as those of a payout are.
+func (t *FeeTotals) Add(txn *BalanceTransaction) {
+	if t.Currency == "" {
+		t.Currency = txn.Currency
+	}
+	t.Amount += txn.Amount
+	t.Fee += txn.Fee
+	t.Net += txn.Net
+	for _, fee := range txn.FeeDetails {
+		switch fee.Type {
+		case FeeStripe:
+			t.StripeFees += fee.Amount
+		case FeeApplication:
+			t.ApplicationFees += fee.Amount
+		case FeeTax:
+			t.Taxes += fee.Amount
+		default:
+			t.Other += fee.Amount
+		}
+	}
+}
+
+// BalanceTransactionListParams encapsulates options for filtering a list of
+// Balance Transactions.
+type BalanceTransactionListParams struct {
+	ListParams
+
+	// (Optional) Only return transactions of the given type, e.g. charge,
+	// refund, transfer, payout or adjustment.
+	Type string `form:"type"`
+
+	// (Optional) Only return transactions that were paid out in the payout
+	// with the given ID.
+	Payout string `form:"payout"`
+
+	// (Optional) Only return transactions in the given currency.
+	Currency Currency `form:"currency"`
+
+	// (Optional) Only return transactions related to the given source ID,
+	// such as a charge.
+	Source string `form:"source"`
+
+	// (Optional) Only return transactions created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// BalanceTransactionClient encapsulates operations for querying balance
+// transactions using the Stripe REST API.
+type BalanceTransactionClient struct{ scope }
+
+// With returns a BalanceTransactionClient configured with the given options.
+func (c BalanceTransactionClient) With(opts ...ClientOption) BalanceTransactionClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Balance Transaction with the given ID.
+//
+// see https://stripe.com/docs/api#retrieve_balance_transaction
+func (c BalanceTransactionClient) Get(id string) (*BalanceTransaction, error) {
+	res := &BalanceTransaction{}
+	return res, c.cachedGet("/balance_transactions/"+url.QueryEscape(id), res, func() bool { return true })
+}
+
+// Returns a list of Balance Transactions matching the given filters.
+//
+// see https://stripe.com/docs/api#balance_history
+func (c BalanceTransactionClient) List(params *BalanceTransactionListParams) ([]*BalanceTransaction, bool, error) {
+	res := struct {
+		ListObject
+		Data []*BalanceTransaction
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/balance_transactions", values, &res)
+	return res.Data, res.More, err
+}
+
+// PayoutFees lists the balance transactions paid out in the payout with the
+// given ID and returns their totals, leaving out the payout's own
+// transaction, so Amount is the gross of the payout and Net what was paid
+// out.
+func (c BalanceTransactionClient) PayoutFees(payoutID string) (*FeeTotals, error) {
+	totals := &FeeTotals{}
+	params := &BalanceTransactionListParams{ListParams: ListParams{Limit: 100}, Payout: payoutID}
+	for {
+		txns, more, err := c.List(params)
+		if err != nil {
+			return nil, err
+		}
+		for _, txn := range txns {
+			if txn.Type != "payout" {
+				totals.Add(txn)
+			}
+		}
+		if !more || len(txns) == 0 {
+			return totals, nil
+		}
+		params.After = txns[len(txns)-1].ID
+	}
+}
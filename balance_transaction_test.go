@@ -0,0 +1,39 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+// TestPayoutFees will test that the fees of the transactions of a payout are
+// added up by type, across pages.
+func TestPayoutFees(t *testing.T) {
+	pages := map[string]string{
+		"": `{"has_more":true,"data":[
+			{"id":"txn_1","type":"charge","amount":10000,"fee":620,"net":9380,"currency":"eur","fee_details":[
+				{"type":"stripe_fee","amount":320,"currency":"eur"},
+				{"type":"application_fee","amount":250,"currency":"eur","application":"ca_1"},
+				{"type":"tax","amount":50,"currency":"eur"}]},
+			{"id":"txn_2","type":"payout","amount":-18760,"fee":0,"net":-18760,"currency":"eur"}]}`,
+		"txn_2": `{"has_more":false,"data":[
+			{"id":"txn_3","type":"charge","amount":10000,"fee":620,"net":9380,"currency":"eur","fee_details":[
+				{"type":"stripe_fee","amount":320,"currency":"eur"},
+				{"type":"payment_method_passthrough_fee","amount":300,"currency":"eur"}]}]}`,
+	}
+	backend := BackendFunc(func(method, path string, params url.Values, v interface{}) error {
+		if path != "/balance_transactions" || params.Get("payout") != "po_1" {
+			t.Errorf("Expected the transactions of the payout to be listed, got %s %v", path, params)
+		}
+		return json.Unmarshal([]byte(pages[params.Get("starting_after")]), v)
+	})
+
+	totals, err := BalanceTransactions.With(WithBackend(backend)).PayoutFees("po_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := FeeTotals{Currency: EUR, Amount: 20000, Fee: 1240, Net: 18760, StripeFees: 640, ApplicationFees: 250, Taxes: 50, Other: 300}
+	if *totals != want {
+		t.Errorf("Expected totals %+v, got %+v", want, *totals)
+	}
+}
@@ -0,0 +1,127 @@
+package stripe
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// BatchError is returned by the RetrieveMany methods when some of the objects
+// could not be retrieved, and holds the error for each of their IDs. The
+// objects that were retrieved are still returned alongside it.
+type BatchError map[string]error
+
+func (e BatchError) Error() string {
+	ids := make([]string, 0, len(e))
+	for id := range e {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	msgs := make([]string, 0, 3)
+	for _, id := range ids {
+		if len(msgs) == cap(msgs) {
+			msgs = append(msgs, "...")
+			break
+		}
+		msgs = append(msgs, id+": "+e[id].Error())
+	}
+	return fmt.Sprintf("stripe: %d retrievals failed: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// retrieveMany calls get for each of the IDs, running at most concurrency
+// calls at a time, and returns the results keyed by ID. Once ctx is done no
+// further calls are started and its error is returned; calls already in
+// flight are waited for.
+func retrieveMany(ctx context.Context, ids []string, concurrency int, get func(id string) (interface{}, error)) (map[string]interface{}, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	var (
+		mu      sync.Mutex
+		results = make(map[string]interface{}, len(ids))
+		errs    = make(BatchError)
+		wg      sync.WaitGroup
+	)
+	work := make(chan string)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range work {
+				v, err := get(id)
+				mu.Lock()
+				if err != nil {
+					errs[id] = err
+				} else {
+					results[id] = v
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	var err error
+dispatch:
+	for _, id := range ids {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		select {
+		case work <- id:
+		case <-ctx.Done():
+			err = ctx.Err()
+			break dispatch
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	if err == nil && len(errs) > 0 {
+		err = errs
+	}
+	return results, err
+}
+
+// RetrieveMany retrieves the Charges with the given IDs, making at most
+// concurrency requests at a time, and returns them keyed by ID. If some of
+// them cannot be retrieved the others are still returned, with a BatchError.
+func (c ChargeClient) RetrieveMany(ctx context.Context, ids []string, concurrency int) (map[string]*Charge, error) {
+	res, err := retrieveMany(ctx, ids, concurrency, func(id string) (interface{}, error) {
+		return c.Get(id)
+	})
+	charges := make(map[string]*Charge, len(res))
+	for id, v := range res {
+		charges[id] = v.(*Charge)
+	}
+	return charges, err
+}
+
+// RetrieveMany retrieves the Customers with the given IDs, making at most
+// concurrency requests at a time, and returns them keyed by ID. If some of
+// them cannot be retrieved the others are still returned, with a BatchError.
+func (c CustomerClient) RetrieveMany(ctx context.Context, ids []string, concurrency int) (map[string]*Customer, error) {
+	res, err := retrieveMany(ctx, ids, concurrency, func(id string) (interface{}, error) {
+		return c.Get(id)
+	})
+	customers := make(map[string]*Customer, len(res))
+	for id, v := range res {
+		customers[id] = v.(*Customer)
+	}
+	return customers, err
+}
+
+// RetrieveMany retrieves the Invoices with the given IDs, making at most
+// concurrency requests at a time, and returns them keyed by ID. If some of
+// them cannot be retrieved the others are still returned, with a BatchError.
+func (c InvoiceClient) RetrieveMany(ctx context.Context, ids []string, concurrency int) (map[string]*Invoice, error) {
+	res, err := retrieveMany(ctx, ids, concurrency, func(id string) (interface{}, error) {
+		return c.Get(id)
+	})
+	invoices := make(map[string]*Invoice, len(res))
+	for id, v := range res {
+		invoices[id] = v.(*Invoice)
+	}
+	return invoices, err
+}
@@ -0,0 +1,68 @@
+package stripe
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestRetrieveMany will test that objects are retrieved concurrently, no more
+// than the given number at a time, and that failures are reported by ID.
+func TestRetrieveMany(t *testing.T) {
+	var mu sync.Mutex
+	var running, peak int
+	backend := BackendFunc(func(method, path string, params url.Values, v interface{}) error {
+		mu.Lock()
+		running++
+		if running > peak {
+			peak = running
+		}
+		mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+
+		id := strings.TrimPrefix(path, "/invoices/")
+		if id == "in_missing" {
+			e := &Error{Code: 404}
+			e.Detail.Message = "No such invoice: in_missing"
+			return e
+		}
+		return json.Unmarshal([]byte(`{"id":"`+id+`"}`), v)
+	})
+
+	ids := []string{"in_1", "in_2", "in_3", "in_missing", "in_4", "in_5", "in_6"}
+	invoices, err := Invoices.With(WithBackend(backend)).RetrieveMany(context.Background(), ids, 3)
+	batch, ok := err.(BatchError)
+	if !ok || len(batch) != 1 || batch["in_missing"] == nil {
+		t.Fatalf("Expected a BatchError for in_missing, got %v", err)
+	}
+	if len(invoices) != 6 || invoices["in_4"].ID != "in_4" {
+		t.Errorf("Expected the other 6 Invoices keyed by ID, got %v", invoices)
+	}
+	if peak != 3 {
+		t.Errorf("Expected 3 concurrent retrievals, got %d", peak)
+	}
+}
+
+// TestRetrieveManyCanceled will test that no retrievals are started once the
+// context is done.
+func TestRetrieveManyCanceled(t *testing.T) {
+	var calls int
+	backend := BackendFunc(func(method, path string, params url.Values, v interface{}) error {
+		calls++
+		return json.Unmarshal([]byte(`{"id":"ch_1"}`), v)
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := Charges.With(WithBackend(backend)).RetrieveMany(ctx, []string{"ch_1", "ch_2"}, 1)
+	if err != context.Canceled || calls != 0 {
+		t.Errorf("Expected context.Canceled before retrieving, got %v after %d calls", err, calls)
+	}
+}
@@ -0,0 +1,408 @@
+package stripe
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BillingStore holds the local snapshot of Customers, Subscriptions and
+// Invoices kept by a BillingSync. Implementations must be safe for concurrent
+// use.
+type BillingStore interface {
+	// SaveCustomer stores the Customer, replacing any previous version.
+	SaveCustomer(c *Customer) error
+
+	// DeleteCustomer forgets the Customer, along with its Subscriptions and
+	// Invoices.
+	DeleteCustomer(id string) error
+
+	// SaveSubscription stores the Subscription, replacing any previous
+	// version.
+	SaveSubscription(s *Subscription) error
+
+	// SaveInvoice stores the Invoice, replacing any previous version.
+	SaveInvoice(inv *Invoice) error
+
+	// Customer returns the stored Customer, or nil if it is not known.
+	Customer(id string) (*Customer, error)
+
+	// CustomerIDs returns the IDs of all stored Customers.
+	CustomerIDs() ([]string, error)
+
+	// Subscriptions returns the stored Subscriptions of the Customer.
+	Subscriptions(customerID string) ([]*Subscription, error)
+
+	// Invoices returns the stored Invoices of the Customer.
+	Invoices(customerID string) ([]*Invoice, error)
+}
+
+// BillingSync keeps a local snapshot of billing state in a BillingStore, so
+// that questions such as whether a Customer has an active Subscription can be
+// answered without a request to Stripe. The snapshot is updated from
+// customer, customer.subscription and invoice events passed to Handle, e.g.
+// by a WebhookProcessor, and reconciled with the Customers and Subscriptions
+// listed from Stripe every Interval to repair any events that were missed.
+//
+// Events older than the version of an object already stored are ignored, so
+// events delivered out of order do not overwrite newer state. The versions
+// of objects that were neither listed nor sent in an event since the
+// previous reconciliation pass are forgotten at the end of a pass.
+type BillingSync struct {
+	// Time between reconciliation passes once started. Defaults to an hour.
+	Interval time.Duration
+
+	// (Optional) Called when a reconciliation pass made in the background
+	// fails.
+	OnError func(err error)
+
+	store     BillingStore
+	customers CustomerClient
+
+	mu      sync.Mutex
+	updated map[string]time.Time
+
+	// when the previous reconciliation pass started
+	reconciled time.Time
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewBillingSync returns a BillingSync that keeps its snapshot in store, and
+// lists Customers with the default CustomerClient when reconciling.
+func NewBillingSync(store BillingStore) *BillingSync {
+	return &BillingSync{
+		Interval:  time.Hour,
+		store:     store,
+		customers: *Customers,
+		updated:   make(map[string]time.Time),
+	}
+}
+
+// WithClient sets the CustomerClient used to list Customers and their
+// Subscriptions when reconciling, e.g. for a connected account.
+func (s *BillingSync) WithClient(c CustomerClient) *BillingSync {
+	s.customers = c
+	return s
+}
+
+// Register registers the BillingSync as the handler of the events it consumes
+// with the WebhookProcessor. It must not be called after the processor is
+// started.
+func (s *BillingSync) Register(p *WebhookProcessor) {
+	for _, t := range []string{
+		"customer.created", "customer.updated", "customer.deleted",
+		"customer.subscription.created", "customer.subscription.updated",
+		"customer.subscription.deleted", "customer.subscription.paused",
+		"customer.subscription.resumed", "customer.subscription.trial_will_end",
+		"invoice.created", "invoice.updated", "invoice.finalized", "invoice.paid",
+		"invoice.payment_succeeded", "invoice.payment_failed",
+		"invoice.voided", "invoice.marked_uncollectible",
+	} {
+		p.Handle(t, s.Handle)
+	}
+}
+
+// Handle applies the event to the snapshot. Events about other objects are
+// ignored, so Handle can be registered for all events as an EventHandler.
+func (s *BillingSync) Handle(e *Event) error {
+	switch {
+	case strings.HasPrefix(e.Type, "customer.subscription."):
+		sub := &Subscription{}
+		if err := e.Decode(sub); err != nil {
+			return err
+		}
+		if !s.newer(sub.ID, e.Created.Time) {
+			return nil
+		}
+		return s.store.SaveSubscription(sub)
+	case e.Type == "customer.deleted":
+		cust := &Customer{}
+		if err := e.Decode(cust); err != nil {
+			return err
+		}
+		if !s.newer(cust.ID, e.Created.Time) {
+			return nil
+		}
+		return s.store.DeleteCustomer(cust.ID)
+	case e.Type == "customer.created", e.Type == "customer.updated":
+		cust := &Customer{}
+		if err := e.Decode(cust); err != nil {
+			return err
+		}
+		if !s.newer(cust.ID, e.Created.Time) {
+			return nil
+		}
+		return s.store.SaveCustomer(cust)
+	case strings.HasPrefix(e.Type, "invoice."):
+		inv := &Invoice{}
+		if err := e.Decode(inv); err != nil {
+			return err
+		}
+		if !s.newer(inv.ID, e.Created.Time) {
+			return nil
+		}
+		return s.store.SaveInvoice(inv)
+	}
+	return nil
+}
+
+// newer reports whether state of the object with the given ID as of t is at
+// least as recent as what is stored, recording t if so.
+func (s *BillingSync) newer(id string, t time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if last, ok := s.updated[id]; ok && t.Before(last) {
+		return false
+	}
+	s.updated[id] = t
+	return true
+}
+
+// Reconcile lists all Customers and their Subscriptions from Stripe and
+// stores them, deleting stored Customers that no longer exist and marking
+// stored Subscriptions that are no longer listed as canceled. Invoices are
+// only updated by events.
+func (s *BillingSync) Reconcile() error {
+	// events are timestamped to the second, so events made during the
+	// pass are still applied after it
+	started := _clock.Now().Truncate(time.Second)
+	subs := SubscriptionClient{s.customers.scope}
+	seen := make(map[string]bool)
+
+	var after string
+	for {
+		var last string
+		more, err := s.customers.Each(100, "", after, func(cust *Customer) error {
+			last = cust.ID
+			seen[cust.ID] = true
+			if !s.newer(cust.ID, started) {
+				return nil
+			}
+			var listed []*Subscription
+			if cust.Subscriptions != nil && !cust.Subscriptions.More {
+				listed = cust.Subscriptions.Data
+			} else {
+				var err error
+				if listed, err = subs.all(cust.ID); err != nil {
+					return err
+				}
+			}
+			cust.Subscriptions = nil
+			if err := s.store.SaveCustomer(cust); err != nil {
+				return err
+			}
+			return s.reconcileSubscriptions(cust.ID, listed, started)
+		})
+		if err != nil {
+			return err
+		}
+		if !more || last == "" {
+			break
+		}
+		after = last
+	}
+
+	ids, err := s.store.CustomerIDs()
+	if err != nil {
+		return err
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if !seen[id] && s.newer(id, started) {
+			if err := s.store.DeleteCustomer(id); err != nil {
+				return err
+			}
+		}
+	}
+	s.prune(started)
+	return nil
+}
+
+// prune forgets the versions of objects that were last updated before the
+// previous reconciliation pass, such as deleted Customers, which are no
+// longer listed, and records that a pass started at started.
+func (s *BillingSync) prune(started time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, t := range s.updated {
+		if t.Before(s.reconciled) {
+			delete(s.updated, id)
+		}
+	}
+	s.reconciled = started
+}
+
+// reconcileSubscriptions stores the Customer's listed Subscriptions, and
+// marks those stored but not listed as canceled.
+func (s *BillingSync) reconcileSubscriptions(customerID string, listed []*Subscription, started time.Time) error {
+	ids := make(map[string]bool, len(listed))
+	for _, sub := range listed {
+		ids[sub.ID] = true
+		if sub.Customer == "" {
+			sub.Customer = customerID
+		}
+		if s.newer(sub.ID, started) {
+			if err := s.store.SaveSubscription(sub); err != nil {
+				return err
+			}
+		}
+	}
+	stored, err := s.store.Subscriptions(customerID)
+	if err != nil {
+		return err
+	}
+	for _, sub := range stored {
+		if ids[sub.ID] || sub.Status == SubscriptionCanceled || !s.newer(sub.ID, started) {
+			continue
+		}
+		canceled := *sub
+		canceled.Status = SubscriptionCanceled
+		if err := s.store.SaveSubscription(&canceled); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsActive reports whether the stored Customer has a Subscription that is
+// active or trialing.
+func (s *BillingSync) IsActive(customerID string) (bool, error) {
+	subs, err := s.store.Subscriptions(customerID)
+	if err != nil {
+		return false, err
+	}
+	for _, sub := range subs {
+		if sub.Status == SubscriptionActive || sub.Status == SubscriptionTrialing {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Start reconciles the snapshot every Interval in the background, starting
+// with an immediate pass.
+func (s *BillingSync) Start() {
+	s.quit = make(chan struct{})
+	s.wg.Add(1)
+	go s.run()
+}
+
+// Stop stops the background reconciliation, waiting for a pass in progress
+// to finish.
+func (s *BillingSync) Stop() {
+	close(s.quit)
+	s.wg.Wait()
+}
+
+func (s *BillingSync) run() {
+	defer s.wg.Done()
+	for {
+		if err := s.Reconcile(); err != nil && s.OnError != nil {
+			s.OnError(err)
+		}
+		select {
+		case <-_clock.After(s.Interval):
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// MemoryBillingStore is a BillingStore that keeps the snapshot in memory. It
+// does not survive restarts, so a reconciliation pass is needed to fill it.
+type MemoryBillingStore struct {
+	mu            sync.Mutex
+	customers     map[string]*Customer
+	subscriptions map[string]*Subscription
+	invoices      map[string]*Invoice
+}
+
+// NewMemoryBillingStore returns an empty MemoryBillingStore.
+func NewMemoryBillingStore() *MemoryBillingStore {
+	return &MemoryBillingStore{
+		customers:     make(map[string]*Customer),
+		subscriptions: make(map[string]*Subscription),
+		invoices:      make(map[string]*Invoice),
+	}
+}
+
+func (m *MemoryBillingStore) SaveCustomer(c *Customer) error {
+	m.mu.Lock()
+	m.customers[c.ID] = c
+	m.mu.Unlock()
+	return nil
+}
+
+func (m *MemoryBillingStore) DeleteCustomer(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.customers, id)
+	for subID, sub := range m.subscriptions {
+		if sub.Customer == id {
+			delete(m.subscriptions, subID)
+		}
+	}
+	for invID, inv := range m.invoices {
+		if inv.Customer.ID() == id {
+			delete(m.invoices, invID)
+		}
+	}
+	return nil
+}
+
+func (m *MemoryBillingStore) SaveSubscription(s *Subscription) error {
+	m.mu.Lock()
+	m.subscriptions[s.ID] = s
+	m.mu.Unlock()
+	return nil
+}
+
+func (m *MemoryBillingStore) SaveInvoice(inv *Invoice) error {
+	m.mu.Lock()
+	m.invoices[inv.ID] = inv
+	m.mu.Unlock()
+	return nil
+}
+
+func (m *MemoryBillingStore) Customer(id string) (*Customer, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.customers[id], nil
+}
+
+func (m *MemoryBillingStore) CustomerIDs() ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ids := make([]string, 0, len(m.customers))
+	for id := range m.customers {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (m *MemoryBillingStore) Subscriptions(customerID string) ([]*Subscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var subs []*Subscription
+	for _, sub := range m.subscriptions {
+		if sub.Customer == customerID {
+			subs = append(subs, sub)
+		}
+	}
+	return subs, nil
+}
+
+func (m *MemoryBillingStore) Invoices(customerID string) ([]*Invoice, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var invs []*Invoice
+	for _, inv := range m.invoices {
+		if inv.Customer.ID() == customerID {
+			invs = append(invs, inv)
+		}
+	}
+	return invs, nil
+}
@@ -0,0 +1,108 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func billingEvent(typ string, created int64, object string) *Event {
+	return &Event{Type: typ, Created: UnixTime{time.Unix(created, 0)}, Data: EventData{Object: json.RawMessage(object)}}
+}
+
+// TestBillingSync will test that events update the snapshot, that stale
+// events are ignored, and that reconciling repairs missed events.
+func TestBillingSync(t *testing.T) {
+	store := NewMemoryBillingStore()
+	s := NewBillingSync(store)
+
+	for _, e := range []*Event{
+		billingEvent("customer.created", 100, `{"id":"cus_1","email":"a@example.com"}`),
+		billingEvent("customer.subscription.created", 100, `{"id":"sub_1","customer":"cus_1","status":"trialing"}`),
+		billingEvent("customer.subscription.updated", 300, `{"id":"sub_1","customer":"cus_1","status":"active"}`),
+		billingEvent("customer.subscription.updated", 200, `{"id":"sub_1","customer":"cus_1","status":"past_due"}`),
+		billingEvent("invoice.paid", 300, `{"id":"in_1","customer":"cus_1","paid":true}`),
+		billingEvent("charge.succeeded", 300, `{"id":"ch_1"}`),
+		billingEvent("customer.created", 100, `{"id":"cus_2"}`),
+		billingEvent("customer.subscription.created", 100, `{"id":"sub_2","customer":"cus_2","status":"active"}`),
+	} {
+		if err := s.Handle(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if active, _ := s.IsActive("cus_1"); !active {
+		t.Error("Expected cus_1 to be active despite the stale past_due event")
+	}
+	if invs, _ := store.Invoices("cus_1"); len(invs) != 1 || !invs[0].Paid {
+		t.Errorf("Expected the paid invoice to be stored, got %v", invs)
+	}
+
+	// cus_1's subscription was canceled and cus_2 deleted without the events
+	// being received, and cus_3 was created
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/customers":
+			w.Write([]byte(`{"has_more":false,"data":[
+				{"id":"cus_1","subscriptions":{"has_more":false,"data":[]}},
+				{"id":"cus_3","subscriptions":{"has_more":true,"data":[]}}]}`))
+		case "/v1/customers/cus_3/subscriptions":
+			w.Write([]byte(`{"has_more":false,"data":[{"id":"sub_3","status":"active"}]}`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+			w.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+
+	if err := s.WithClient(Customers.With(WithBaseURL(server.URL))).Reconcile(); err != nil {
+		t.Fatal(err)
+	}
+	if active, _ := s.IsActive("cus_1"); active {
+		t.Error("Expected cus_1's unlisted subscription to be canceled")
+	}
+	if c, _ := store.Customer("cus_2"); c != nil {
+		t.Error("Expected the deleted cus_2 to be removed")
+	}
+	if active, _ := s.IsActive("cus_3"); !active {
+		t.Error("Expected cus_3 and its subscription to be stored")
+	}
+
+	// an event from before the pass does not undo it
+	s.Handle(billingEvent("customer.subscription.updated", 400, `{"id":"sub_1","customer":"cus_1","status":"active"}`))
+	if active, _ := s.IsActive("cus_1"); active {
+		t.Error("Expected an event older than the reconciliation to be ignored")
+	}
+}
+
+type fixedClock struct{ now time.Time }
+
+func (c *fixedClock) Now() time.Time                         { return c.now }
+func (c *fixedClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// TestBillingSyncPrune will test that the versions of objects which are no
+// longer listed or sent in events are forgotten after a reconciliation pass,
+// while those of listed objects are kept.
+func TestBillingSyncPrune(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"has_more":false,"data":[{"id":"cus_1","subscriptions":{"has_more":false,"data":[]}}]}`))
+	}))
+	defer server.Close()
+	clock := &fixedClock{now: time.Unix(1000, 0)}
+	defer SetClock(nil)
+	SetClock(clock)
+
+	s := NewBillingSync(NewMemoryBillingStore()).WithClient(Customers.With(WithBaseURL(server.URL)))
+	s.Handle(billingEvent("customer.created", 900, `{"id":"cus_2"}`))
+	s.Handle(billingEvent("invoice.paid", 900, `{"id":"in_1","customer":"cus_2","paid":true}`))
+	for _, now := range []int64{1000, 2000, 3000} {
+		clock.now = time.Unix(now, 0)
+		if err := s.Reconcile(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(s.updated) != 1 || !s.updated["cus_1"].Equal(time.Unix(3000, 0)) {
+		t.Errorf("Expected only the listed cus_1 to be remembered, got %v", s.updated)
+	}
+}
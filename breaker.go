@@ -0,0 +1,42 @@
+package stripe
+
+import (
+	"net/http"
+)
+
+// CircuitBreaker decides whether requests are sent to the Stripe API, so that
+// calls fail fast rather than pile up while Stripe is having an outage. Its
+// method set matches gobreaker.TwoStepCircuitBreaker, which can be used
+// directly.
+type CircuitBreaker interface {
+	// Allow returns an error if the request must not be sent. Otherwise it
+	// returns a function that is called with whether the request succeeded
+	// once it completes.
+	Allow() (done func(success bool), err error)
+}
+
+// the CircuitBreaker requests are routed through, if any
+var _breaker CircuitBreaker
+
+// SetCircuitBreaker will set the CircuitBreaker all requests to the Stripe API
+// are routed through. Requests that fail without a response, or with a 5xx
+// status, count as failures; errors such as declined cards do not. Setting it
+// to nil, the default, sends every request.
+func SetCircuitBreaker(b CircuitBreaker) {
+	_breaker = b
+}
+
+// breakerDo sends the request with do, if the CircuitBreaker allows it, and
+// reports the outcome.
+func breakerDo(req *http.Request, do func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	if _breaker == nil {
+		return do(req)
+	}
+	done, err := _breaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+	r, err := do(req)
+	done(err == nil && r.StatusCode < 500)
+	return r, err
+}
@@ -0,0 +1,57 @@
+package stripe
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// testBreaker opens once it has seen a failure.
+type testBreaker struct {
+	open     bool
+	outcomes []bool
+}
+
+var breakerOpenError = errors.New("circuit breaker is open")
+
+func (b *testBreaker) Allow() (func(bool), error) {
+	if b.open {
+		return nil, breakerOpenError
+	}
+	return func(success bool) {
+		b.outcomes = append(b.outcomes, success)
+		b.open = !success
+	}, nil
+}
+
+// TestCircuitBreaker will test that 5xx responses count as failures, other
+// errors do not, and that no request is sent while the breaker is open.
+func TestCircuitBreaker(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		switch r.URL.Path {
+		case "/v1/charges/ch_402":
+			w.WriteHeader(402)
+		case "/v1/charges/ch_500":
+			w.WriteHeader(500)
+		}
+		w.Write([]byte(`{"id":"ch_1"}`))
+	}))
+	defer server.Close()
+	b := &testBreaker{}
+	defer SetCircuitBreaker(nil)
+	SetCircuitBreaker(b)
+
+	client := Charges.With(WithBaseURL(server.URL))
+	client.Get("ch_1")
+	client.Get("ch_402")
+	client.Get("ch_500")
+	if _, err := client.Get("ch_1"); err != breakerOpenError {
+		t.Errorf("Expected the open breaker's error, got %v", err)
+	}
+	if hits != 3 || len(b.outcomes) != 3 || !b.outcomes[0] || !b.outcomes[1] || b.outcomes[2] {
+		t.Errorf("Expected 3 requests, the 500 failing, got %d requests and %v", hits, b.outcomes)
+	}
+}
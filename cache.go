@@ -0,0 +1,140 @@
+package stripe
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// Cache stores the JSON of objects that do not change once created, such as
+// Balance Transactions and paid Invoices, so that retrieving them again does
+// not need a request to the Stripe API. Implementations must be safe for
+// concurrent use.
+type Cache interface {
+	// Get returns the data stored for key, if it has not expired.
+	Get(key string) ([]byte, bool)
+
+	// Set stores data for key until the ttl has passed.
+	Set(key string, data []byte, ttl time.Duration)
+
+	// Delete removes the data stored for key, if any.
+	Delete(key string)
+}
+
+// the Cache immutable objects are kept in, and for how long. nil disables
+// caching.
+var (
+	_cache    Cache
+	_cacheTTL time.Duration
+)
+
+// SetCache will set the Cache immutable objects are kept in for the given
+// ttl after they are retrieved. Setting it to nil disables caching, which is
+// the default.
+//
+// The objects cached are Balance Transactions, and Invoices once they are
+// closed and paid. Updating a cached Invoice through InvoiceClient removes it
+// from the Cache, but changes made elsewhere, e.g. to its metadata in the
+// Dashboard, are only seen once the ttl has passed.
+func SetCache(c Cache, ttl time.Duration) {
+	_cache = c
+	_cacheTTL = ttl
+}
+
+// cacheKey returns the key the object at path is cached under, which differs
+// between base URLs, connected accounts and API versions.
+func (s scope) cacheKey(path string) string {
+	account := s.account
+	if account == "" {
+		account = _account
+	}
+	return s.apiURL() + "|" + account + "|" + s.versionFor() + "|" + path
+}
+
+// cachedGet retrieves the object at path into v, using the Cache if one is
+// set. The object is only stored in the Cache if immutable reports that it
+// can no longer change.
+func (s scope) cachedGet(path string, v interface{}, immutable func() bool) error {
+	if _cache == nil {
+		return s.query("GET", path, nil, v)
+	}
+	key := s.cacheKey(path)
+	if data, ok := _cache.Get(key); ok {
+		if err := json.Unmarshal(data, v); err == nil {
+			keepRawJSON(v, data)
+			normalizeVersion(v, s.versionFor())
+			return nil
+		}
+		_cache.Delete(key)
+	}
+	if err := s.query("GET", path, nil, v); err != nil {
+		return err
+	}
+	if !immutable() {
+		return nil
+	}
+	var data []byte
+	if r, ok := v.(interface{ RawJSON() json.RawMessage }); ok {
+		data = r.RawJSON()
+	}
+	if len(data) == 0 {
+		var err error
+		if data, err = json.Marshal(v); err != nil {
+			return nil
+		}
+	}
+	_cache.Set(key, data, _cacheTTL)
+	return nil
+}
+
+// uncache removes the object at path from the Cache, after it was changed.
+func (s scope) uncache(path string) {
+	if _cache != nil {
+		_cache.Delete(s.cacheKey(path))
+	}
+}
+
+// MemoryCache is a Cache that keeps objects in memory until they expire.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+type memoryCacheEntry struct {
+	data    []byte
+	expires time.Time
+}
+
+// NewMemoryCache returns an empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]memoryCacheEntry)}
+}
+
+// Get implements Cache.
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if _clock.Now().After(e.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return e.data, true
+}
+
+// Set implements Cache.
+func (c *MemoryCache) Set(key string, data []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = memoryCacheEntry{data: data, expires: _clock.Now().Add(ttl)}
+}
+
+// Delete implements Cache.
+func (c *MemoryCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
@@ -0,0 +1,62 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// TestCache will test that immutable objects are retrieved from the Cache
+// until they expire, and that Invoices are only cached once paid.
+func TestCache(t *testing.T) {
+	calls := map[string]int{}
+	backend := BackendFunc(func(method, path string, params url.Values, v interface{}) error {
+		calls[method+" "+path]++
+		switch path {
+		case "/balance_transactions/txn_1":
+			return json.Unmarshal([]byte(`{"id":"txn_1","amount":400}`), v)
+		case "/invoices/in_paid":
+			return json.Unmarshal([]byte(`{"id":"in_paid","closed":true,"paid":true}`), v)
+		default:
+			return json.Unmarshal([]byte(`{"id":"in_open","closed":false,"paid":false}`), v)
+		}
+	})
+	defer SetCache(nil, 0)
+	SetCache(NewMemoryCache(), time.Hour)
+
+	for i := 0; i < 3; i++ {
+		txn, err := BalanceTransactions.With(WithBackend(backend)).Get("txn_1")
+		if err != nil || txn.ID != "txn_1" || txn.Amount != 400 {
+			t.Fatalf("Expected txn_1, got %v and %v", txn, err)
+		}
+		Invoices.With(WithBackend(backend)).Get("in_paid")
+		Invoices.With(WithBackend(backend)).Get("in_open")
+	}
+	if calls["GET /balance_transactions/txn_1"] != 1 || calls["GET /invoices/in_paid"] != 1 {
+		t.Errorf("Expected immutable objects to be retrieved once, got %v", calls)
+	}
+	if calls["GET /invoices/in_open"] != 3 {
+		t.Errorf("Expected an open Invoice to be retrieved every time, got %v", calls)
+	}
+
+	Invoices.With(WithBackend(backend)).Update("in_paid", &InvoiceParams{})
+	Invoices.With(WithBackend(backend)).Get("in_paid")
+	if calls["GET /invoices/in_paid"] != 2 {
+		t.Errorf("Expected an updated Invoice to be retrieved again, got %v", calls)
+	}
+}
+
+// TestMemoryCacheExpires will test that entries are not returned after their
+// ttl has passed.
+func TestMemoryCacheExpires(t *testing.T) {
+	c := NewMemoryCache()
+	c.Set("a", []byte("1"), time.Hour)
+	c.Set("b", []byte("2"), -time.Second)
+	if data, ok := c.Get("a"); !ok || string(data) != "1" {
+		t.Errorf("Expected a to be cached, got %q", data)
+	}
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("Expected b to have expired")
+	}
+}
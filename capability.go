@@ -0,0 +1,87 @@
+package stripe
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Capability Statuses
+const (
+	CapabilityActive      = "active"
+	CapabilityInactive    = "inactive"
+	CapabilityPending     = "pending"
+	CapabilityUnrequested = "unrequested"
+	CapabilityDisabled    = "disabled"
+)
+
+// Capability describes a feature, such as card_payments or transfers, that a
+// connected account has requested, along with what is needed to enable it.
+//
+// see https://stripe.com/docs/api#capability_object
+type Capability struct {
+	APIResource
+
+	ID           string               `json:"id"`
+	Account      string               `json:"account"`
+	Requested    bool                 `json:"requested"`
+	RequestedAt  *UnixTime            `json:"requested_at,omitempty"`
+	Status       string               `json:"status"`
+	Requirements *AccountRequirements `json:"requirements,omitempty"`
+}
+
+// CapabilityParams requests a Capability of a connected account when it is
+// created or updated with AccountParams.
+type CapabilityParams struct {
+	// Request (true) or un-request (false) the Capability.
+	Requested *bool `form:"requested"`
+}
+
+// CapabilityClient encapsulates operations for requesting and querying the
+// capabilities of a connected account using the Stripe REST API.
+type CapabilityClient struct{ scope }
+
+// With returns a CapabilityClient configured with the given options.
+func (c CapabilityClient) With(opts ...ClientOption) CapabilityClient {
+	c.apply(opts)
+	return c
+}
+
+func (c CapabilityClient) path(accountID, capabilityID string) string {
+	p := fmt.Sprintf("/accounts/%s/capabilities", url.QueryEscape(accountID))
+	if capabilityID != "" {
+		p += "/" + url.QueryEscape(capabilityID)
+	}
+	return p
+}
+
+// Retrieves the Capability with the given name, e.g. "card_payments", for the
+// connected account.
+//
+// see https://stripe.com/docs/api#retrieve_capability
+func (c CapabilityClient) Get(accountID, capabilityID string) (*Capability, error) {
+	res := &Capability{}
+	return res, c.query("GET", c.path(accountID, capabilityID), nil, res)
+}
+
+// Requests (or un-requests) the Capability with the given name for the
+// connected account.
+//
+// see https://stripe.com/docs/api#update_capability
+func (c CapabilityClient) Update(accountID, capabilityID string, requested bool) (*Capability, error) {
+	values := url.Values{"requested": {strconv.FormatBool(requested)}}
+	res := &Capability{}
+	return res, c.query("POST", c.path(accountID, capabilityID), values, res)
+}
+
+// Returns all Capabilities of the connected account, requested or not.
+//
+// see https://stripe.com/docs/api#list_capabilities
+func (c CapabilityClient) List(accountID string) ([]*Capability, error) {
+	res := struct {
+		ListObject
+		Data []*Capability
+	}{}
+	err := c.query("GET", c.path(accountID, ""), nil, &res)
+	return res.Data, err
+}
@@ -1,28 +1,107 @@
 package stripe
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/url"
-	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
-// Credit Card Types accepted by the Stripe API.
+// Credit Card Types accepted by the Stripe API, as it names them in the brand
+// of a card.
 const (
 	AmericanExpress = "American Express"
 	DinersClub      = "Diners Club"
 	Discover        = "Discover"
 	JCB             = "JCB"
 	MasterCard      = "MasterCard"
+	UnionPay        = "UnionPay"
 	Visa            = "Visa"
 	UnknownCard     = "Unknown"
 )
 
+// Credit Card Types that GetCardType recognizes but Stripe does not report as
+// brands of their own: Maestro cards are reported as MasterCard, and Elo and
+// Mir cards are not accepted.
+const (
+	Elo     = "Elo"
+	Maestro = "Maestro"
+	Mir     = "Mir"
+)
+
+// Card brands as the Stripe API names them in newer objects, such as the
+// card details of Payment Methods, and in parameters.
+const (
+	BrandAmex       = "amex"
+	BrandDiners     = "diners"
+	BrandDiscover   = "discover"
+	BrandJCB        = "jcb"
+	BrandMasterCard = "mastercard"
+	BrandUnionPay   = "unionpay"
+	BrandVisa       = "visa"
+	BrandUnknown    = "unknown"
+)
+
+// brands maps each form a card brand is written in, lowercased and without
+// spaces, to its brand code and Card Type.
+var brands = map[string][2]string{
+	"amex":            {BrandAmex, AmericanExpress},
+	"americanexpress": {BrandAmex, AmericanExpress},
+	"diners":          {BrandDiners, DinersClub},
+	"dinersclub":      {BrandDiners, DinersClub},
+	"discover":        {BrandDiscover, Discover},
+	"jcb":             {BrandJCB, JCB},
+	"mastercard":      {BrandMasterCard, MasterCard},
+	"maestro":         {BrandMasterCard, MasterCard},
+	"unionpay":        {BrandUnionPay, UnionPay},
+	"visa":            {BrandVisa, Visa},
+}
+
+// BrandCode returns the brand code, e.g. "amex", for a card brand written as
+// either a Card Type, e.g. "American Express", or a brand code, ignoring case
+// and spaces. Maestro cards have the code "mastercard", as Stripe reports
+// them; BrandUnknown is returned for brands Stripe does not accept.
+func BrandCode(brand string) string {
+	if b, ok := brands[brandKey(brand)]; ok {
+		return b[0]
+	}
+	return BrandUnknown
+}
+
+// BrandName returns the Card Type, e.g. "American Express", for a card brand
+// written as either a Card Type or a brand code, e.g. "amex", ignoring case
+// and spaces. UnknownCard is returned for brands Stripe does not accept.
+func BrandName(brand string) string {
+	if b, ok := brands[brandKey(brand)]; ok {
+		return b[1]
+	}
+	return UnknownCard
+}
+
+// SameBrand reports whether a and b name the same card brand, in either form.
+// Brands Stripe does not accept are not the same as any brand.
+func SameBrand(a, b string) bool {
+	code := BrandCode(a)
+	return code != BrandUnknown && code == BrandCode(b)
+}
+
+func brandKey(brand string) string {
+	return strings.ToLower(strings.Replace(brand, " ", "", -1))
+}
+
 // Card represents details about a Credit Card entered into Stripe.
 type Card struct {
+	APIResource
+
 	ID                string `json:"id"`
+	Object            string `json:"object,omitempty"`
 	Name              string `json:"name,omitempty"`
 	Type              string `json:"type"`
+	Brand             string `json:"brand,omitempty"`
 	ExpMonth          int    `json:"exp_month"`
 	ExpYear           int    `json:"exp_year"`
 	Last4             string `json:"last4"`
@@ -37,42 +116,62 @@ type Card struct {
 	AddressZipCheck   string `json:"address_zip_check,omitempty"`
 	CVCCheck          string `json:"cvc_check,omitempty"`
 	Customer          string `json:"customer,omitempty"`
+
+	// Set on debit cards attached to a connected account as an external
+	// account.
+	Account            string   `json:"account,omitempty"`
+	Currency           Currency `json:"currency,omitempty"`
+	DefaultForCurrency bool     `json:"default_for_currency,omitempty"`
+
+	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
 // CardParams encapsulates options for Creating or Updating Credit Cards.
 type CardParams struct {
+	Params
+
 	// (Optional) Cardholder's full name.
-	Name string
+	Name *string `form:"name"`
 
 	// The card number, as a string without any separators.
-	Number string
+	Number string `form:"number"`
 
 	// The card's expiration month.
-	ExpMonth int
+	ExpMonth int `form:"exp_month"`
 
 	// The card's expiration year.
-	ExpYear int
+	ExpYear int `form:"exp_year"`
 
 	// Card security code
-	CVC string
+	CVC string `form:"cvc"`
 
 	// (Optional) Billing address line 1
-	Address1 string
+	Address1 *string `form:"address_line1"`
 
 	// (Optional) Billing address line 2
-	Address2 string
+	Address2 *string `form:"address_line2"`
 
 	// (Optional) Billing address country
-	AddressCountry string
+	AddressCountry *string `form:"address_country"`
 
 	// (Optional) Billing address state
-	AddressState string
+	AddressState *string `form:"address_state"`
 
 	// (Optional) Billing address zip code
-	AddressZip string
+	AddressZip *string `form:"address_zip"`
+
+	// (Optional) Metadata to attach to the card. Set a key to an empty value to
+	// unset it.
+	Metadata map[string]string `form:"metadata"`
 }
 
-type CardClient struct{}
+type CardClient struct{ scope }
+
+// With returns a CardClient configured with the given options.
+func (c CardClient) With(opts ...ClientOption) CardClient {
+	c.apply(opts)
+	return c
+}
 
 func (c CardClient) path(customerID, cardID string) string {
 	p := fmt.Sprintf("/customers/%s/cards", url.QueryEscape(customerID))
@@ -83,32 +182,38 @@ func (c CardClient) path(customerID, cardID string) string {
 }
 
 func (c CardClient) Create(customerID, token string, card *CardParams) (*Card, error) {
-	params := make(url.Values)
+	var params url.Values
 	if token != "" {
-		params.Add("card", token)
+		params = url.Values{"card": {token}}
+		if card != nil {
+			card.appendExtra(params)
+		}
+	} else if err := card.validate(); err != nil {
+		return nil, err
 	} else {
-		appendCardParams(params, false, card)
+		params = formValues(card)
 	}
 	res := &Card{}
-	return res, query("POST", c.path(customerID, ""), params, res)
+	return res, c.query("POST", c.path(customerID, ""), params, res)
 }
 
 func (c CardClient) Update(customerID, cardID string, card *CardParams) (*Card, error) {
-	params := make(url.Values)
-	appendCardParams(params, false, card)
+	if err := card.validate(); err != nil {
+		return nil, err
+	}
+	params := formValues(card)
 	res := &Card{}
-	return res, query("POST", c.path(customerID, cardID), params, res)
+	return res, c.query("POST", c.path(customerID, cardID), params, res)
 }
 
-func (c CardClient) Delete(customerID, cardID string) (bool, error) {
-	res := &DeleteResp{}
-	err := query("DELETE", c.path(customerID, cardID), nil, res)
-	return res.Deleted, err
+func (c CardClient) Delete(customerID, cardID string) (*Deleted, error) {
+	res := &Deleted{}
+	return res, c.query("DELETE", c.path(customerID, cardID), nil, res)
 }
 
 func (c CardClient) Get(customerID, cardID string) (*Card, error) {
 	res := &Card{}
-	return res, query("GET", c.path(customerID, cardID), nil, res)
+	return res, c.query("GET", c.path(customerID, cardID), nil, res)
 }
 
 func (c CardClient) List(customerID string, limit int, before, after string) ([]*Card, bool, error) {
@@ -116,82 +221,387 @@ func (c CardClient) List(customerID string, limit int, before, after string) ([]
 		ListObject
 		Data []*Card
 	}{}
-	err := query("GET", c.path(customerID, ""), listParams(limit, before, after), &res)
+	err := c.query("GET", c.path(customerID, ""), listParams(limit, before, after), &res)
 	return res.Data, res.More, err
 }
 
+// FindByFingerprint returns the Customer's saved Card with the given
+// fingerprint, which is the same for every Card with the same number, or nil
+// if they have none. All of the Customer's Cards are listed to find it.
+func (c CardClient) FindByFingerprint(customerID, fingerprint string) (*Card, error) {
+	after := ""
+	for {
+		cards, more, err := c.List(customerID, 100, "", after)
+		if err != nil {
+			return nil, err
+		}
+		for _, card := range cards {
+			if card.Fingerprint == fingerprint {
+				return card, nil
+			}
+		}
+		if !more || len(cards) == 0 {
+			return nil, nil
+		}
+		after = cards[len(cards)-1].ID
+	}
+}
+
+// CreateUnique attaches the card tokenized as token to the Customer, unless
+// they already have a Card with the same fingerprint, in which case that Card
+// is returned with existing set and nothing is attached. This keeps the same
+// card from being saved twice, e.g. when a returning customer enters it again.
+func (c CardClient) CreateUnique(customerID, token string) (card *Card, existing bool, err error) {
+	tok, err := TokenClient{c.scope}.Get(token)
+	if err != nil {
+		return nil, false, err
+	}
+	if tok.Card != nil && tok.Card.Fingerprint != "" {
+		card, err := c.FindByFingerprint(customerID, tok.Card.Fingerprint)
+		if err != nil || card != nil {
+			return card, card != nil, err
+		}
+	}
+	card, err = c.Create(customerID, token, nil)
+	return card, false, err
+}
+
+var (
+	InvalidCardNumberError = errors.New("stripe: invalid card number")
+	InvalidExpMonthError   = errors.New("stripe: card expiration month must be between 1 and 12")
+	ExpiredCardError       = errors.New("stripe: card expiration date is in the past")
+	InvalidCVCError        = errors.New("stripe: invalid card security code")
+)
+
+// IsCVCValid reports whether cvc is a valid security code for the card with
+// the given number: 4 digits for American Express cards and 3 digits for
+// other brands. If the brand cannot be determined from the number, either
+// length is accepted.
+func IsCVCValid(card, cvc string) bool {
+	for i := 0; i < len(cvc); i++ {
+		if cvc[i] < '0' || cvc[i] > '9' {
+			return false
+		}
+	}
+	switch GetCardType(card) {
+	case AmericanExpress:
+		return len(cvc) == 4
+	case UnknownCard:
+		return len(cvc) == 3 || len(cvc) == 4
+	}
+	return len(cvc) == 3
+}
+
+// IsExpired reports whether a card expiring in the given month and year has
+// expired at now. Cards are valid through the last day of their expiration
+// month, in the time zone of now. Two digit years are taken to be in the
+// 2000s, as the Stripe API does.
+func IsExpired(expMonth, expYear int, now time.Time) bool {
+	if expYear < 100 {
+		expYear += 2000
+	}
+	end := time.Date(expYear, time.Month(expMonth)+1, 1, 0, 0, 0, 0, now.Location())
+	return !now.Before(end)
+}
+
+// validate checks the card's expiration date and security code, if they are
+// set, so that obviously bad input fails before a request is made:
+// InvalidExpMonthError is returned for an out of range month, ExpiredCardError
+// for a date that has passed, and InvalidCVCError for a security code that
+// does not fit the card's brand. If only the year is set, e.g. when updating
+// a Card, it must not have passed.
+func (c *CardParams) validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.CVC != "" && !IsCVCValid(c.Number, c.CVC) {
+		return InvalidCVCError
+	}
+	if c.ExpMonth != 0 && (c.ExpMonth < 1 || c.ExpMonth > 12) {
+		return InvalidExpMonthError
+	}
+	switch {
+	case c.ExpYear == 0:
+		return nil
+	case c.ExpMonth == 0 && IsExpired(12, c.ExpYear, _clock.Now()):
+		return ExpiredCardError
+	case c.ExpMonth != 0 && IsExpired(c.ExpMonth, c.ExpYear, _clock.Now()):
+		return ExpiredCardError
+	}
+	return nil
+}
+
+// ParseCardNumber sanitizes a card number as entered by a customer, removing
+// the spaces and dashes commonly used to group its digits. It returns
+// InvalidCardNumberError if the number contains any other characters, or is
+// not between 8 and 19 digits long.
+func ParseCardNumber(number string) (string, error) {
+	digits := make([]byte, 0, len(number))
+	for i := 0; i < len(number); i++ {
+		switch c := number[i]; {
+		case c >= '0' && c <= '9':
+			digits = append(digits, c)
+		case c == ' ' || c == '-':
+		default:
+			return "", InvalidCardNumberError
+		}
+	}
+	if len(digits) < 8 || len(digits) > 19 {
+		return "", InvalidCardNumberError
+	}
+	return string(digits), nil
+}
+
+// MaskNumber returns the card number with all but its last four digits
+// hidden, e.g. "•••• 4242", for display and logging. Spaces and dashes in the
+// number are ignored; if it has four digits or fewer, none of them are shown.
+func MaskNumber(number string) string {
+	digits := make([]byte, 0, len(number))
+	for i := 0; i < len(number); i++ {
+		if c := number[i]; c >= '0' && c <= '9' {
+			digits = append(digits, c)
+		}
+	}
+	if len(digits) <= 4 {
+		return "••••"
+	}
+	return "•••• " + string(digits[len(digits)-4:])
+}
+
+// FormatNumber groups the digits of a sanitized card number the way they are
+// printed on cards of its brand, e.g. "3782 822463 10005" for American
+// Express and "4242 4242 4242 4242" for Visa.
+func FormatNumber(card string) string {
+	groups := []int{4, 4, 4, 4, 3}
+	switch GetCardType(card) {
+	case AmericanExpress:
+		groups = []int{4, 6, 5}
+	case DinersClub:
+		if len(card) == 14 {
+			groups = []int{4, 6, 4}
+		}
+	}
+	var b strings.Builder
+	for _, n := range groups {
+		if card == "" {
+			break
+		}
+		if n > len(card) {
+			n = len(card)
+		}
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(card[:n])
+		card = card[n:]
+	}
+	if card != "" {
+		b.WriteString(card)
+	}
+	return b.String()
+}
+
+// CardNumberError is returned by IsLuhnValid when a card number contains a
+// character other than a digit, space or dash. It matches
+// InvalidCardNumberError with errors.Is.
+type CardNumberError struct {
+	// The offset of the character in the card number.
+	Offset int
+
+	// The character.
+	Char byte
+}
+
+func (e *CardNumberError) Error() string {
+	return fmt.Sprintf("stripe: invalid card number: unexpected %q at offset %d", e.Char, e.Offset)
+}
+
+func (e *CardNumberError) Is(target error) bool {
+	return target == InvalidCardNumberError
+}
+
 // IsLuhnValid uses the Luhn Algorithm (also known as the Mod 10 algorithm) to
 // verify a credit cards checksum, which helps flag accidental data entry
-// errors.
+// errors. Spaces and dashes between the digits are ignored. A
+// *CardNumberError is returned if the number contains any other characters,
+// and InvalidCardNumberError if it has no digits.
 //
 // see http://en.wikipedia.org/wiki/Luhn_algorithm
 func IsLuhnValid(card string) (bool, error) {
-	var sum = 0
-	var digits = strings.Split(card, "")
+	sum, digits, double := 0, 0, false
 
 	// iterate through the digits in reverse order
-	for i, even := len(digits)-1, false; i >= 0; i, even = i-1, !even {
-		// convert the digit to an integer
-		digit, err := strconv.Atoi(digits[i])
-		if err != nil {
-			return false, err
+	for i := len(card) - 1; i >= 0; i-- {
+		d := int(card[i]) - '0'
+		if uint(d) > 9 {
+			if c := card[i]; c != ' ' && c != '-' {
+				return false, &CardNumberError{Offset: i, Char: c}
+			}
+			continue
 		}
 
 		// we multiply every other digit by 2, adding the product to the sum.
 		// note: if the product is double digits (i.e. 14) we add the two digits
 		//       to the sum (14 -> 1+4 = 5). A simple shortcut is to subtract 9
 		//       from a double digit product (14 -> 14 - 9 = 5).
-		switch {
-		case even && digit > 4:
-			sum += (digit * 2) - 9
-		case even:
-			sum += digit * 2
-		case !even:
-			sum += digit
+		if double {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
 		}
+		sum += d
+		digits, double = digits+1, !double
+	}
+	if digits == 0 {
+		return false, InvalidCardNumberError
 	}
 
 	// if the sum is divisible by 10, it passes the check
 	return sum%10 == 0, nil
 }
 
+// CardRange is a range of Issuer Identification Numbers, the leading digits
+// of card numbers, issued by a Card Type. A number is in the range if its
+// first len(Low) digits are between Low and High inclusive.
+type CardRange struct {
+	Low  string `json:"low"`
+	High string `json:"high"`
+	Type string `json:"type"`
+}
+
+// defaultCardRanges are the ranges GetCardType uses unless they are replaced
+// with SetCardRanges. Ranges are checked in order, so narrower ones, such as
+// Elo's within Visa's and Discover's, are listed first.
+//
+// see https://en.wikipedia.org/wiki/Payment_card_number#Issuer_identification_number_(IIN)
+var defaultCardRanges = []CardRange{
+	// Elo
+	{"401178", "401179", Elo}, {"431274", "431274", Elo},
+	{"438935", "438935", Elo}, {"451416", "451416", Elo},
+	{"457393", "457393", Elo}, {"457631", "457632", Elo},
+	{"504175", "504175", Elo}, {"506699", "506778", Elo},
+	{"509000", "509999", Elo}, {"627780", "627780", Elo},
+	{"636297", "636297", Elo}, {"636368", "636368", Elo},
+	{"650031", "650033", Elo}, {"650035", "650051", Elo},
+	{"650405", "650439", Elo}, {"650485", "650538", Elo},
+	{"650541", "650598", Elo}, {"650700", "650718", Elo},
+	{"650720", "650727", Elo}, {"650901", "650978", Elo},
+	{"651652", "651679", Elo}, {"655000", "655019", Elo},
+	{"655021", "655058", Elo},
+
+	// Discover cards co-branded with UnionPay
+	{"622126", "622925", UnionPay},
+
+	{"2200", "2204", Mir},
+	{"2221", "2720", MasterCard},
+	{"3528", "3589", JCB},
+	{"2131", "2131", JCB},
+	{"1800", "1800", JCB},
+	{"3095", "3095", DinersClub},
+	{"6011", "6011", Discover},
+
+	// Maestro
+	{"5018", "5018", Maestro}, {"5020", "5020", Maestro},
+	{"5038", "5038", Maestro}, {"5893", "5893", Maestro},
+	{"6304", "6304", Maestro}, {"6759", "6759", Maestro},
+	{"6761", "6763", Maestro},
+
+	{"300", "305", DinersClub},
+	{"644", "649", Discover},
+	{"34", "34", AmericanExpress},
+	{"37", "37", AmericanExpress},
+	{"36", "36", DinersClub},
+	{"38", "39", DinersClub},
+	{"51", "55", MasterCard},
+	{"56", "58", Maestro},
+	{"62", "62", UnionPay},
+	{"65", "65", Discover},
+	{"81", "81", UnionPay},
+	{"4", "4", Visa},
+}
+
 // GetCardType is a simple algorithm to determine the Card Type (ie Visa,
-// Discover) based on the Credit Card Number. If the Number is not recognized, a
-// value of "Unknown" will be returned.
+// Discover) based on the Credit Card Number. If the Number is not recognized,
+// including when it is too short to tell, a value of "Unknown" will be
+// returned.
 func GetCardType(card string) string {
-	switch card[0:1] {
-	case "4":
-		return Visa
-	case "2", "1":
-		switch card[0:4] {
-		case "2131", "1800":
-			return JCB
-		}
-	case "6":
-		switch card[0:4] {
-		case "6011":
-			return Discover
-		}
-	case "5":
-		switch card[0:2] {
-		case "51", "52", "53", "54", "55":
-			return MasterCard
-		}
-	case "3":
-		switch card[0:2] {
-		case "34", "37":
-			return AmericanExpress
-		case "36":
-			return DinersClub
-		case "30":
-			switch card[0:3] {
-			case "300", "301", "302", "303", "304", "305":
-				return DinersClub
-			}
-		default:
-			return JCB
+	cardRangesMu.RLock()
+	defer cardRangesMu.RUnlock()
+	for _, r := range _cardRanges {
+		if len(card) < len(r.Low) {
+			continue
+		}
+		if iin := card[:len(r.Low)]; iin >= r.Low && iin <= r.High {
+			return r.Type
 		}
 	}
-
 	return UnknownCard
 }
+
+var InvalidCardRangeError = errors.New("stripe: card range bounds must be digits of equal length, with low not above high")
+
+// the ranges GetCardType uses, which can be replaced while it is called.
+var (
+	cardRangesMu sync.RWMutex
+	_cardRanges  = defaultCardRanges
+)
+
+// SetCardRanges will set the ranges GetCardType determines Card Types from,
+// checked in order, so that new ranges can be recognized without updating
+// this package, e.g. by loading them from a file with LoadCardRanges. Setting
+// them to nil restores the ranges built into this package.
+// InvalidCardRangeError is returned, and the ranges are left unchanged, if
+// any of them is malformed.
+func SetCardRanges(ranges []CardRange) error {
+	if ranges == nil {
+		ranges = defaultCardRanges
+	}
+	for _, r := range ranges {
+		if !validCardRange(r) {
+			return InvalidCardRangeError
+		}
+	}
+	ranges = append([]CardRange(nil), ranges...)
+	cardRangesMu.Lock()
+	_cardRanges = ranges
+	cardRangesMu.Unlock()
+	return nil
+}
+
+// AddCardRanges will add ranges that take precedence over the ones
+// GetCardType already uses, e.g. to recognize a range newly assigned to a
+// Card Type, or one that was assigned to a different Card Type.
+func AddCardRanges(ranges ...CardRange) error {
+	return SetCardRanges(append(ranges, CardRanges()...))
+}
+
+// CardRanges returns a copy of the ranges GetCardType uses.
+func CardRanges() []CardRange {
+	cardRangesMu.RLock()
+	defer cardRangesMu.RUnlock()
+	return append([]CardRange(nil), _cardRanges...)
+}
+
+// LoadCardRanges will set the ranges GetCardType uses to the JSON array of
+// CardRange objects read from r, e.g.
+//
+//	[{"low": "2221", "high": "2720", "type": "MasterCard"}]
+func LoadCardRanges(r io.Reader) error {
+	ranges := []CardRange{}
+	if err := json.NewDecoder(r).Decode(&ranges); err != nil {
+		return err
+	}
+	return SetCardRanges(ranges)
+}
+
+func validCardRange(r CardRange) bool {
+	if r.Low == "" || len(r.Low) != len(r.High) || r.Low > r.High {
+		return false
+	}
+	for i := 0; i < len(r.Low); i++ {
+		if r.Low[i] < '0' || r.Low[i] > '9' || r.High[i] < '0' || r.High[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,29 @@
+//go:build go1.18
+// +build go1.18
+
+package stripe
+
+import "testing"
+
+func FuzzCardNumber(f *testing.F) {
+	for _, card := range cards {
+		f.Add(card.Number)
+	}
+	f.Add("")
+	f.Add("4242 4242-4242 4242")
+	f.Fuzz(func(t *testing.T, input string) {
+		GetCardType(input)
+		IsLuhnValid(input)
+
+		number, err := ParseCardNumber(input)
+		if err != nil {
+			return
+		}
+		if _, err := IsLuhnValid(number); err != nil {
+			t.Errorf("Expected parsed number %q to be checkable, got Error %s", number, err.Error())
+		}
+		if len(number) < 8 || len(number) > 19 {
+			t.Errorf("Expected parsed number %q to be 8 to 19 digits", number)
+		}
+	})
+}
@@ -1,7 +1,12 @@
 package stripe
 
 import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 type card struct {
@@ -21,8 +26,18 @@ var cards = []*card{
 	{"361134239348202", DinersClub, false},      // should fail
 	{"300134239348202", DinersClub, false},      // should fail
 	{"521134239348202", MasterCard, false},      // should fail
-	{"380134239348202", JCB, false},             // should fail
+	{"380134239348202", DinersClub, false},      // should fail
 	{"180034239348202", JCB, false},             // should fail
+	{"3530111333300000", JCB, true},             // should pass
+	{"2223003122003222", MasterCard, true},      // should pass
+	{"2720990000000007", MasterCard, true},      // should pass
+	{"6445644564456445", Discover, true},        // should pass
+	{"6500000000000002", Discover, true},        // should pass
+	{"6200000000000005", UnionPay, true},        // should pass
+	{"2200000000000004", Mir, true},             // should pass
+	{"6759649826438453", Maestro, true},         // should pass
+	{"6363680000457013", Elo, false},            // should fail
+	{"4011780000000000", Elo, false},            // should fail
 }
 
 func TestLuhn(t *testing.T) {
@@ -38,3 +53,309 @@ func TestLuhn(t *testing.T) {
 		}
 	}
 }
+
+// TestLuhnSeparators will test that spaces and dashes are ignored, and that
+// other characters are reported where they occur.
+func TestLuhnSeparators(t *testing.T) {
+	if valid, err := IsLuhnValid("4242 4242-4242 4242"); !valid || err != nil {
+		t.Errorf("Expected separated number to be valid, got %v, %v", valid, err)
+	}
+	if _, err := IsLuhnValid(" - "); err != InvalidCardNumberError {
+		t.Errorf("Expected InvalidCardNumberError for number without digits, got %v", err)
+	}
+	_, err := IsLuhnValid("4242 42x2")
+	if e, ok := err.(*CardNumberError); !ok || e.Offset != 7 || e.Char != 'x' {
+		t.Fatalf("Expected CardNumberError for x at offset 7, got %v", err)
+	}
+	if !errors.Is(err, InvalidCardNumberError) {
+		t.Errorf("Expected CardNumberError to match InvalidCardNumberError")
+	}
+}
+
+func BenchmarkIsLuhnValid(b *testing.B) {
+	for _, number := range []string{"4242424242424242", "4242 4242 4242 4242"} {
+		b.Run(number, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				IsLuhnValid(number)
+			}
+		})
+	}
+}
+
+// TestShortCardNumbers will test that card numbers too short to identify are
+// rejected instead of causing a panic.
+func TestShortCardNumbers(t *testing.T) {
+	for _, number := range []string{"", "4", "3", "30", "601", "x"} {
+		if _, err := IsLuhnValid(number); number == "" && err != InvalidCardNumberError {
+			t.Errorf("Expected InvalidCardNumberError for empty number, got %v", err)
+		}
+		if typ := GetCardType(number); number != "4" && typ != UnknownCard {
+			t.Errorf("card type of %q [%s]; want [%s]", number, typ, UnknownCard)
+		}
+	}
+	if typ := GetCardType("4"); typ != Visa {
+		t.Errorf("card type of \"4\" [%s]; want [%s]", typ, Visa)
+	}
+}
+
+func TestParseCardNumber(t *testing.T) {
+	number, err := ParseCardNumber(" 4242-4242 4242 4242 ")
+	if err != nil || number != "4242424242424242" {
+		t.Errorf("Expected 4242424242424242, got %q, %v", number, err)
+	}
+	for _, bad := range []string{"4242x42424242424", "4242", "42424242424242424242"} {
+		if _, err := ParseCardNumber(bad); err != InvalidCardNumberError {
+			t.Errorf("Expected InvalidCardNumberError for %q, got %v", bad, err)
+		}
+	}
+}
+
+// TestCardMetadata will test that card metadata is nested under the card
+// parameter when the card is attached as part of another request.
+func TestCardMetadata(t *testing.T) {
+	params := &CardParams{Metadata: map[string]string{"order_id": "6735", "old": ""}}
+	values := formValues(&ChargeParams{Card: params})
+	if got := values.Get("card[metadata][order_id]"); got != "6735" {
+		t.Errorf("Expected card[metadata][order_id] 6735, got %q", got)
+	}
+	if got, ok := values["card[metadata][old]"]; !ok || got[0] != "" {
+		t.Errorf("Expected card[metadata][old] to be sent empty, got %v", got)
+	}
+
+	values = formValues(params)
+	if got := values.Get("metadata[order_id]"); got != "6735" {
+		t.Errorf("Expected metadata[order_id] 6735, got %q", got)
+	}
+}
+
+func TestMaskNumber(t *testing.T) {
+	tests := map[string]string{
+		"4242424242424242":    "•••• 4242",
+		"4242-4242 4242-4242": "•••• 4242",
+		"378282246310005":     "•••• 0005",
+		"4242":                "••••",
+		"":                    "••••",
+	}
+	for number, want := range tests {
+		if got := MaskNumber(number); got != want {
+			t.Errorf("MaskNumber(%q) = %q; want %q", number, got, want)
+		}
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+	tests := map[string]string{
+		"4242424242424242":    "4242 4242 4242 4242",
+		"378282246310005":     "3782 822463 10005",
+		"36227206271667":      "3622 720627 1667",
+		"6200000000000000005": "6200 0000 0000 0000 005",
+		"42424":               "4242 4",
+		"":                    "",
+	}
+	for number, want := range tests {
+		if got := FormatNumber(number); got != want {
+			t.Errorf("FormatNumber(%q) = %q; want %q", number, got, want)
+		}
+	}
+}
+
+func TestIsExpired(t *testing.T) {
+	now := time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		month, year int
+		expired     bool
+	}{
+		{3, 2024, false},
+		{2, 2024, true},
+		{12, 2023, true},
+		{1, 2025, false},
+		{3, 24, false},
+		{2, 24, true},
+	}
+	for _, test := range tests {
+		if got := IsExpired(test.month, test.year, now); got != test.expired {
+			t.Errorf("IsExpired(%d, %d) = %v; want %v", test.month, test.year, got, test.expired)
+		}
+	}
+	if !IsExpired(3, 2024, time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected a card to expire at the end of its expiration month")
+	}
+}
+
+func TestIsCVCValid(t *testing.T) {
+	tests := []struct {
+		card, cvc string
+		valid     bool
+	}{
+		{"378282246310005", "1234", true},
+		{"378282246310005", "123", false},
+		{"4242424242424242", "123", true},
+		{"4242424242424242", "1234", false},
+		{"5555555555554444", "12a", false},
+		{"", "123", true},
+		{"", "1234", true},
+		{"", "12", false},
+	}
+	for _, test := range tests {
+		if got := IsCVCValid(test.card, test.cvc); got != test.valid {
+			t.Errorf("IsCVCValid(%q, %q) = %v; want %v", test.card, test.cvc, got, test.valid)
+		}
+	}
+}
+
+// TestCardValidation will test that cards with an invalid or past
+// expiration date, or a security code that does not fit their brand, are
+// rejected without making a request.
+func TestCardValidation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no request, got %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	year := time.Now().Year()
+	tests := []struct {
+		card *CardParams
+		err  error
+	}{
+		{&CardParams{Number: "4242424242424242", ExpMonth: 13, ExpYear: year + 1}, InvalidExpMonthError},
+		{&CardParams{Number: "4242424242424242", ExpMonth: -1, ExpYear: year + 1}, InvalidExpMonthError},
+		{&CardParams{Number: "4242424242424242", ExpMonth: 12, ExpYear: year - 1}, ExpiredCardError},
+		{&CardParams{ExpYear: year - 1}, ExpiredCardError},
+		{&CardParams{Number: "378282246310005", CVC: "123", ExpMonth: 12, ExpYear: year + 1}, InvalidCVCError},
+	}
+	for _, test := range tests {
+		if _, err := Tokens.With(WithBaseURL(server.URL)).Create(test.card); err != test.err {
+			t.Errorf("Expected %v creating a token, got %v", test.err, err)
+		}
+		if _, err := Cards.With(WithBaseURL(server.URL)).Update("cus_1", "card_1", test.card); err != test.err {
+			t.Errorf("Expected %v updating a card, got %v", test.err, err)
+		}
+		if _, err := Customers.With(WithBaseURL(server.URL)).Create(&CustomerParams{Card: test.card}); err != test.err {
+			t.Errorf("Expected %v creating a customer, got %v", test.err, err)
+		}
+	}
+}
+
+func TestSetCardRanges(t *testing.T) {
+	defer SetCardRanges(nil)
+
+	if err := AddCardRanges(CardRange{"9999", "9999", "Test"}); err != nil {
+		t.Fatalf("Expected the range to be added, got %v", err)
+	}
+	if typ := GetCardType("9999000000000000"); typ != "Test" {
+		t.Errorf("Expected the added range to be recognized, got %s", typ)
+	}
+	if typ := GetCardType("4242424242424242"); typ != Visa {
+		t.Errorf("Expected the default ranges to still apply, got %s", typ)
+	}
+
+	for _, r := range []CardRange{{"", "", Visa}, {"12", "123", Visa}, {"20", "10", Visa}, {"4x", "4x", Visa}} {
+		if err := SetCardRanges([]CardRange{r}); err != InvalidCardRangeError {
+			t.Errorf("Expected InvalidCardRangeError for %v, got %v", r, err)
+		}
+	}
+
+	if err := LoadCardRanges(strings.NewReader(`[{"low": "4", "high": "4", "type": "Other"}]`)); err != nil {
+		t.Fatalf("Expected the ranges to be loaded, got %v", err)
+	}
+	if typ := GetCardType("4242424242424242"); typ != "Other" {
+		t.Errorf("Expected the loaded ranges to replace the defaults, got %s", typ)
+	}
+	if typ := GetCardType("9999000000000000"); typ != UnknownCard {
+		t.Errorf("Expected the added range to be replaced, got %s", typ)
+	}
+
+	SetCardRanges(nil)
+	if typ := GetCardType("4242424242424242"); typ != Visa {
+		t.Errorf("Expected the default ranges to be restored, got %s", typ)
+	}
+}
+
+func TestBrandNormalization(t *testing.T) {
+	tests := []struct {
+		brand, code, name string
+	}{
+		{AmericanExpress, BrandAmex, AmericanExpress},
+		{BrandAmex, BrandAmex, AmericanExpress},
+		{"AMEX", BrandAmex, AmericanExpress},
+		{DinersClub, BrandDiners, DinersClub},
+		{MasterCard, BrandMasterCard, MasterCard},
+		{"Master Card", BrandMasterCard, MasterCard},
+		{Maestro, BrandMasterCard, MasterCard},
+		{BrandUnionPay, BrandUnionPay, UnionPay},
+		{Visa, BrandVisa, Visa},
+		{Mir, BrandUnknown, UnknownCard},
+		{"", BrandUnknown, UnknownCard},
+	}
+	for _, test := range tests {
+		if code := BrandCode(test.brand); code != test.code {
+			t.Errorf("BrandCode(%q) = %q; want %q", test.brand, code, test.code)
+		}
+		if name := BrandName(test.brand); name != test.name {
+			t.Errorf("BrandName(%q) = %q; want %q", test.brand, name, test.name)
+		}
+	}
+	if !SameBrand(GetCardType("378282246310005"), BrandAmex) {
+		t.Errorf("Expected American Express and amex to be the same brand")
+	}
+	if SameBrand(Visa, BrandMasterCard) {
+		t.Errorf("Expected Visa and mastercard to be different brands")
+	}
+	if SameBrand(Mir, Elo) {
+		t.Errorf("Expected unaccepted brands not to be the same brand")
+	}
+}
+
+func TestIsTestCard(t *testing.T) {
+	for number := range testCards {
+		if !IsTestCard(number) {
+			t.Errorf("Expected %q to be a test card", number)
+		}
+		if valid, _ := IsLuhnValid(number); !valid {
+			t.Errorf("Expected test card %q to pass the Luhn check", number)
+		}
+	}
+	if !IsTestCard("4242 4242 4242 4242") {
+		t.Errorf("Expected a separated test card number to be a test card")
+	}
+	for _, number := range []string{"4111111111111111", "", TestAccountNumber} {
+		if IsTestCard(number) {
+			t.Errorf("Expected %q not to be a test card", number)
+		}
+	}
+}
+
+// TestCreateUnique will test that a card is only attached to a customer if
+// none of their saved cards has the same fingerprint.
+func TestCreateUnique(t *testing.T) {
+	created := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v1/tokens/tok_dup":
+			w.Write([]byte(`{"id":"tok_dup","card":{"id":"card_new","fingerprint":"fp_2"}}`))
+		case r.URL.Path == "/v1/tokens/tok_new":
+			w.Write([]byte(`{"id":"tok_new","card":{"id":"card_new","fingerprint":"fp_3"}}`))
+		case r.Method == "GET" && r.URL.Query().Get("starting_after") == "":
+			w.Write([]byte(`{"object":"list","has_more":true,"data":[{"id":"card_1","fingerprint":"fp_1"}]}`))
+		case r.Method == "GET":
+			w.Write([]byte(`{"object":"list","has_more":false,"data":[{"id":"card_2","fingerprint":"fp_2"}]}`))
+		default:
+			created++
+			w.Write([]byte(`{"id":"card_3","fingerprint":"fp_3"}`))
+		}
+	}))
+	defer server.Close()
+
+	card, existing, err := Cards.With(WithBaseURL(server.URL)).CreateUnique("cus_1", "tok_dup")
+	if err != nil || !existing || card.ID != "card_2" {
+		t.Errorf("Expected existing card_2, got %v, %v, %v", card, existing, err)
+	}
+	card, existing, err = Cards.With(WithBaseURL(server.URL)).CreateUnique("cus_1", "tok_new")
+	if err != nil || existing || card.ID != "card_3" {
+		t.Errorf("Expected new card_3, got %v, %v, %v", card, existing, err)
+	}
+	if created != 1 {
+		t.Errorf("Expected 1 card to be attached, got %d", created)
+	}
+}
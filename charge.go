@@ -1,163 +1,488 @@
 package stripe
 
 import (
+	"encoding/json"
 	"net/url"
 	"strconv"
 )
 
-// ISO 3-digit Currency Codes for major currencies (not the full list).
-const (
-	USD = "usd" // US Dollar ($)
-	EUR = "eur" // Euro (€)
-	GBP = "gbp" // British Pound Sterling (UK£)
-	JPY = "jpy" // Japanese Yen (¥)
-	CAD = "cad" // Canadian Dollar (CA$)
-	HKD = "hkd" // Hong Kong Dollar (HK$)
-	CNY = "cny" // Chinese Yuan (CN¥)
-	AUD = "aud" // Australian Dollar (A$)
-)
-
 // Charge represents details about a credit card charge in Stripe.
 //
 // see https://stripe.com/docs/api#charge_object
 type Charge struct {
+	APIResource
+
 	ID                 string            `json:"id"`
 	Description        string            `json:"description,omitempty"`
-	Amount             int               `json:"amount"`
+	Amount             int64             `json:"amount"`
 	Card               *Card             `json:"card"`
-	Currency           string            `json:"currency"`
+	Currency           Currency          `json:"currency"`
 	Created            UnixTime          `json:"created"`
 	Customer           string            `json:"customer,omitempty"`
 	Invoice            string            `json:"invoice,omitempty"`
 	Paid               bool              `json:"paid"`
 	Refunded           bool              `json:"refunded,omitempty"`
-	AmountRefunded     int               `json:"amount_refunded,omitempty"`
+	AmountRefunded     int64             `json:"amount_refunded,omitempty"`
 	BalanceTransaction string            `json:"balance_transaction"`
 	Dispute            *Dispute          `json:"dispute,omitempty"`
 	FailureMessage     string            `json:"failure_message,omitempty"`
 	FailureCode        string            `json:"failure_code,omitempty"`
+	Outcome            *ChargeOutcome    `json:"outcome,omitempty"`
+	FraudDetails       *FraudDetails     `json:"fraud_details,omitempty"`
+	Shipping           *ShippingDetails  `json:"shipping,omitempty"`
 	Metadata           map[string]string `json:"metadata,omitempty"`
 	Livemode           bool              `json:"livemode"`
+
+	// Set on destination charges: the ID of the Transfer of the funds to the
+	// connected account, and of the ApplicationFee the platform collected.
+	Transfer       string `json:"transfer,omitempty"`
+	ApplicationFee string `json:"application_fee,omitempty"`
+
+	// The connected account the Charge was made on behalf of, and where its
+	// funds are transferred to, for destination charges.
+	OnBehalfOf   string        `json:"on_behalf_of,omitempty"`
+	TransferData *TransferData `json:"transfer_data,omitempty"`
+
+	// The group of charges and transfers the Charge is part of, e.g. an
+	// order ID, set to correlate it with the transfers paying out its funds.
+	TransferGroup string `json:"transfer_group,omitempty"`
+
+	// Returned instead of Card by API versions since 2015-02-18. Card is
+	// filled in from them when the Charge is decoded.
+	Source               *Card                 `json:"source,omitempty"`
+	PaymentMethodDetails *PaymentMethodDetails `json:"payment_method_details,omitempty"`
+
+	// Returned by API versions that create Charges from PaymentIntents: the
+	// PaymentMethod charged and the billing details collected with it.
+	PaymentMethod  string          `json:"payment_method,omitempty"`
+	PaymentIntent  string          `json:"payment_intent,omitempty"`
+	BillingDetails *BillingDetails `json:"billing_details,omitempty"`
+
+	// Whether the Charge was captured, and how much of it, for Charges
+	// authorized with capture set to false.
+	Captured       bool  `json:"captured"`
+	AmountCaptured int64 `json:"amount_captured,omitempty"`
+
+	// The receipt of the Charge: the email address it was sent to, its
+	// number, which is set once it is sent, and the URL it can be viewed at.
+	ReceiptEmail  string `json:"receipt_email,omitempty"`
+	ReceiptNumber string `json:"receipt_number,omitempty"`
+	ReceiptURL    string `json:"receipt_url,omitempty"`
+}
+
+// TransferData describes where the funds of a destination charge are
+// transferred to once it succeeds.
+type TransferData struct {
+	// The ID of the connected account the funds are transferred to.
+	Destination string `json:"destination" form:"destination"`
+
+	// (Optional) The amount transferred, in the smallest unit of the
+	// currency, when it is not the whole amount less the application fee.
+	// It cannot be combined with an application fee.
+	Amount *int64 `json:"amount,omitempty" form:"amount"`
 }
 
-type Dispute struct {
-	Charge             string    `json:"charge"`
-	Livemode           bool      `json:"livemode"`
-	Amount             int       `json:"amount"`
-	Created            UnixTime  `json:"created"`
-	Currency           string    `json:"currency"`
-	Reason             string    `json:"reason"`
-	Status             string    `json:"status"`
-	BalanceTransaction string    `json:"balance_transaction"`
-	Evidence           string    `json:"evidence,omitempty"`
-	EvidenceDueBy      *UnixTime `json:"evidence_due_by,omitempty"`
-	Protected          bool      `json:"is_protected,omitempty"`
+// validateConnect checks how the funds of a payment of the given amount are
+// split with connected accounts before it is made, returning a
+// ValidationError if Stripe will reject it: the application fee and the
+// amount transferred must each be within the payment's amount, and cannot be
+// combined, as the amount not transferred is the platform's fee.
+func validateConnect(amount int64, fee *int64, d *TransferData) error {
+	var errs ValidationError
+	if fee != nil && (*fee < 0 || *fee > amount) {
+		errs.add("application_fee_amount", InvalidApplicationFeeError)
+	}
+	if d != nil {
+		if d.Destination == "" {
+			errs.add("transfer_data[destination]", RequiredParamError)
+		}
+		switch {
+		case d.Amount == nil:
+		case *d.Amount < 0 || *d.Amount > amount:
+			errs.add("transfer_data[amount]", InvalidAmountError)
+		case fee != nil && *fee != 0:
+			errs.add("transfer_data[amount]", TransferAmountWithFeeError)
+		}
+	}
+	return errs.err()
+}
+
+// Payment Method Types
+const (
+	PaymentMethodTypeCard          = "card"
+	PaymentMethodTypeCardPresent   = "card_present"
+	PaymentMethodTypeSEPADebit     = "sepa_debit"
+	PaymentMethodTypeUSBankAccount = "us_bank_account"
+	PaymentMethodTypeLink          = "link"
+)
+
+// PaymentMethodDetails describes the payment method used for a Charge at the
+// time of the transaction. Of the details of each type of payment method,
+// only those of the given Type are set.
+type PaymentMethodDetails struct {
+	Type          string                             `json:"type"`
+	Card          *PaymentMethodDetailsCard          `json:"card,omitempty"`
+	CardPresent   *PaymentMethodDetailsCardPresent   `json:"card_present,omitempty"`
+	SEPADebit     *PaymentMethodDetailsSEPADebit     `json:"sepa_debit,omitempty"`
+	USBankAccount *PaymentMethodDetailsUSBankAccount `json:"us_bank_account,omitempty"`
+	Link          *PaymentMethodDetailsLink          `json:"link,omitempty"`
+	BACSDebit     *PaymentMethodDetailsBACSDebit     `json:"bacs_debit,omitempty"`
+	AUBECSDebit   *PaymentMethodDetailsAUBECSDebit   `json:"au_becs_debit,omitempty"`
+
+	Alipay           *PaymentMethodDetailsWallet           `json:"alipay,omitempty"`
+	WeChatPay        *PaymentMethodDetailsWallet           `json:"wechat_pay,omitempty"`
+	Klarna           *PaymentMethodDetailsKlarna           `json:"klarna,omitempty"`
+	AfterpayClearpay *PaymentMethodDetailsAfterpayClearpay `json:"afterpay_clearpay,omitempty"`
+}
+
+// PaymentMethodDetailsCard describes the card charged.
+type PaymentMethodDetailsCard struct {
+	Brand       string `json:"brand"`
+	Country     string `json:"country,omitempty"`
+	ExpMonth    int    `json:"exp_month"`
+	ExpYear     int    `json:"exp_year"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+	Funding     string `json:"funding,omitempty"`
+	Last4       string `json:"last4"`
+	Network     string `json:"network,omitempty"`
+
+	// The results of the checks of the address and CVC given with the card,
+	// each one of "pass", "fail", "unavailable" or "unchecked".
+	Checks *struct {
+		AddressLine1Check      string `json:"address_line1_check,omitempty"`
+		AddressPostalCodeCheck string `json:"address_postal_code_check,omitempty"`
+		CVCCheck               string `json:"cvc_check,omitempty"`
+	} `json:"checks,omitempty"`
+
+	// The result of 3D Secure authentication of the card, if it was
+	// required.
+	ThreeDSecure *struct {
+		Result  string `json:"result"`
+		Version string `json:"version,omitempty"`
+	} `json:"three_d_secure,omitempty"`
+}
+
+// PaymentMethodDetailsCardPresent describes the card charged in person with
+// a Terminal reader.
+type PaymentMethodDetailsCardPresent struct {
+	Brand       string `json:"brand"`
+	Country     string `json:"country,omitempty"`
+	ExpMonth    int    `json:"exp_month"`
+	ExpYear     int    `json:"exp_year"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+	Funding     string `json:"funding,omitempty"`
+	Last4       string `json:"last4"`
+	Network     string `json:"network,omitempty"`
+
+	// how the card was read, e.g. "contact_emv" or "contactless_emv"
+	ReadMethod string `json:"read_method,omitempty"`
+}
+
+// PaymentMethodDetailsSEPADebit describes the bank account debited through
+// SEPA.
+type PaymentMethodDetailsSEPADebit struct {
+	BankCode    string `json:"bank_code,omitempty"`
+	BranchCode  string `json:"branch_code,omitempty"`
+	Country     string `json:"country,omitempty"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+	Last4       string `json:"last4"`
+	Mandate     string `json:"mandate,omitempty"`
+}
+
+// PaymentMethodDetailsUSBankAccount describes the US bank account debited
+// through ACH.
+type PaymentMethodDetailsUSBankAccount struct {
+	AccountHolderType string `json:"account_holder_type,omitempty"`
+	AccountType       string `json:"account_type,omitempty"`
+	BankName          string `json:"bank_name,omitempty"`
+	Fingerprint       string `json:"fingerprint,omitempty"`
+	Last4             string `json:"last4"`
+	RoutingNumber     string `json:"routing_number,omitempty"`
+}
+
+// PaymentMethodDetailsBACSDebit describes the UK bank account debited
+// through Bacs Direct Debit, and the mandate it was debited under.
+type PaymentMethodDetailsBACSDebit struct {
+	Fingerprint string `json:"fingerprint,omitempty"`
+	Last4       string `json:"last4"`
+	Mandate     string `json:"mandate,omitempty"`
+	SortCode    string `json:"sort_code"`
+}
+
+// PaymentMethodDetailsAUBECSDebit describes the Australian bank account
+// debited through BECS Direct Debit, and the mandate it was debited under.
+type PaymentMethodDetailsAUBECSDebit struct {
+	BSBNumber   string `json:"bsb_number"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+	Last4       string `json:"last4"`
+	Mandate     string `json:"mandate,omitempty"`
+}
+
+// PaymentMethodDetailsWallet describes the Alipay or WeChat Pay account
+// charged.
+type PaymentMethodDetailsWallet struct {
+	// The ID of the customer's account, for Alipay.
+	BuyerID string `json:"buyer_id,omitempty"`
+
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// The ID of the payment in the wallet provider's records.
+	TransactionID string `json:"transaction_id,omitempty"`
+}
+
+// PaymentMethodDetailsKlarna describes how the customer chose to pay with
+// Klarna.
+type PaymentMethodDetailsKlarna struct {
+	// e.g. "pay_later" or "pay_over_time"
+	PaymentMethodCategory string `json:"payment_method_category,omitempty"`
+	PreferredLocale       string `json:"preferred_locale,omitempty"`
+}
+
+// PaymentMethodDetailsAfterpayClearpay describes the Afterpay or Clearpay
+// order paid.
+type PaymentMethodDetailsAfterpayClearpay struct {
+	// The order's ID in Afterpay's records.
+	OrderID   string `json:"order_id,omitempty"`
+	Reference string `json:"reference,omitempty"`
+}
+
+// PaymentMethodDetailsLink describes the Link account charged.
+type PaymentMethodDetailsLink struct {
+	Country string `json:"country,omitempty"`
+}
+
+// Charge Outcome Risk Levels
+const (
+	RiskNormal      = "normal"
+	RiskElevated    = "elevated"
+	RiskHighest     = "highest"
+	RiskNotAssessed = "not_assessed"
+)
+
+// ChargeOutcome describes whether a Charge was authorized by the card network
+// and how Radar assessed its risk.
+//
+// see https://stripe.com/docs/api#charge_object-outcome
+type ChargeOutcome struct {
+	NetworkStatus string       `json:"network_status"`
+	Reason        string       `json:"reason,omitempty"`
+	RiskLevel     string       `json:"risk_level"`
+	RiskScore     int          `json:"risk_score,omitempty"`
+	Rule          *OutcomeRule `json:"rule,omitempty"`
+	SellerMessage string       `json:"seller_message"`
+	Type          string       `json:"type"`
+}
+
+// OutcomeRule describes the Radar rule that caused a Charge to be blocked,
+// placed in review or allowed. Unless expanded only the ID is populated.
+type OutcomeRule struct {
+	ID        string `json:"id"`
+	Action    string `json:"action,omitempty"`
+	Predicate string `json:"predicate,omitempty"`
+}
+
+// UnmarshalJSON accepts either the rule's ID or the expanded rule object.
+func (r *OutcomeRule) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &r.ID)
+	}
+	type rule OutcomeRule
+	return json.Unmarshal(data, (*rule)(r))
+}
+
+// Fraud Reports
+const (
+	FraudReportFraudulent = "fraudulent"
+	FraudReportSafe       = "safe"
+)
+
+// RefundReason is the reason given for refunding a Charge.
+type RefundReason string
+
+// Refund Reasons
+const (
+	RefundDuplicate           RefundReason = "duplicate"
+	RefundFraudulent          RefundReason = "fraudulent"
+	RefundRequestedByCustomer RefundReason = "requested_by_customer"
+)
+
+// FraudDetails holds the fraud assessments made of a Charge by you and by
+// Stripe.
+type FraudDetails struct {
+	UserReport   string `json:"user_report,omitempty"`
+	StripeReport string `json:"stripe_report,omitempty"`
 }
 
 // ChargeParams encapsulates options for creating a new Charge.
 type ChargeParams struct {
+	Params
+
 	// A positive integer in cents representing how much to charge the card.
 	// The minimum amount is 50 cents.
-	Amount int
+	Amount int64 `form:"amount"`
 
 	// 3-letter ISO code for currency. Currently, only 'usd' is supported.
-	Currency string
+	Currency Currency `form:"currency"`
 
 	// (Optional) Either customer or card is required, but not both The ID of an
 	// existing customer that will be charged in this request.
-	Customer string
+	Customer *string `form:"customer"`
 
 	// (Optional) Credit Card that should be charged.
-	Card *CardParams
+	Card *CardParams `form:"card"`
 
 	// (Optional) Credit Card token that should be charged.
-	Token string
+	Token *string `form:"card"`
 
 	// An arbitrary string which you can attach to a charge object. It is
 	// displayed when in the web interface alongside the charge. It's often a
 	// good idea to use an email address as a description for tracking later.
-	Description string
+	Description *string `form:"description"`
 
 	// Whether or not to immediately capture the charge. Default is true.
-	Capture *bool
+	Capture *bool `form:"capture"`
 
 	// An arbitrary string to be displayed alongside your company name on your
 	// customer's credit card statement. This may be up to 15 characters.
-	StatementDescription string
+	StatementDescription *string `form:"statement_description"`
+
+	// (Optional) The text shown on the customer's statement, replacing the
+	// account's default, which later API versions accept in place of
+	// StatementDescription: 5 to 22 Latin characters including a letter, and
+	// none of < > \ ' " *.
+	StatementDescriptor *string `form:"statement_descriptor"`
+
+	// (Optional) The text added to the account's statement descriptor prefix
+	// on the customer's statement, within the 22 characters that fit.
+	StatementDescriptorSuffix *string `form:"statement_descriptor_suffix"`
+
+	// (Optional) The ID of a connected account the charge is made on behalf
+	// of, which becomes the settlement merchant.
+	OnBehalfOf *string `form:"on_behalf_of"`
+
+	// (Optional) The connected account the funds are transferred to, for a
+	// destination charge.
+	TransferData *TransferData `form:"transfer_data"`
+
+	// (Optional) The fee the platform collects from a charge made on or to a
+	// connected account, in the smallest unit of the currency.
+	ApplicationFeeAmount *int64 `form:"application_fee_amount"`
 
-	Metadata map[string]string
+	// (Optional) Radar fraud signals collected client-side to evaluate the
+	// charge with.
+	RadarOptions *RadarOptions `form:"radar_options"`
+
+	// (Optional) The name and address the goods paid for are shipped to,
+	// which also helps Radar assess the charge.
+	Shipping *ShippingDetails `form:"shipping"`
+
+	// (Optional) The email address the receipt of the charge is sent to.
+	ReceiptEmail *string `form:"receipt_email"`
+
+	// (Optional) A string that identifies this charge as part of a group of
+	// charges and transfers, e.g. an order ID.
+	TransferGroup *string `form:"transfer_group"`
+
+	// (Optional) Itemized order data, for payments on corporate cards
+	// qualifying for lower interchange rates. Its line items and shipping
+	// must add up to the Amount.
+	Level3 *Level3 `form:"level3"`
+
+	Metadata map[string]string `form:"metadata"`
 }
 
 // ChargeClient encapsulates operations for creating, updating, deleting and
 // querying charges using the Stripe REST API.
-type ChargeClient struct{}
+type ChargeClient struct{ scope }
+
+// With returns a ChargeClient configured with the given options.
+func (c ChargeClient) With(opts ...ClientOption) ChargeClient {
+	c.apply(opts)
+	return c
+}
 
 // Creates a new credit card Charge.
 //
 // see https://stripe.com/docs/api#create_charge
-func (ChargeClient) Create(params *ChargeParams) (*Charge, error) {
-	charge := Charge{}
-	values := url.Values{
-		"amount":   {strconv.Itoa(params.Amount)},
-		"currency": {params.Currency},
+func (c ChargeClient) Create(params *ChargeParams) (*Charge, error) {
+	if err := params.Card.validate(); err != nil {
+		return nil, err
 	}
-
-	if params.Description != "" {
-		values.Add("description", params.Description)
+	if err := checkDescriptors(params.StatementDescriptor, params.StatementDescriptorSuffix); err != nil {
+		return nil, err
 	}
-	if params.Capture != nil && !*params.Capture {
-		values.Add("capture", "false")
+	if err := validateLevel3(params.Level3, params.Amount); err != nil {
+		return nil, err
 	}
-	if params.StatementDescription != "" {
-		values.Add("statement_description", params.StatementDescription)
-	}
-	appendMetadata(values, params.Metadata)
-
-	// add optional credit card details, if specified
-	if params.Card != nil {
-		appendCardParams(values, true, params.Card)
-	} else if len(params.Token) > 0 {
-		values.Add("card", params.Token)
-	} else {
-		// if no credit card is provide we need to specify the customer
-		values.Add("customer", params.Customer)
+	if err := validateConnect(params.Amount, params.ApplicationFeeAmount, params.TransferData); err != nil {
+		return nil, err
 	}
+	charge := Charge{}
+	values := formValues(params)
 
-	err := query("POST", "/charges", values, &charge)
+	err := c.query("POST", "/charges", values, &charge)
 	return &charge, err
 }
 
 // Retrieves the details of a charge with the given ID.
 //
 // see https://stripe.com/docs/api#retrieve_charge
-func (ChargeClient) Get(id string) (*Charge, error) {
+func (c ChargeClient) Get(id string) (*Charge, error) {
 	charge := Charge{}
 	path := "/charges/" + url.QueryEscape(id)
-	err := query("GET", path, nil, &charge)
+	err := c.query("GET", path, nil, &charge)
 	return &charge, err
 }
 
 // Refunds a charge for the full amount.
 //
 // see https://stripe.com/docs/api#refund_charge
-func (ChargeClient) Refund(id string) (*Charge, error) {
+func (c ChargeClient) Refund(id string) (*Charge, error) {
 	values := url.Values{}
 	charge := Charge{}
 	path := "/charges/" + url.QueryEscape(id) + "/refund"
-	err := query("POST", path, values, &charge)
+	err := c.query("POST", path, values, &charge)
 	return &charge, err
 }
 
 // Refunds a charge for the specified amount.
 //
 // see https://stripe.com/docs/api#refund_charge
-func (ChargeClient) RefundAmount(id string, amt int) (*Charge, error) {
+func (c ChargeClient) RefundAmount(id string, amt int64) (*Charge, error) {
+	values := url.Values{
+		"amount": {strconv.FormatInt(amt, 10)},
+	}
+	charge := Charge{}
+	path := "/charges/" + url.QueryEscape(id) + "/refund"
+	err := c.query("POST", path, values, &charge)
+	return &charge, err
+}
+
+// Refunds a charge for the full amount, giving the reason for the refund.
+// Refunding with the reason fraudulent also reports the charge as fraudulent
+// to Radar and adds the card and email to your block lists.
+//
+// see https://stripe.com/docs/api#refund_charge
+func (c ChargeClient) RefundReason(id string, reason RefundReason) (*Charge, error) {
 	values := url.Values{
-		"amount": {strconv.Itoa(amt)},
+		"reason": {string(reason)},
 	}
 	charge := Charge{}
 	path := "/charges/" + url.QueryEscape(id) + "/refund"
-	err := query("POST", path, values, &charge)
+	err := c.query("POST", path, values, &charge)
+	return &charge, err
+}
+
+// Reports a charge as fraudulent or safe, feeding the outcome of a manual
+// review back into Radar.
+//
+// see https://stripe.com/docs/api#update_charge-fraud_details
+func (c ChargeClient) ReportFraud(id, report string) (*Charge, error) {
+	values := url.Values{
+		"fraud_details[user_report]": {report},
+	}
+	charge := Charge{}
+	err := c.query("POST", "/charges/"+url.QueryEscape(id), values, &charge)
 	return &charge, err
 }
 
@@ -175,7 +500,24 @@ func (c ChargeClient) CustomerList(id string, limit int, before, after string) (
 	return c.list(id, limit, before, after)
 }
 
-func (ChargeClient) list(id string, limit int, before, after string) ([]*Charge, bool, error) {
+// Each calls fn with each Charge in the specified range as it is decoded,
+// without holding the whole page in memory, and reports whether more Charges
+// are available. An error returned by fn stops the listing and is returned.
+//
+// see https://stripe.com/docs/api#list_charges
+func (c ChargeClient) Each(limit int, before, after string, fn func(*Charge) error) (bool, error) {
+	return c.stream("/charges", listParams(limit, before, after), func(raw json.RawMessage) error {
+		charge := &Charge{}
+		if err := json.Unmarshal(raw, charge); err != nil {
+			return err
+		}
+		charge.setRawJSON(raw)
+		charge.normalizeVersion(c.versionFor())
+		return fn(charge)
+	})
+}
+
+func (c ChargeClient) list(id string, limit int, before, after string) ([]*Charge, bool, error) {
 	res := struct {
 		ListObject
 		Data []*Charge
@@ -184,6 +526,6 @@ func (ChargeClient) list(id string, limit int, before, after string) ([]*Charge,
 	if id != "" {
 		params.Add("customer", id)
 	}
-	err := query("GET", "/charges", params, &res)
+	err := c.query("GET", "/charges", params, &res)
 	return res.Data, res.More, err
 }
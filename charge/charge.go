@@ -0,0 +1,82 @@
+// Package charge is the Charges API of the stripe package, under a package of
+// its own so that code using it can refer to charge.Params and charge.Create
+// rather than the longer names the stripe package declares them with:
+//
+//	ch, err := charge.Create(&charge.Params{Amount: 400, Currency: stripe.USD, Customer: stripe.String("cus_1")})
+//
+// Its functions make requests with the package-level client, configured with
+// stripe.SetKey and the other Set functions. A Client configured otherwise is
+// returned by With, or taken from a stripe.Client created with NewClient.
+package charge
+
+import "github.com/cupcake/stripe"
+
+type (
+	// Charge is a stripe.Charge.
+	Charge = stripe.Charge
+
+	// Outcome is a stripe.ChargeOutcome.
+	Outcome = stripe.ChargeOutcome
+
+	// Params is a stripe.ChargeParams.
+	Params = stripe.ChargeParams
+
+	// Client is a stripe.ChargeClient.
+	Client = stripe.ChargeClient
+)
+
+// With returns a Client configured with the given options.
+func With(opts ...stripe.ClientOption) Client {
+	return stripe.Charges.With(opts...)
+}
+
+// Creates a new credit card Charge.
+//
+// see https://stripe.com/docs/api#create_charge
+func Create(params *Params) (*Charge, error) {
+	return stripe.Charges.Create(params)
+}
+
+// Retrieves the details of a charge with the given ID.
+//
+// see https://stripe.com/docs/api#retrieve_charge
+func Get(id string) (*Charge, error) {
+	return stripe.Charges.Get(id)
+}
+
+// Refunds a charge for the full amount.
+//
+// see https://stripe.com/docs/api#refund_charge
+func Refund(id string) (*Charge, error) {
+	return stripe.Charges.Refund(id)
+}
+
+// Refunds a charge for the specified amount.
+//
+// see https://stripe.com/docs/api#refund_charge
+func RefundAmount(id string, amt int64) (*Charge, error) {
+	return stripe.Charges.RefundAmount(id, amt)
+}
+
+// Returns a list of your Charges with the specified range.
+//
+// see https://stripe.com/docs/api#list_charges
+func List(limit int, before, after string) ([]*Charge, bool, error) {
+	return stripe.Charges.List(limit, before, after)
+}
+
+// Returns a list of your Charges with the given Customer ID.
+//
+// see https://stripe.com/docs/api#list_charges
+func CustomerList(id string, limit int, before, after string) ([]*Charge, bool, error) {
+	return stripe.Charges.CustomerList(id, limit, before, after)
+}
+
+// Each calls fn with each Charge in the specified range as it is decoded, and
+// reports whether more Charges are available, as stripe.ChargeClient.Each
+// does.
+//
+// see https://stripe.com/docs/api#list_charges
+func Each(limit int, before, after string, fn func(*Charge) error) (bool, error) {
+	return stripe.Charges.Each(limit, before, after, fn)
+}
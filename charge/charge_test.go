@@ -0,0 +1,45 @@
+package charge
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/cupcake/stripe"
+)
+
+// TestCreate will test that Create sends the Params through the stripe
+// package's Backend, and decodes the Charge it returns.
+func TestCreate(t *testing.T) {
+	var path string
+	var params url.Values
+	stripe.SetBackend(stripe.BackendFunc(func(method, p string, values url.Values, v interface{}) error {
+		path, params = method+" "+p, values
+		return json.Unmarshal([]byte(`{"id":"ch_1","amount":400}`), v)
+	}))
+	defer stripe.SetBackend(nil)
+
+	ch, err := Create(&Params{Amount: 400, Currency: stripe.USD, Customer: stripe.String("cus_1")})
+	if err != nil {
+		t.Fatalf("Expected Charge, got Error %s", err.Error())
+	}
+	if ch.ID != "ch_1" || ch.Amount != 400 {
+		t.Errorf("Expected Charge ch_1 of 400, got %s of %d", ch.ID, ch.Amount)
+	}
+	if path != "POST /charges" || params.Get("customer") != "cus_1" {
+		t.Errorf("Expected POST /charges for cus_1, got %s with %v", path, params)
+	}
+}
+
+// TestWith will test that the Client returned by With is configured with the
+// given options.
+func TestWith(t *testing.T) {
+	var called bool
+	backend := stripe.BackendFunc(func(method, path string, values url.Values, v interface{}) error {
+		called = true
+		return json.Unmarshal([]byte(`{"id":"ch_1"}`), v)
+	})
+	if _, err := With(stripe.WithBackend(backend)).Get("ch_1"); err != nil || !called {
+		t.Errorf("Expected the request to be sent through the Backend, got %v", err)
+	}
+}
@@ -1,6 +1,8 @@
 package stripe
 
 import (
+	"encoding/json"
+	"net/url"
 	"testing"
 	"time"
 )
@@ -18,11 +20,11 @@ var (
 
 	// Charge with only the required fields
 	charge1 = ChargeParams{
-		Description: "Calzone",
+		Description: String("Calzone"),
 		Amount:      400,
 		Currency:    USD,
 		Card: &CardParams{
-			Name:     "George Costanza",
+			Name:     String("George Costanza"),
 			Number:   "4242424242424242",
 			ExpYear:  time.Now().Year() + 1,
 			ExpMonth: 5,
@@ -41,8 +43,8 @@ func TestCreateCharge(t *testing.T) {
 	if err != nil {
 		t.Errorf("Expected Successful Charge, got Error %s", err.Error())
 	}
-	if resp.Description != charge1.Description {
-		t.Errorf("Expected Charge Desc %s, got %s", charge1.Description, resp.Description)
+	if resp.Description != *charge1.Description {
+		t.Errorf("Expected Charge Desc %s, got %s", *charge1.Description, resp.Description)
 	}
 	if resp.Amount != charge1.Amount {
 		t.Errorf("Expected Charge Amount %v, got %v", charge1.Amount, resp.Amount)
@@ -67,10 +69,10 @@ func TestCreateChargeToken(t *testing.T) {
 
 	// Create a Charge that uses a Token
 	charge := ChargeParams{
-		Description: "Calzone",
+		Description: String("Calzone"),
 		Amount:      400,
 		Currency:    USD,
-		Token:       token.ID,
+		Token:       String(token.ID),
 	}
 
 	// Create the charge
@@ -95,10 +97,10 @@ func TestCreateChargeCustomer(t *testing.T) {
 
 	// Create a Charge that uses a Token
 	charge := ChargeParams{
-		Description: "Calzone",
+		Description: String("Calzone"),
 		Amount:      400,
 		Currency:    USD,
-		Customer:    cust.ID,
+		Customer:    String(cust.ID),
 	}
 
 	// Create the charge
@@ -147,3 +149,90 @@ func TestRefundCharge(t *testing.T) {
 		return
 	}
 }
+
+// TestChargeOutcome will test that a Charge's Radar outcome is decoded, with
+// the rule given either as an ID or as an expanded object.
+func TestChargeOutcome(t *testing.T) {
+	resp := Charge{}
+	body := `{"id":"ch_1","outcome":{"network_status":"approved_by_network","risk_level":"elevated","risk_score":67,"rule":"rule_1","seller_message":"Payment complete.","type":"authorized"}}`
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("Expected Charge, got Error %s", err.Error())
+	}
+	if resp.Outcome.RiskLevel != RiskElevated || resp.Outcome.RiskScore != 67 {
+		t.Errorf("Expected risk elevated (67), got %s (%d)", resp.Outcome.RiskLevel, resp.Outcome.RiskScore)
+	}
+	if resp.Outcome.Rule.ID != "rule_1" {
+		t.Errorf("Expected Rule rule_1, got %s", resp.Outcome.Rule.ID)
+	}
+
+	body = `{"id":"ch_1","outcome":{"rule":{"id":"rule_2","action":"block","predicate":":risk_level: = 'highest'"}}}`
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("Expected Charge, got Error %s", err.Error())
+	}
+	if resp.Outcome.Rule.ID != "rule_2" || resp.Outcome.Rule.Action != "block" {
+		t.Errorf("Expected expanded Rule rule_2 (block), got %+v", resp.Outcome.Rule)
+	}
+}
+
+// TestChargePaymentMethodDetails will test that the billing, payment method
+// and receipt details of a Charge created from a PaymentIntent are decoded.
+func TestChargePaymentMethodDetails(t *testing.T) {
+	resp := Charge{}
+	body := `{"id":"ch_1","amount":2000,"amount_captured":2000,"captured":true,"paid":true,"refunded":false,"amount_refunded":500,
+		"payment_intent":"pi_1","payment_method":"pm_1",
+		"billing_details":{"name":"Jenny Rosen","email":"jenny@example.com","address":{"postal_code":"94111","country":"US"}},
+		"payment_method_details":{"type":"card","card":{"brand":"visa","last4":"4242","exp_month":12,"exp_year":2030,"network":"visa",
+			"checks":{"cvc_check":"pass","address_postal_code_check":"fail"},"three_d_secure":{"result":"authenticated","version":"2.2.0"}}},
+		"receipt_email":"jenny@example.com","receipt_number":"1234-5678","receipt_url":"https://pay.stripe.com/receipts/ch_1"}`
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("Expected Charge, got Error %s", err.Error())
+	}
+	if !resp.Captured || resp.AmountCaptured != 2000 || !resp.Paid || resp.AmountRefunded != 500 {
+		t.Errorf("Expected a captured, partially refunded Charge, got %+v", resp)
+	}
+	if resp.BillingDetails.Name != "Jenny Rosen" || resp.BillingDetails.Address.PostalCode != "94111" {
+		t.Errorf("Expected billing details, got %+v", resp.BillingDetails)
+	}
+	card := resp.PaymentMethodDetails.Card
+	if resp.PaymentMethodDetails.Type != PaymentMethodTypeCard || card.Checks.CVCCheck != "pass" || card.ThreeDSecure.Result != "authenticated" {
+		t.Errorf("Expected card details with checks, got %+v", card)
+	}
+	if resp.ReceiptNumber != "1234-5678" || resp.ReceiptURL != "https://pay.stripe.com/receipts/ch_1" {
+		t.Errorf("Expected receipt details, got %s and %s", resp.ReceiptNumber, resp.ReceiptURL)
+	}
+
+	body = `{"id":"ch_2","payment_method_details":{"type":"sepa_debit","sepa_debit":{"bank_code":"37040044","country":"DE","last4":"3000","mandate":"mandate_1"}}}`
+	resp = Charge{}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("Expected Charge, got Error %s", err.Error())
+	}
+	if d := resp.PaymentMethodDetails; d.Card != nil || d.SEPADebit.Last4 != "3000" || d.SEPADebit.Mandate != "mandate_1" {
+		t.Errorf("Expected SEPA Debit details only, got %+v", d)
+	}
+}
+
+// TestShippingAndReceiptEmail will test that the shipping details and receipt
+// email of a payment are sent with it, and decoded from the PaymentIntent.
+func TestShippingAndReceiptEmail(t *testing.T) {
+	var sent []url.Values
+	backend := BackendFunc(func(method, path string, params url.Values, v interface{}) error {
+		sent = append(sent, params)
+		return json.Unmarshal([]byte(`{"id":"pi_1","receipt_email":"jenny@example.com",
+			"shipping":{"name":"Jenny Rosen","address":{"line1":"1 Market St","city":"San Francisco","country":"US"},"carrier":"USPS"}}`), v)
+	})
+	shipping := &ShippingDetails{Name: "Jenny Rosen", Address: &Address{Line1: "1 Market St", City: "San Francisco", Country: "US"}, Carrier: "USPS"}
+
+	Charges.With(WithBackend(backend)).Create(&ChargeParams{Amount: 400, Currency: USD, Customer: String("cus_1"), Shipping: shipping, ReceiptEmail: String("jenny@example.com")})
+	pi, err := PaymentIntents.With(WithBackend(backend)).Create(&PaymentIntentParams{Amount: 400, Currency: USD, Shipping: shipping, ReceiptEmail: String("jenny@example.com")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, values := range sent {
+		if values.Get("receipt_email") != "jenny@example.com" || values.Get("shipping[address][line1]") != "1 Market St" || values.Get("shipping[carrier]") != "USPS" {
+			t.Errorf("Expected shipping details and receipt email, got %v", values)
+		}
+	}
+	if pi.ReceiptEmail != "jenny@example.com" || pi.Shipping.Address.City != "San Francisco" {
+		t.Errorf("Expected the PaymentIntent's shipping details and receipt email, got %+v", pi)
+	}
+}
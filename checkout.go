@@ -0,0 +1,165 @@
+package stripe
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// CheckoutSession represents a customer's session paying through a
+// Stripe-hosted Checkout page.
+//
+// see https://stripe.com/docs/api/checkout/sessions/object
+type CheckoutSession struct {
+	APIResource
+
+	ID                string                  `json:"id"`
+	AmountSubtotal    int64                   `json:"amount_subtotal"`
+	AmountTotal       int64                   `json:"amount_total"`
+	ClientReferenceID string                  `json:"client_reference_id,omitempty"`
+	Created           UnixTime                `json:"created"`
+	Currency          Currency                `json:"currency"`
+	Customer          ExpandableCustomer      `json:"customer"`
+	CustomerEmail     string                  `json:"customer_email,omitempty"`
+	LineItems         *CheckoutLineItemList   `json:"line_items,omitempty"`
+	Mode              string                  `json:"mode"`
+	PaymentIntent     ExpandablePaymentIntent `json:"payment_intent"`
+	PaymentStatus     string                  `json:"payment_status"`
+	Status            string                  `json:"status"`
+	Subscription      string                  `json:"subscription,omitempty"`
+	URL               string                  `json:"url,omitempty"`
+	Metadata          map[string]string       `json:"metadata,omitempty"`
+	Livemode          bool                    `json:"livemode"`
+
+	CustomerDetails *struct {
+		Email   string   `json:"email,omitempty"`
+		Name    string   `json:"name,omitempty"`
+		Phone   string   `json:"phone,omitempty"`
+		Address *Address `json:"address,omitempty"`
+	} `json:"customer_details,omitempty"`
+}
+
+// CheckoutLineItemList is a page of the items a customer paid for in a
+// Checkout Session.
+type CheckoutLineItemList struct {
+	ListObject
+	Data []*CheckoutLineItem `json:"data"`
+}
+
+// CheckoutLineItem is an item a customer paid for in a Checkout Session.
+type CheckoutLineItem struct {
+	ID             string   `json:"id"`
+	AmountSubtotal int64    `json:"amount_subtotal"`
+	AmountTotal    int64    `json:"amount_total"`
+	Currency       Currency `json:"currency"`
+	Description    string   `json:"description"`
+	Quantity       int64    `json:"quantity"`
+
+	Price *struct {
+		ID         string `json:"id"`
+		Product    string `json:"product"`
+		UnitAmount int64  `json:"unit_amount"`
+	} `json:"price,omitempty"`
+}
+
+// CheckoutFulfillment is everything needed to fulfill a completed Checkout
+// Session, retrieved by Fulfillment.
+type CheckoutFulfillment struct {
+	Session *CheckoutSession
+
+	// All of the items paid for.
+	LineItems []*CheckoutLineItem
+
+	// The PaymentIntent of a session in payment mode, or nil.
+	PaymentIntent *PaymentIntent
+
+	// The Customer, if the session created or used one, or nil.
+	Customer *Customer
+}
+
+// Paid reports whether the customer's payment succeeded, so the order can be
+// fulfilled. Sessions paid with delayed payment methods, such as bank
+// debits, complete before the payment succeeds.
+func (f *CheckoutFulfillment) Paid() bool {
+	return f.Session.PaymentStatus == "paid" || f.Session.PaymentStatus == "no_payment_required"
+}
+
+var NotCheckoutEventError = errors.New("stripe: event is not about a Checkout Session")
+
+// CheckoutSessionClient encapsulates operations for querying Checkout
+// Sessions using the Stripe REST API.
+type CheckoutSessionClient struct{ scope }
+
+// With returns a CheckoutSessionClient configured with the given options.
+func (c CheckoutSessionClient) With(opts ...ClientOption) CheckoutSessionClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Checkout Session with the given ID.
+//
+// see https://stripe.com/docs/api/checkout/sessions/retrieve
+func (c CheckoutSessionClient) Get(id string) (*CheckoutSession, error) {
+	res := &CheckoutSession{}
+	return res, c.query("GET", "/checkout/sessions/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of the items paid for in the Checkout Session with the given
+// ID, at the specified range.
+//
+// see https://stripe.com/docs/api/checkout/sessions/line_items
+func (c CheckoutSessionClient) ListLineItems(id string, limit int, before, after string) ([]*CheckoutLineItem, bool, error) {
+	res := &CheckoutLineItemList{}
+	path := "/checkout/sessions/" + url.QueryEscape(id) + "/line_items"
+	err := c.query("GET", path, listParams(limit, before, after), res)
+	return res.Data, res.More, err
+}
+
+// Fulfillment retrieves the Checkout Session with the given ID along with its
+// line items, PaymentIntent and Customer, expanded in a single request, for
+// fulfilling the order. Line items beyond the first page are listed with
+// further requests.
+func (c CheckoutSessionClient) Fulfillment(id string) (*CheckoutFulfillment, error) {
+	values := url.Values{"expand[]": {"line_items", "payment_intent", "customer"}}
+	session := &CheckoutSession{}
+	if err := c.query("GET", "/checkout/sessions/"+url.QueryEscape(id), values, session); err != nil {
+		return nil, err
+	}
+	f := &CheckoutFulfillment{
+		Session:       session,
+		PaymentIntent: session.PaymentIntent.Object(),
+		Customer:      session.Customer.Object(),
+	}
+	if items := session.LineItems; items != nil {
+		f.LineItems = items.Data
+		for more := items.More; more && len(f.LineItems) > 0; {
+			var page []*CheckoutLineItem
+			var err error
+			page, more, err = c.ListLineItems(id, 100, "", f.LineItems[len(f.LineItems)-1].ID)
+			if err != nil {
+				return nil, err
+			}
+			if len(page) == 0 {
+				break
+			}
+			f.LineItems = append(f.LineItems, page...)
+		}
+	}
+	return f, nil
+}
+
+// FulfillmentFor retrieves the Fulfillment of the Checkout Session an event
+// such as checkout.session.completed or
+// checkout.session.async_payment_succeeded is about. The session is retrieved
+// again rather than read from the event, so it is up to date even if events
+// arrive out of order. NotCheckoutEventError is returned for other events.
+func (c CheckoutSessionClient) FulfillmentFor(e *Event) (*CheckoutFulfillment, error) {
+	if !strings.HasPrefix(e.Type, "checkout.session.") {
+		return nil, NotCheckoutEventError
+	}
+	var session struct{ ID string }
+	if err := e.Decode(&session); err != nil {
+		return nil, err
+	}
+	return c.Fulfillment(session.ID)
+}
@@ -0,0 +1,47 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckoutFulfillment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/checkout/sessions/cs_1":
+			if expand := r.URL.Query()["expand[]"]; len(expand) != 3 {
+				t.Errorf("Expected line items, payment intent and customer to be expanded, got %v", expand)
+			}
+			w.Write([]byte(`{"id":"cs_1","payment_status":"paid",
+				"customer":{"id":"cus_1","email":"jo@example.com"},
+				"payment_intent":{"id":"pi_1","status":"succeeded"},
+				"line_items":{"has_more":true,"data":[{"id":"li_1","quantity":1}]}}`))
+		case "/v1/checkout/sessions/cs_1/line_items":
+			if after := r.URL.Query().Get("starting_after"); after != "li_1" {
+				t.Errorf("Expected line items after li_1, got %q", after)
+			}
+			w.Write([]byte(`{"has_more":false,"data":[{"id":"li_2","quantity":2}]}`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	e := &Event{Type: "checkout.session.completed", Data: EventData{Object: json.RawMessage(`{"id":"cs_1","object":"checkout.session"}`)}}
+	f, err := CheckoutSessions.With(WithBaseURL(server.URL)).FulfillmentFor(e)
+	if err != nil {
+		t.Fatalf("Expected the fulfillment, got %v", err)
+	}
+	if !f.Paid() || f.Customer == nil || f.Customer.ID != "cus_1" || f.PaymentIntent == nil || f.PaymentIntent.ID != "pi_1" {
+		t.Errorf("Expected paid session with customer and payment intent, got %+v", f)
+	}
+	if len(f.LineItems) != 2 || f.LineItems[1].ID != "li_2" {
+		t.Errorf("Expected both pages of line items, got %v", f.LineItems)
+	}
+
+	if _, err := CheckoutSessions.With(WithBaseURL(server.URL)).FulfillmentFor(&Event{Type: "charge.succeeded"}); err != NotCheckoutEventError {
+		t.Errorf("Expected NotCheckoutEventError, got %v", err)
+	}
+}
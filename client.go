@@ -0,0 +1,327 @@
+package stripe
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Client holds a client for each of the APIs, which all authenticate with the
+// same API key and share the configuration given to NewClient, so that
+// several Stripe accounts, or differently configured clients, can be used in
+// one program, unlike the package-level clients configured with SetKey and
+// the other Set functions.
+type Client struct {
+	AccountLinks                 AccountLinkClient
+	AccountSessions              AccountSessionClient
+	Accounts                     AccountClient
+	ApplePayDomains              ApplePayDomainClient
+	ApplicationFees              ApplicationFeeClient
+	BalanceTransactions          BalanceTransactionClient
+	Balances                     BalanceClient
+	Capabilities                 CapabilityClient
+	Cards                        CardClient
+	Charges                      ChargeClient
+	CheckoutSessions             CheckoutSessionClient
+	ClimateOrders                ClimateOrderClient
+	ClimateProducts              ClimateProductClient
+	ClimateSuppliers             ClimateSupplierClient
+	ConnectionTokens             ConnectionTokenClient
+	CountrySpecs                 CountrySpecClient
+	Coupons                      CouponClient
+	CustomerSessions             CustomerSessionClient
+	Customers                    CustomerClient
+	Disputes                     DisputeClient
+	EarlyFraudWarnings           EarlyFraudWarningClient
+	EphemeralKeys                EphemeralKeyClient
+	Events                       EventClient
+	ExchangeRates                ExchangeRateClient
+	ExternalAccounts             ExternalAccountClient
+	FeeRefunds                   FeeRefundClient
+	FileLinks                    FileLinkClient
+	Files                        FileClient
+	FinancialAccounts            FinancialAccountClient
+	FinancialConnectionsAccounts FinancialConnectionsAccountClient
+	FinancialConnectionsSessions FinancialConnectionsSessionClient
+	InboundTransfers             InboundTransferClient
+	InvoiceItems                 InvoiceItemClient
+	Invoices                     InvoiceClient
+	IssuingAuthorizations        IssuingAuthorizationClient
+	IssuingCardholders           IssuingCardholderClient
+	IssuingCards                 IssuingCardClient
+	IssuingDisputes              IssuingDisputeClient
+	IssuingTransactions          IssuingTransactionClient
+	Mandates                     MandateClient
+	MeterEvents                  MeterEventClient
+	Meters                       MeterClient
+	OAuth                        OAuthClient
+	OutboundPayments             OutboundPaymentClient
+	OutboundTransfers            OutboundTransferClient
+	PaymentIntents               PaymentIntentClient
+	PaymentMethodConfigurations  PaymentMethodConfigurationClient
+	PaymentMethodDomains         PaymentMethodDomainClient
+	PaymentMethods               PaymentMethodClient
+	Payouts                      PayoutClient
+	Persons                      PersonClient
+	Plans                        PlanClient
+	Quotes                       QuoteClient
+	RadarSessions                RadarSessionClient
+	ReceivedCredits              ReceivedCreditClient
+	ReceivedDebits               ReceivedDebitClient
+	ReportRuns                   ReportRunClient
+	ReportTypes                  ReportTypeClient
+	Reversals                    ReversalClient
+	Reviews                      ReviewClient
+	ScheduledQueryRuns           ScheduledQueryRunClient
+	Subscriptions                SubscriptionClient
+	TaxCalculations              TaxCalculationClient
+	TaxRegistrations             TaxRegistrationClient
+	TaxSettings                  TaxSettingsClient
+	TaxTransactions              TaxTransactionClient
+	TerminalLocations            TerminalLocationClient
+	TerminalReaders              TerminalReaderClient
+	TestClocks                   TestClockClient
+	Tokens                       TokenClient
+	Topups                       TopupClient
+	Transfers                    TransferClient
+	TreasuryTransactions         TreasuryTransactionClient
+	V2Events                     V2EventClient
+	ValueListItems               ValueListItemClient
+	ValueLists                   ValueListClient
+	VerificationReports          VerificationReportClient
+	VerificationSessions         VerificationSessionClient
+
+	// the configuration the clients were created with, for requests the
+	// Client makes itself, such as Ping
+	scope scope
+}
+
+// ClientOption configures a Client created with NewClient, or a single
+// client returned by its With method:
+//
+//	charge, err := stripe.Charges.With(stripe.WithAccount("acct_1"), stripe.WithIdempotencyKey(key)).Create(&params)
+//
+// Configuration it does not set is taken from the package-level defaults.
+type ClientOption func(*scope)
+
+// NewClient returns a Client that authenticates its requests with key and is
+// configured with the given options:
+//
+//	sc := stripe.NewClient(key, stripe.WithRetries(3), stripe.WithVersion("2024-06-20"))
+//	charge, err := sc.Charges.Get("ch_1")
+//
+// Each of its clients can be narrowed further with its With method.
+func NewClient(key string, opts ...ClientOption) *Client {
+	s := scope{key: key}
+	s.apply(opts)
+	return &Client{
+		AccountLinks:                 AccountLinkClient{s},
+		AccountSessions:              AccountSessionClient{s},
+		Accounts:                     AccountClient{s},
+		ApplePayDomains:              ApplePayDomainClient{s},
+		ApplicationFees:              ApplicationFeeClient{s},
+		BalanceTransactions:          BalanceTransactionClient{s},
+		Balances:                     BalanceClient{s},
+		Capabilities:                 CapabilityClient{s},
+		Cards:                        CardClient{s},
+		Charges:                      ChargeClient{s},
+		CheckoutSessions:             CheckoutSessionClient{s},
+		ClimateOrders:                ClimateOrderClient{s},
+		ClimateProducts:              ClimateProductClient{s},
+		ClimateSuppliers:             ClimateSupplierClient{s},
+		ConnectionTokens:             ConnectionTokenClient{s},
+		CountrySpecs:                 CountrySpecClient{s},
+		Coupons:                      CouponClient{s},
+		CustomerSessions:             CustomerSessionClient{s},
+		Customers:                    CustomerClient{s},
+		Disputes:                     DisputeClient{s},
+		EarlyFraudWarnings:           EarlyFraudWarningClient{s},
+		EphemeralKeys:                EphemeralKeyClient{s},
+		Events:                       EventClient{s},
+		ExchangeRates:                ExchangeRateClient{s},
+		ExternalAccounts:             ExternalAccountClient{s},
+		FeeRefunds:                   FeeRefundClient{s},
+		FileLinks:                    FileLinkClient{s},
+		Files:                        FileClient{s},
+		FinancialAccounts:            FinancialAccountClient{s},
+		FinancialConnectionsAccounts: FinancialConnectionsAccountClient{s},
+		FinancialConnectionsSessions: FinancialConnectionsSessionClient{s},
+		InboundTransfers:             InboundTransferClient{s},
+		InvoiceItems:                 InvoiceItemClient{s},
+		Invoices:                     InvoiceClient{s},
+		IssuingAuthorizations:        IssuingAuthorizationClient{s},
+		IssuingCardholders:           IssuingCardholderClient{s},
+		IssuingCards:                 IssuingCardClient{s},
+		IssuingDisputes:              IssuingDisputeClient{s},
+		IssuingTransactions:          IssuingTransactionClient{s},
+		Mandates:                     MandateClient{s},
+		MeterEvents:                  MeterEventClient{s},
+		Meters:                       MeterClient{s},
+		OAuth:                        OAuthClient{s},
+		OutboundPayments:             OutboundPaymentClient{s},
+		OutboundTransfers:            OutboundTransferClient{s},
+		PaymentIntents:               PaymentIntentClient{s},
+		PaymentMethodConfigurations:  PaymentMethodConfigurationClient{s},
+		PaymentMethodDomains:         PaymentMethodDomainClient{s},
+		PaymentMethods:               PaymentMethodClient{s},
+		Payouts:                      PayoutClient{s},
+		Persons:                      PersonClient{s},
+		Plans:                        PlanClient{s},
+		Quotes:                       QuoteClient{s},
+		RadarSessions:                RadarSessionClient{s},
+		ReceivedCredits:              ReceivedCreditClient{s},
+		ReceivedDebits:               ReceivedDebitClient{s},
+		ReportRuns:                   ReportRunClient{s},
+		ReportTypes:                  ReportTypeClient{s},
+		Reversals:                    ReversalClient{s},
+		Reviews:                      ReviewClient{s},
+		ScheduledQueryRuns:           ScheduledQueryRunClient{s},
+		Subscriptions:                SubscriptionClient{s},
+		TaxCalculations:              TaxCalculationClient{s},
+		TaxRegistrations:             TaxRegistrationClient{s},
+		TaxSettings:                  TaxSettingsClient{s},
+		TaxTransactions:              TaxTransactionClient{s},
+		TerminalLocations:            TerminalLocationClient{s},
+		TerminalReaders:              TerminalReaderClient{s},
+		TestClocks:                   TestClockClient{s},
+		Tokens:                       TokenClient{s},
+		Topups:                       TopupClient{s},
+		Transfers:                    TransferClient{s},
+		TreasuryTransactions:         TreasuryTransactionClient{s},
+		V2Events:                     V2EventClient{s},
+		ValueListItems:               ValueListItemClient{s},
+		ValueLists:                   ValueListClient{s},
+		VerificationReports:          VerificationReportClient{s},
+		VerificationSessions:         VerificationSessionClient{s},
+
+		scope: s,
+	}
+}
+
+// WithHTTPClient returns a ClientOption that sends requests with c rather than
+// the http.Client set with SetHTTPClient.
+func WithHTTPClient(c *http.Client) ClientOption {
+	return func(s *scope) {
+		s.httpClient = c
+	}
+}
+
+// WithRetries returns a ClientOption that retries failed requests up to n
+// times, as SetMaxRetries does for the package-level clients.
+func WithRetries(n int) ClientOption {
+	return func(s *scope) {
+		s.maxRetries = &n
+	}
+}
+
+// WithVersion returns a ClientOption that sends requests with the given
+// Stripe-Version rather than the one set with SetAPIVersion.
+func WithVersion(version string) ClientOption {
+	return func(s *scope) {
+		s.version = version
+	}
+}
+
+// WithAccount returns a ClientOption that makes requests on behalf of the
+// connected account with the given ID, as SetAccount does for the
+// package-level clients.
+func WithAccount(id string) ClientOption {
+	return func(s *scope) {
+		s.account = id
+	}
+}
+
+// WithFallbackKey returns a ClientOption that sends requests Stripe rejects
+// as unauthorized again with key, as SetFallbackKey does for the
+// package-level clients.
+func WithFallbackKey(key string) ClientOption {
+	return func(s *scope) {
+		s.fallback = key
+	}
+}
+
+// RequireTestMode returns a ClientOption that refuses to send requests with a
+// live mode key, as SetRequireTestMode does for the package-level clients.
+func RequireTestMode() ClientOption {
+	return func(s *scope) {
+		s.requireTestMode = true
+	}
+}
+
+// WithBackend returns a ClientOption that sends requests through b, e.g. a
+// BackendFunc in tests, rather than to the Stripe API.
+func WithBackend(b Backend) ClientOption {
+	return func(s *scope) {
+		s.backend = b
+	}
+}
+
+// WithBaseURL returns a ClientOption that sends API and file requests to the
+// given base URL, e.g. that of a mock server, rather than Stripe's.
+func WithBaseURL(url string) ClientOption {
+	return func(s *scope) {
+		s.url = url
+	}
+}
+
+// WithCoalescing returns a ClientOption that coalesces identical requests to
+// retrieve or list objects made at the same time, e.g. from many goroutines
+// looking up the same object, into a single request to the Stripe API whose
+// response is shared between them.
+func WithCoalescing() ClientOption {
+	return func(s *scope) {
+		s.coalesce = true
+	}
+}
+
+// WithContext returns a ClientOption that makes requests with ctx, so they
+// are cancelled once it is done and are part of the trace it carries.
+func WithContext(ctx context.Context) ClientOption {
+	return func(s *scope) {
+		s.ctx = ctx
+	}
+}
+
+// WithTimeout returns a ClientOption that cancels requests taking longer than
+// d, such as a short timeout for interactive calls or a longer one for uploads
+// and downloads.
+func WithTimeout(d time.Duration) ClientOption {
+	return func(s *scope) {
+		s.timeout = d
+	}
+}
+
+// WithIdempotencyKey returns a ClientOption that sends the given
+// Idempotency-Key with POST requests, so that repeating a request, e.g. after
+// a timeout, returns the original result instead of acting twice.
+func WithIdempotencyKey(key string) ClientOption {
+	return func(s *scope) {
+		s.idempotencyKey = key
+	}
+}
+
+// WithHeader returns a ClientOption that sends the given header with
+// requests, replacing any the library sets of the same name, e.g. a
+// Stripe-Version enabling a beta feature, or a header routing requests through
+// a proxy.
+func WithHeader(key, value string) ClientOption {
+	return func(s *scope) {
+		s.headers = s.withHeader(key, value)
+	}
+}
+
+// WithServiceURL returns a ClientOption that sends requests to the given
+// service, e.g. FilesService, to the given base URL, such as a gateway or mock
+// for that service alone. It takes precedence over WithBaseURL.
+func WithServiceURL(svc Service, url string) ClientOption {
+	return func(s *scope) {
+		s.serviceURLs = s.withServiceURL(svc, url)
+	}
+}
+
+// apply configures the scope with each of the options in turn.
+func (s *scope) apply(opts []ClientOption) {
+	for _, opt := range opts {
+		opt(s)
+	}
+}
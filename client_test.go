@@ -0,0 +1,58 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestNewClient will test that a Client's requests are made with its own key,
+// version, account, retries and http.Client rather than the defaults.
+func TestNewClient(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if got := r.Header.Get("Authorization"); got != "Bearer sk_test_client" {
+			t.Errorf("Expected the client's key, got %s", got)
+		}
+		if got := r.Header.Get("Stripe-Version"); got != "2024-06-20" {
+			t.Errorf("Expected the client's version, got %s", got)
+		}
+		if got := r.Header.Get("Stripe-Account"); got != "acct_1" {
+			t.Errorf("Expected the client's account, got %s", got)
+		}
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error":{"type":"api_error","message":"try again"}}`))
+			return
+		}
+		w.Write([]byte(`{"id":"ch_1"}`))
+	}))
+	defer server.Close()
+
+	var sent int
+	httpClient := &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		sent++
+		return http.DefaultTransport.RoundTrip(r)
+	})}
+	sc := NewClient("sk_test_client", WithBaseURL(server.URL), WithHTTPClient(httpClient),
+		WithRetries(1), WithVersion("2024-06-20"), WithAccount("acct_1"))
+	charge, err := sc.Charges.Get("ch_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if charge.ID != "ch_1" || attempts != 2 || sent != 2 {
+		t.Errorf("Expected the charge after a retry with the client's http.Client, got %s after %d attempts and %d sent", charge.ID, attempts, sent)
+	}
+
+	// the package-level clients are unaffected
+	if _maxRetries != 0 || (scope{}).client() != _httpClient {
+		t.Errorf("Expected the defaults to be unchanged")
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -0,0 +1,233 @@
+package stripe
+
+import "net/url"
+
+// Climate Order Statuses
+const (
+	ClimateOrderAwaitingFunds = "awaiting_funds"
+	ClimateOrderConfirmed     = "confirmed"
+	ClimateOrderDelivered     = "delivered"
+	ClimateOrderCanceled      = "canceled"
+	ClimateOrderOpen          = "open"
+)
+
+// ClimateOrder represents a purchase of carbon removal through Stripe
+// Climate.
+//
+// see https://stripe.com/docs/api/climate/order/object
+type ClimateOrder struct {
+	APIResource
+
+	ID                   string              `json:"id"`
+	AmountFees           int64               `json:"amount_fees"`
+	AmountSubtotal       int64               `json:"amount_subtotal"`
+	AmountTotal          int64               `json:"amount_total"`
+	Beneficiary          *ClimateBeneficiary `json:"beneficiary,omitempty"`
+	CanceledAt           *UnixTime           `json:"canceled_at,omitempty"`
+	CancellationReason   string              `json:"cancellation_reason,omitempty"`
+	Certificate          string              `json:"certificate,omitempty"`
+	ConfirmedAt          *UnixTime           `json:"confirmed_at,omitempty"`
+	Created              UnixTime            `json:"created"`
+	Currency             Currency            `json:"currency"`
+	DelayedAt            *UnixTime           `json:"delayed_at,omitempty"`
+	DeliveredAt          *UnixTime           `json:"delivered_at,omitempty"`
+	ExpectedDeliveryYear int                 `json:"expected_delivery_year"`
+	MetricTons           string              `json:"metric_tons"`
+	Product              string              `json:"product"`
+	ProductSubstitutedAt *UnixTime           `json:"product_substituted_at,omitempty"`
+	Status               string              `json:"status"`
+	Metadata             map[string]string   `json:"metadata,omitempty"`
+	Livemode             bool                `json:"livemode"`
+}
+
+// ClimateBeneficiary is the publicly displayed name credited with a
+// ClimateOrder.
+type ClimateBeneficiary struct {
+	PublicName string `json:"public_name" form:"public_name"`
+}
+
+// ClimateOrderParams encapsulates options for creating and updating Climate
+// Orders.
+type ClimateOrderParams struct {
+	Params
+
+	// The ID of the Climate Product to order. Can only be set on creation.
+	Product string `form:"product"`
+
+	// The quantity to order, as a decimal string e.g. "0.5". Either MetricTons
+	// or Amount is required on creation.
+	MetricTons string `form:"metric_tons"`
+
+	// The amount to spend, in the smallest unit of Currency, which determines
+	// the quantity ordered.
+	Amount   int64    `form:"amount"`
+	Currency Currency `form:"currency"`
+
+	// (Optional) The name credited with the order.
+	Beneficiary *ClimateBeneficiary `form:"beneficiary"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// ClimateOrderClient encapsulates operations for creating, cancelling and
+// querying carbon removal orders using the Stripe REST API.
+type ClimateOrderClient struct{ scope }
+
+// With returns a ClimateOrderClient configured with the given options.
+func (c ClimateOrderClient) With(opts ...ClientOption) ClimateOrderClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Climate Order.
+//
+// see https://stripe.com/docs/api/climate/order/create
+func (c ClimateOrderClient) Create(params *ClimateOrderParams) (*ClimateOrder, error) {
+	res := &ClimateOrder{}
+	return res, c.query("POST", "/climate/orders", formValues(params), res)
+}
+
+// Retrieves the Climate Order with the given ID.
+//
+// see https://stripe.com/docs/api/climate/order/retrieve
+func (c ClimateOrderClient) Get(id string) (*ClimateOrder, error) {
+	res := &ClimateOrder{}
+	return res, c.query("GET", "/climate/orders/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the beneficiary or metadata of the Climate Order with the given ID.
+//
+// see https://stripe.com/docs/api/climate/order/update
+func (c ClimateOrderClient) Update(id string, params *ClimateOrderParams) (*ClimateOrder, error) {
+	res := &ClimateOrder{}
+	return res, c.query("POST", "/climate/orders/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Cancels the Climate Order with the given ID. Orders can only be canceled
+// before they are confirmed with the supplier.
+//
+// see https://stripe.com/docs/api/climate/order/cancel
+func (c ClimateOrderClient) Cancel(id string) (*ClimateOrder, error) {
+	res := &ClimateOrder{}
+	return res, c.query("POST", "/climate/orders/"+url.QueryEscape(id)+"/cancel", nil, res)
+}
+
+// Returns a list of your Climate Orders with the specified range.
+//
+// see https://stripe.com/docs/api/climate/order/list
+func (c ClimateOrderClient) List(limit int, before, after string) ([]*ClimateOrder, bool, error) {
+	res := struct {
+		ListObject
+		Data []*ClimateOrder
+	}{}
+	err := c.query("GET", "/climate/orders", listParams(limit, before, after), &res)
+	return res.Data, res.More, err
+}
+
+// ClimateProduct represents a carbon removal unit that can be ordered, with
+// its current price and availability.
+//
+// see https://stripe.com/docs/api/climate/product/object
+type ClimateProduct struct {
+	APIResource
+
+	ID                        string                  `json:"id"`
+	Created                   UnixTime                `json:"created"`
+	CurrentPricesPerMetricTon map[string]ClimatePrice `json:"current_prices_per_metric_ton"`
+	DeliveryYear              int                     `json:"delivery_year,omitempty"`
+	MetricTonsAvailable       string                  `json:"metric_tons_available"`
+	Name                      string                  `json:"name"`
+	Suppliers                 []*ClimateSupplier      `json:"suppliers"`
+	Livemode                  bool                    `json:"livemode"`
+}
+
+// ClimatePrice is the price of one metric ton of a ClimateProduct in a single
+// currency.
+type ClimatePrice struct {
+	AmountFees     int64 `json:"amount_fees"`
+	AmountSubtotal int64 `json:"amount_subtotal"`
+	AmountTotal    int64 `json:"amount_total"`
+}
+
+// ClimateSupplier represents a carbon removal supplier.
+//
+// see https://stripe.com/docs/api/climate/supplier/object
+type ClimateSupplier struct {
+	APIResource
+
+	ID             string            `json:"id"`
+	InfoURL        string            `json:"info_url"`
+	Locations      []ClimateLocation `json:"locations"`
+	Name           string            `json:"name"`
+	RemovalPathway string            `json:"removal_pathway"`
+	Livemode       bool              `json:"livemode"`
+}
+
+// ClimateLocation is a place where a ClimateSupplier removes carbon.
+type ClimateLocation struct {
+	City      string  `json:"city,omitempty"`
+	Country   string  `json:"country"`
+	Latitude  float64 `json:"latitude,omitempty"`
+	Longitude float64 `json:"longitude,omitempty"`
+	Region    string  `json:"region,omitempty"`
+}
+
+// ClimateProductClient encapsulates operations for querying carbon removal
+// products using the Stripe REST API.
+type ClimateProductClient struct{ scope }
+
+// With returns a ClimateProductClient configured with the given options.
+func (c ClimateProductClient) With(opts ...ClientOption) ClimateProductClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Climate Product with the given ID.
+//
+// see https://stripe.com/docs/api/climate/product/retrieve
+func (c ClimateProductClient) Get(id string) (*ClimateProduct, error) {
+	res := &ClimateProduct{}
+	return res, c.query("GET", "/climate/products/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of the Climate Products available to order.
+//
+// see https://stripe.com/docs/api/climate/product/list
+func (c ClimateProductClient) List(limit int, before, after string) ([]*ClimateProduct, bool, error) {
+	res := struct {
+		ListObject
+		Data []*ClimateProduct
+	}{}
+	err := c.query("GET", "/climate/products", listParams(limit, before, after), &res)
+	return res.Data, res.More, err
+}
+
+// ClimateSupplierClient encapsulates operations for querying carbon removal
+// suppliers using the Stripe REST API.
+type ClimateSupplierClient struct{ scope }
+
+// With returns a ClimateSupplierClient configured with the given options.
+func (c ClimateSupplierClient) With(opts ...ClientOption) ClimateSupplierClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Climate Supplier with the given ID.
+//
+// see https://stripe.com/docs/api/climate/supplier/retrieve
+func (c ClimateSupplierClient) Get(id string) (*ClimateSupplier, error) {
+	res := &ClimateSupplier{}
+	return res, c.query("GET", "/climate/suppliers/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of Climate Suppliers.
+//
+// see https://stripe.com/docs/api/climate/supplier/list
+func (c ClimateSupplierClient) List(limit int, before, after string) ([]*ClimateSupplier, bool, error) {
+	res := struct {
+		ListObject
+		Data []*ClimateSupplier
+	}{}
+	err := c.query("GET", "/climate/suppliers", listParams(limit, before, after), &res)
+	return res.Data, res.More, err
+}
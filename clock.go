@@ -0,0 +1,41 @@
+package stripe
+
+import "time"
+
+// Clock tells the time for the checks and waits that depend on it: webhook
+// signature tolerance, cache expiry, retry backoff, and polling for report
+// runs and test clocks. Tests can set one they control, such as
+// stripetest.Clock, instead of sleeping.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After returns a channel that receives the current time once d has
+	// passed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// realClock is the Clock of the time package.
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// the Clock used for all time-dependent logic
+var _clock Clock = realClock{}
+
+// SetClock will set the Clock used for all time-dependent logic. Passing nil
+// restores the system clock.
+func SetClock(c Clock) {
+	if c == nil {
+		c = realClock{}
+	}
+	_clock = c
+}
+
+// Now returns the current time of the Clock set with SetClock, for code
+// outside the package, such as test helpers signing webhooks, to agree with
+// its time-dependent checks.
+func Now() time.Time {
+	return _clock.Now()
+}
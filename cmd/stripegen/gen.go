@@ -0,0 +1,513 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Spec is the subset of an OpenAPI 3 document that stripegen reads.
+type Spec struct {
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	} `json:"components"`
+	Paths map[string]map[string]*Operation `json:"paths"`
+}
+
+// Operation is a single method of an API path.
+type Operation struct {
+	RequestBody struct {
+		Content map[string]struct {
+			Schema *Schema `json:"schema"`
+		} `json:"content"`
+	} `json:"requestBody"`
+	Responses map[string]struct {
+		Content map[string]struct {
+			Schema *Schema `json:"schema"`
+		} `json:"content"`
+	} `json:"responses"`
+}
+
+// Schema describes a resource, a field of a resource, or a parameter.
+type Schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Format               string             `json:"format"`
+	Description          string             `json:"description"`
+	Nullable             bool               `json:"nullable"`
+	Enum                 []interface{}      `json:"enum"`
+	Properties           map[string]*Schema `json:"properties"`
+	Required             []string           `json:"required"`
+	Items                *Schema            `json:"items"`
+	AnyOf                []*Schema          `json:"anyOf"`
+	AdditionalProperties interface{}        `json:"additionalProperties"`
+	ResourceID           string             `json:"x-resourceId"`
+}
+
+// refName returns the name of the schema a $ref points to.
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// generator writes the Go declarations for a set of resources. Types,
+// constants and Expandable wrappers already declared by hand in the package
+// are referenced instead of being generated again.
+type generator struct {
+	spec     *Spec
+	pkg      string
+	existing map[string]bool
+
+	buf     bytes.Buffer
+	done    map[string]bool
+	pending []string
+	objects []pendingType
+	nested  []pendingType
+	enums   []pendingType
+	imports map[string]bool
+}
+
+// pendingType is a nested object, a list of objects or an enum, waiting to
+// be declared after the type that uses it.
+type pendingType struct {
+	name   string
+	schema *Schema
+	list   bool
+}
+
+// Generate returns the formatted Go source declaring the structs, params and
+// enum constants of the given resources, e.g. "tax_rate", along with the
+// schemas they reference that the package does not declare yet.
+func Generate(spec *Spec, pkg string, existing map[string]bool, resources []string) ([]byte, error) {
+	g := &generator{
+		spec:     spec,
+		pkg:      pkg,
+		existing: existing,
+		done:     make(map[string]bool),
+		imports:  make(map[string]bool),
+	}
+	for _, id := range resources {
+		schema, ok := spec.Components.Schemas[id]
+		if !ok {
+			return nil, fmt.Errorf("stripegen: no schema for resource %q", id)
+		}
+		g.resource(id, schema)
+		g.params(id)
+	}
+	for len(g.pending) > 0 {
+		id := g.pending[0]
+		g.pending = g.pending[1:]
+		g.object(typeName(id), "", g.spec.Components.Schemas[id], false)
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by stripegen from Stripe's OpenAPI spec. DO NOT EDIT.\n\npackage %s\n\n", g.pkg)
+	if len(g.imports) > 0 {
+		var imports []string
+		for imp := range g.imports {
+			imports = append(imports, fmt.Sprintf("%q", imp))
+		}
+		sort.Strings(imports)
+		fmt.Fprintf(&out, "import (\n%s\n)\n\n", strings.Join(imports, "\n"))
+	}
+	out.Write(g.buf.Bytes())
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return out.Bytes(), fmt.Errorf("stripegen: generated invalid source: %v", err)
+	}
+	return src, nil
+}
+
+func (g *generator) resource(id string, schema *Schema) {
+	name := typeName(id)
+	g.done[id] = true
+	if g.existing[name] {
+		return
+	}
+	g.object(name, fmt.Sprintf("%s is generated from the %s resource. %s", name, id, firstSentence(schema.Description)), schema, true)
+}
+
+// object declares the struct type name for the object schema. Resources
+// embed APIResource so their raw JSON is kept.
+func (g *generator) object(name, doc string, schema *Schema, resource bool) {
+	if g.existing[name] || g.done["type "+name] {
+		return
+	}
+	g.done["type "+name] = true
+
+	if doc == "" {
+		doc = name + " is generated from Stripe's OpenAPI spec. " + firstSentence(schema.Description)
+	}
+	comment(&g.buf, "", doc)
+	fmt.Fprintf(&g.buf, "type %s struct {\n", name)
+	if resource {
+		fmt.Fprintf(&g.buf, "\tAPIResource\n\n")
+	}
+	required := set(schema.Required)
+	for _, prop := range sortedKeys(schema.Properties) {
+		if prop == "object" {
+			continue
+		}
+		field := schema.Properties[prop]
+		typ := g.fieldType(name+fieldName(prop), field)
+		tag := prop
+		if field.Nullable || !required[prop] {
+			tag += ",omitempty"
+		}
+		comment(&g.buf, "\t", field.Description)
+		fmt.Fprintf(&g.buf, "\t%s %s `json:%q`\n", fieldName(prop), typ, tag)
+	}
+	fmt.Fprintf(&g.buf, "}\n\n")
+
+	// as are the types of its enum fields, and nested objects
+	for len(g.enums) > 0 {
+		t := g.enums[0]
+		g.enums = g.enums[1:]
+		g.enum(t.name, t.schema)
+	}
+	for len(g.objects) > 0 {
+		t := g.objects[0]
+		g.objects = g.objects[1:]
+		if t.list {
+			comment(&g.buf, "", t.name+" is a page of a list. "+firstSentence(t.schema.Description))
+			fmt.Fprintf(&g.buf, "type %s struct {\n\tListObject\n\tData %s `json:\"data\"`\n}\n\n",
+				t.name, g.fieldType(t.name+"Data", t.schema.Properties["data"]))
+			continue
+		}
+		g.object(t.name, "", t.schema, false)
+	}
+}
+
+// fieldType returns the Go type of a resource field, declaring the nested
+// types it needs under names starting with name.
+func (g *generator) fieldType(name string, s *Schema) string {
+	if s.Ref != "" {
+		return "*" + g.ref(s.Ref)
+	}
+	if len(s.AnyOf) > 0 {
+		// an expandable field is either the ID or the referenced object
+		var ref string
+		var id bool
+		for _, alt := range s.AnyOf {
+			switch {
+			case alt.Ref != "":
+				ref = alt.Ref
+			case alt.Type == "string":
+				id = true
+			}
+		}
+		switch {
+		case ref != "" && id:
+			if expandable := "Expandable" + typeName(refName(ref)); g.existing[expandable] {
+				return expandable
+			}
+			return "string"
+		case ref != "" && len(s.AnyOf) == 1:
+			return "*" + g.ref(ref)
+		}
+		g.imports["encoding/json"] = true
+		return "json.RawMessage"
+	}
+
+	switch s.Type {
+	case "string":
+		if strings.HasSuffix(name, "Currency") {
+			return "Currency"
+		}
+		if len(s.Enum) > 0 {
+			if !g.existing[name] && !g.done["type "+name] {
+				g.done["type "+name] = true
+				g.enums = append(g.enums, pendingType{name: name, schema: s})
+			}
+			return name
+		}
+		return "string"
+	case "integer":
+		if s.Format == "unix-time" {
+			if s.Nullable {
+				return "*UnixTime"
+			}
+			return "UnixTime"
+		}
+		return "int64"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		if s.Items == nil {
+			return "[]interface{}"
+		}
+		return "[]" + g.fieldType(name, s.Items)
+	case "object":
+		if s.Properties["data"] != nil && s.Properties["has_more"] != nil {
+			// a list of objects, with pagination details
+			if !g.done["type "+name] && !g.existing[name] {
+				g.done["type "+name] = true
+				g.objects = append(g.objects, pendingType{name, s, true})
+			}
+			return "*" + name
+		}
+		if len(s.Properties) > 0 {
+			g.objects = append(g.objects, pendingType{name: name, schema: s})
+			return "*" + name
+		}
+		if ap, ok := s.AdditionalProperties.(map[string]interface{}); ok && ap["type"] == "string" {
+			return "map[string]string"
+		}
+		return "map[string]interface{}"
+	}
+	g.imports["encoding/json"] = true
+	return "json.RawMessage"
+}
+
+// ref returns the name of the type for the referenced schema, queueing it to
+// be generated unless it is declared already.
+func (g *generator) ref(ref string) string {
+	id := refName(ref)
+	name := typeName(id)
+	if !g.existing[name] && !g.done[id] {
+		g.done[id] = true
+		g.pending = append(g.pending, id)
+	}
+	return name
+}
+
+// enum declares the string type of an enum field, so its values can be told
+// apart from other strings, with a constant for each of them.
+func (g *generator) enum(name string, s *Schema) {
+	comment(&g.buf, "", name+" is generated from Stripe's OpenAPI spec. "+firstSentence(s.Description))
+	fmt.Fprintf(&g.buf, "type %s string\n\n", name)
+
+	var lines []string
+	for _, v := range s.Enum {
+		value, ok := v.(string)
+		if !ok || value == "" {
+			continue
+		}
+		constant := name + camel(value)
+		if g.existing[constant] || g.done["const "+constant] {
+			continue
+		}
+		g.done["const "+constant] = true
+		lines = append(lines, fmt.Sprintf("\t%s %s = %q", constant, name, value))
+	}
+	if len(lines) > 0 {
+		fmt.Fprintf(&g.buf, "// %s Values\nconst (\n%s\n)\n\n", name, strings.Join(lines, "\n"))
+	}
+}
+
+// params declares the Params struct for creating the resource, from the
+// form parameters of the POST method of its collection path.
+func (g *generator) params(id string) {
+	name := typeName(id) + "Params"
+	if g.existing[name] {
+		return
+	}
+	for _, path := range sortedKeys(g.spec.Paths) {
+		op := g.spec.Paths[path]["post"]
+		if op == nil || strings.Contains(path, "{") {
+			continue
+		}
+		res, ok := op.Responses["200"].Content["application/json"]
+		if !ok || res.Schema == nil || refName(res.Schema.Ref) != id {
+			continue
+		}
+		form, ok := op.RequestBody.Content["application/x-www-form-urlencoded"]
+		if !ok || form.Schema == nil {
+			continue
+		}
+		g.paramsObject(name, fmt.Sprintf("%s encapsulates options for creating a new %s.", name, typeName(id)), form.Schema, true)
+		return
+	}
+}
+
+func (g *generator) paramsObject(name, doc string, schema *Schema, top bool) {
+	if g.done["type "+name] || g.existing[name] {
+		return
+	}
+	g.done["type "+name] = true
+
+	// fields are collected first, as they queue the nested params they use
+	var fields bytes.Buffer
+	required := set(schema.Required)
+	first := true
+	for _, prop := range sortedKeys(schema.Properties) {
+		field := schema.Properties[prop]
+		if !first {
+			fields.WriteByte('\n')
+		}
+		first = false
+		desc := firstSentence(field.Description)
+		if !required[prop] {
+			desc = strings.TrimSpace("(Optional) " + desc)
+		}
+		comment(&fields, "\t", desc)
+		fmt.Fprintf(&fields, "\t%s %s `form:%q`\n", fieldName(prop), g.paramType(strings.TrimSuffix(name, "Params")+fieldName(prop)+"Params", field), prop)
+	}
+
+	if doc == "" {
+		doc = name + " encapsulates nested options. " + firstSentence(schema.Description)
+	}
+	comment(&g.buf, "", doc)
+	fmt.Fprintf(&g.buf, "type %s struct {\n", name)
+	if top {
+		g.buf.WriteString("\tParams\n\n")
+	}
+	g.buf.Write(fields.Bytes())
+	g.buf.WriteString("}\n\n")
+
+	// nested params are declared after the struct that uses them
+	for len(g.nested) > 0 {
+		n := g.nested[0]
+		g.nested = g.nested[1:]
+		g.paramsObject(n.name, "", n.schema, false)
+	}
+}
+
+// paramType returns the Go type of a form parameter. Booleans are pointers,
+// so an explicit false can be sent.
+func (g *generator) paramType(name string, s *Schema) string {
+	if len(s.AnyOf) > 0 {
+		// e.g. metadata, which can also be the empty string to unset it
+		for _, alt := range s.AnyOf {
+			if alt.Type == "object" || alt.Type == "array" {
+				return g.paramType(name, alt)
+			}
+		}
+		return g.paramType(name, s.AnyOf[0])
+	}
+	switch s.Type {
+	case "string":
+		if strings.HasSuffix(name, "CurrencyParams") {
+			return "Currency"
+		}
+		return "string"
+	case "integer":
+		if s.Format == "unix-time" {
+			return "*UnixTime"
+		}
+		return "int64"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "*bool"
+	case "array":
+		if s.Items == nil {
+			return "[]string"
+		}
+		elem := g.paramType(name, s.Items)
+		return "[]" + strings.TrimPrefix(elem, "*")
+	case "object":
+		if len(s.Properties) > 0 {
+			g.nested = append(g.nested, pendingType{name: name, schema: s})
+			return "*" + name
+		}
+		return "map[string]string"
+	}
+	return "string"
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Naming and comments
+
+// initialisms are written in capitals in Go names, e.g. ID and URL.
+var initialisms = map[string]string{
+	"id": "ID", "url": "URL", "api": "API", "ip": "IP", "cvc": "CVC", "uri": "URI",
+	"ach": "ACH", "iban": "IBAN", "bic": "BIC", "ssn": "SSN", "vat": "VAT", "fx": "FX",
+}
+
+// typeName returns the Go name of a schema ID, e.g. IssuingCard for
+// issuing.card.
+func typeName(id string) string {
+	return fieldName(strings.Replace(id, ".", "_", -1))
+}
+
+// fieldName returns the Go name of a property, e.g. ShippingURL for
+// shipping_url.
+func fieldName(name string) string {
+	s := camel(name)
+	if s == "" || (s[0] >= '0' && s[0] <= '9') {
+		s = "V" + s
+	}
+	return s
+}
+
+// camel joins the words of name in camel case.
+func camel(name string) string {
+	var b strings.Builder
+	for _, word := range regexp.MustCompile(`[^A-Za-z0-9]+`).Split(name, -1) {
+		if word == "" {
+			continue
+		}
+		if up, ok := initialisms[strings.ToLower(word)]; ok {
+			b.WriteString(up)
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+var (
+	htmlTags = regexp.MustCompile(`<[^>]+>`)
+	mdLinks  = regexp.MustCompile(`\[([^\]]+)\]\([^)]+\)`)
+)
+
+// firstSentence returns the first sentence of a description from the spec,
+// as plain text.
+func firstSentence(desc string) string {
+	desc = mdLinks.ReplaceAllString(htmlTags.ReplaceAllString(desc, ""), "$1")
+	desc = strings.Join(strings.Fields(desc), " ")
+	if i := strings.Index(desc, ". "); i >= 0 {
+		desc = desc[:i+1]
+	}
+	return desc
+}
+
+// comment writes the description as a comment wrapped to 80 columns. Field
+// descriptions are shortened to their first sentence.
+func comment(buf *bytes.Buffer, indent, desc string) {
+	if indent != "" {
+		desc = firstSentence(desc)
+	}
+	desc = strings.Join(strings.Fields(desc), " ")
+	if desc == "" {
+		return
+	}
+	line := indent + "//"
+	for _, word := range strings.Fields(desc) {
+		if len(line)+1+len(word) > 80 && line != indent+"//" {
+			buf.WriteString(line + "\n")
+			line = indent + "//"
+		}
+		line += " " + word
+	}
+	buf.WriteString(line + "\n")
+}
+
+func set(list []string) map[string]bool {
+	m := make(map[string]bool, len(list))
+	for _, v := range list {
+		m[v] = true
+	}
+	return m
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]*Schema:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]map[string]*Operation:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	spec, err := loadSpec("testdata/spec.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	existing := map[string]bool{"Customer": true, "ExpandableCustomer": true}
+	src, err := Generate(spec, "stripe", existing, []string{"tax_rate"})
+	if err != nil {
+		t.Fatalf("Expected source, got Error %s\n%s", err.Error(), src)
+	}
+
+	for _, want := range []string{
+		"type TaxRate struct {\n\tAPIResource\n",
+		"ID string `json:\"id\"`",
+		"Created UnixTime `json:\"created\"`",
+		"Country string `json:\"country,omitempty\"`",
+		"FlatAmount *TaxRateFlatAmount `json:\"flat_amount,omitempty\"`",
+		"Customer ExpandableCustomer `json:\"customer,omitempty\"`",
+		"Metadata map[string]string `json:\"metadata,omitempty\"`",
+		"type TaxRateFlatAmount struct {",
+		"Currency Currency `json:\"currency\"`",
+		"TaxType TaxRateTaxType `json:\"tax_type,omitempty\"`",
+		"type TaxRateTaxType string",
+		"TaxRateRateTypeFlatAmount TaxRateRateType = \"flat_amount\"",
+		"TaxRateTaxTypeVAT TaxRateTaxType = \"vat\"",
+		"type TaxRateParams struct {\n\tParams\n",
+		"Active *bool `form:\"active\"`",
+		"Expand []string `form:\"expand\"`",
+		"FlatAmount *TaxRateFlatAmountParams `form:\"flat_amount\"`",
+		"type TaxRateFlatAmountParams struct {",
+		"// (Optional) Flag determining whether the tax rate is active or inactive\n\t// (archived).",
+	} {
+		if !strings.Contains(strings.Join(strings.Fields(string(src)), " "), strings.Join(strings.Fields(want), " ")) {
+			t.Errorf("Expected generated source to contain %q, got\n%s", want, src)
+		}
+	}
+	if strings.Contains(string(src), "type Customer struct") {
+		t.Errorf("Expected existing Customer type not to be generated again")
+	}
+}
+
+// TestGenerateCompiles will test that the generated source builds as part of
+// the stripe package.
+func TestGenerateCompiles(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command not available")
+	}
+	dir, err := ioutil.TempDir("", "stripegen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// copy the package, which is two directories up, without its tests
+	files, _ := filepath.Glob("../../*.go")
+	for _, f := range files {
+		if strings.HasSuffix(f, "_test.go") {
+			continue
+		}
+		data, err := ioutil.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ioutil.WriteFile(filepath.Join(dir, filepath.Base(f)), data, 0644)
+	}
+	ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/stripe\n\ngo 1.16\n"), 0644)
+
+	if err := run("testdata/spec.json", filepath.Join(dir, "tax_rate_gen.go"), "stripe", []string{"tax_rate"}); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("go", "vet", ".")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		src, _ := ioutil.ReadFile(filepath.Join(dir, "tax_rate_gen.go"))
+		t.Errorf("Expected generated source to build, got %s\n%s\n%s", err, out, src)
+	}
+}
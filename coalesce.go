@@ -0,0 +1,56 @@
+package stripe
+
+import (
+	"net/http"
+	"sync"
+)
+
+// flight is a request in progress, whose response is shared by every caller
+// that made the same request while it was in flight.
+type flight struct {
+	wg  sync.WaitGroup
+	res response
+	err error
+}
+
+// flights coalesces identical requests made at the same time, keyed by
+// coalesceKey, into one.
+type flights struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+// the requests in flight for clients returned by WithCoalescing
+var inflight = &flights{calls: make(map[string]*flight)}
+
+// do calls fn and returns its results, unless a call with the same key is
+// already in flight, in which case it waits for that call and returns its
+// results instead. The body returned is shared and must not be modified.
+func (g *flights) do(key string, fn func() (response, error)) (response, error) {
+	g.mu.Lock()
+	if f, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		f.wg.Wait()
+		return f.res, f.err
+	}
+	f := new(flight)
+	f.wg.Add(1)
+	g.calls[key] = f
+	g.mu.Unlock()
+
+	f.res, f.err = fn()
+	f.wg.Done()
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+	return f.res, f.err
+}
+
+// coalesceKey identifies a request by its URL and the headers that change its
+// response, so only requests for the same object, made with the same key on
+// behalf of the same account, are coalesced.
+func coalesceKey(req *http.Request) string {
+	return req.URL.String() + "|" + req.Header.Get("Authorization") + "|" +
+		req.Header.Get("Stripe-Account") + "|" + req.Header.Get("Stripe-Version")
+}
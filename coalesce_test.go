@@ -0,0 +1,56 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestCoalescing will test that identical GET requests made at the same time
+// are sent once, and that every caller gets its own copy of the response.
+func TestCoalescing(t *testing.T) {
+	var hits int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		<-release
+		w.Write([]byte(`{"id":"cus_1","email":"jenny.rosen@example.com"}`))
+	}))
+	defer server.Close()
+
+	client := Customers.With(WithBaseURL(server.URL), WithCoalescing())
+	customers := make([]*Customer, 10)
+	var wg sync.WaitGroup
+	for i := range customers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cust, err := client.Get("cus_1")
+			if err != nil {
+				t.Errorf("Expected Customer, got Error %s", err.Error())
+			}
+			customers[i] = cust
+		}(i)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if hits != 1 {
+		t.Errorf("Expected 1 request to the Stripe API, got %d", hits)
+	}
+	if customers[0] == customers[1] || customers[1].Email != "jenny.rosen@example.com" {
+		t.Errorf("Expected a separate Customer for each caller")
+	}
+
+	// requests that are not in flight at the same time are sent separately,
+	// as are requests from clients without coalescing
+	client.Get("cus_1")
+	Customers.With(WithBaseURL(server.URL)).Get("cus_1")
+	if hits != 3 {
+		t.Errorf("Expected 3 requests to the Stripe API, got %d", hits)
+	}
+}
@@ -0,0 +1,32 @@
+package stripe
+
+import "context"
+
+type correlationKey struct{}
+
+// WithCorrelationID returns a copy of ctx carrying a correlation ID chosen by
+// the caller, such as the ID of the order being billed. Requests made by a
+// client scoped to the context with WithContext include it in their log
+// output, next to the Stripe request ID, so a billing operation can be traced
+// from end to end.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationKey{}, id)
+}
+
+// CorrelationID returns the correlation ID carried by ctx, if any.
+func CorrelationID(ctx context.Context) string {
+	id, _ := ctx.Value(correlationKey{}).(string)
+	return id
+}
+
+// logCorrelation returns the correlation ID of the scope's context to include
+// in log output, if it has one.
+func (s scope) logCorrelation() string {
+	if s.ctx == nil {
+		return ""
+	}
+	if id := CorrelationID(s.ctx); id != "" {
+		return "correlation_id=" + id
+	}
+	return ""
+}
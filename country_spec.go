@@ -0,0 +1,76 @@
+package stripe
+
+import "net/url"
+
+// CountrySpec describes the currencies, payment methods and verification
+// requirements supported for connected accounts in a country, given by its
+// two-letter ISO code ID.
+//
+// see https://stripe.com/docs/api#country_spec_object
+type CountrySpec struct {
+	APIResource
+
+	ID                             string                `json:"id"`
+	DefaultCurrency                Currency              `json:"default_currency"`
+	SupportedBankAccountCurrencies map[Currency][]string `json:"supported_bank_account_currencies"`
+	SupportedPaymentCurrencies     []Currency            `json:"supported_payment_currencies"`
+	SupportedPaymentMethods        []string              `json:"supported_payment_methods"`
+	SupportedTransferCountries     []string              `json:"supported_transfer_countries"`
+	VerificationFields             struct {
+		Individual *VerificationFields `json:"individual"`
+		Company    *VerificationFields `json:"company"`
+	} `json:"verification_fields"`
+}
+
+// VerificationFields lists the account fields that must be collected for a
+// business type. Minimum fields are needed to enable charges and payouts,
+// while additional fields may be requested later.
+type VerificationFields struct {
+	Minimum    []string `json:"minimum"`
+	Additional []string `json:"additional"`
+}
+
+// CountrySpecClient encapsulates operations for querying country specs using
+// the Stripe REST API.
+type CountrySpecClient struct{ scope }
+
+// With returns a CountrySpecClient configured with the given options.
+func (c CountrySpecClient) With(opts ...ClientOption) CountrySpecClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Country Spec for the given two-letter country code.
+//
+// see https://stripe.com/docs/api#retrieve_country_spec
+func (c CountrySpecClient) Get(country string) (*CountrySpec, error) {
+	res := &CountrySpec{}
+	return res, c.query("GET", "/country_specs/"+url.QueryEscape(country), nil, res)
+}
+
+// Returns a list of the Country Specs of all supported countries.
+//
+// see https://stripe.com/docs/api#list_country_specs
+func (c CountrySpecClient) List(limit int, before, after string) ([]*CountrySpec, bool, error) {
+	res := struct {
+		ListObject
+		Data []*CountrySpec
+	}{}
+	err := c.query("GET", "/country_specs", listParams(limit, before, after), &res)
+	return res.Data, res.More, err
+}
+
+// BankAccountCurrencies returns the currencies that bank accounts in the
+// given country can be paid out in, for accounts in this spec's country.
+func (s *CountrySpec) BankAccountCurrencies(country string) []Currency {
+	var currencies []Currency
+	for currency, countries := range s.SupportedBankAccountCurrencies {
+		for _, c := range countries {
+			if c == country {
+				currencies = append(currencies, currency)
+				break
+			}
+		}
+	}
+	return currencies
+}
@@ -1,9 +1,6 @@
 package stripe
 
-import (
-	"net/url"
-	"strconv"
-)
+import "net/url"
 
 // Coupon Durations
 const (
@@ -16,9 +13,11 @@ const (
 //
 // see https://stripe.com/docs/api#coupon_object
 type Coupon struct {
+	APIResource
+
 	ID               string            `json:"id"`
 	Duration         string            `json:"duration"`
-	AmountOff        int               `json:"amount_off,omitempty"`
+	AmountOff        int64             `json:"amount_off,omitempty"`
 	PercentOff       int               `json:"percent_off,omitempty"`
 	DurationInMonths int               `json:"duration_in_months,omitempty"`
 	MaxRedemptions   int               `json:"max_redemptions,omitempty"`
@@ -32,109 +31,91 @@ type Coupon struct {
 
 // CouponClient encapsulates operations for creating, updating, deleting and
 // querying coupons using the Stripe REST API.
-type CouponClient struct{}
+type CouponClient struct{ scope }
+
+// With returns a CouponClient configured with the given options.
+func (c CouponClient) With(opts ...ClientOption) CouponClient {
+	c.apply(opts)
+	return c
+}
 
 // CouponParams encapsulates options for creating a new Coupon.
 type CouponParams struct {
+	Params
+
 	// (Optional) Unique string of your choice that will be used to identify
 	// this coupon when applying it a customer.
-	ID string
+	ID *string `form:"id"`
 
 	// A positive integer between 1 and 100 that represents the discount the
 	// coupon will apply.
-	PercentOff int
+	PercentOff int `form:"percent_off"`
 
 	// Specifies how long the discount will be in effect. Can be forever, once,
 	// or repeating.
-	Duration string
+	Duration string `form:"duration"`
 
 	// A positive integer representing the amount to subtract from an invoice
 	// total (required if percent_off is not passed)
-	AmountOff int
+	AmountOff int64 `form:"amount_off"`
 
 	// Currency of the amount_off parameter (required if amount_off is passed)
-	Currency string
+	Currency Currency `form:"currency"`
 
 	// (Optional) If duration is repeating, a positive integer that specifies
 	// the number of months the discount will be in effect.
-	DurationInMonths int
+	DurationInMonths *int64 `form:"duration_in_months"`
 
 	// (Optional) A positive integer specifying the number of times the coupon
 	// can be redeemed before it's no longer valid. For example, you might have
 	// a 50% off coupon that the first 20 readers of your blog can use.
-	MaxRedemptions int
+	MaxRedemptions *int64 `form:"max_redemptions"`
 
 	// (Optional) UTC timestamp specifying the last time at which the coupon can
 	// be redeemed. After the redeem_by date, the coupon can no longer be
 	// applied to new customers.
-	RedeemBy *UnixTime
+	RedeemBy *UnixTime `form:"redeem_by"`
 
-	Metadata map[string]string
+	Metadata map[string]string `form:"metadata"`
 }
 
 // Creates a new Coupon.
 //
 // see https://stripe.com/docs/api#create_coupon
-func (CouponClient) Create(params *CouponParams) (*Coupon, error) {
+func (c CouponClient) Create(params *CouponParams) (*Coupon, error) {
 	coupon := Coupon{}
-	values := url.Values{
-		"duration":    {params.Duration},
-		"percent_off": {strconv.Itoa(params.PercentOff)},
-	}
-
-	if len(params.ID) != 0 {
-		values.Add("id", params.ID)
-	}
-	if params.DurationInMonths != 0 {
-		values.Add("duration_in_months", strconv.Itoa(params.DurationInMonths))
-	}
-	if params.MaxRedemptions != 0 {
-		values.Add("max_redemptions", strconv.Itoa(params.MaxRedemptions))
-	}
-
-	if params.AmountOff != 0 {
-		values.Add("amount_off", strconv.Itoa(params.AmountOff))
-		values.Add("currency", params.Currency)
-	}
-	if params.RedeemBy != nil {
-		values.Add("redeem_by", strconv.FormatInt(params.RedeemBy.Unix(), 10))
-	}
-	appendMetadata(values, params.Metadata)
-
-	err := query("POST", "/coupons", values, &coupon)
+	values := formValues(params)
+
+	err := c.query("POST", "/coupons", values, &coupon)
 	return &coupon, err
 }
 
 // Retrieves the coupon with the given ID.
 //
 // see https://stripe.com/docs/api#retrieve_coupon
-func (CouponClient) Get(id string) (*Coupon, error) {
+func (c CouponClient) Get(id string) (*Coupon, error) {
 	coupon := Coupon{}
 	path := "/coupons/" + url.QueryEscape(id)
-	err := query("GET", path, nil, &coupon)
+	err := c.query("GET", path, nil, &coupon)
 	return &coupon, err
 }
 
 // Deletes the coupon with the given ID.
 //
 // see https://stripe.com/docs/api#delete_coupon
-func (CouponClient) Delete(id string) (bool, error) {
-	resp := DeleteResp{}
-	path := "/coupons/" + url.QueryEscape(id)
-	if err := query("DELETE", path, nil, &resp); err != nil {
-		return false, err
-	}
-	return resp.Deleted, nil
+func (c CouponClient) Delete(id string) (*Deleted, error) {
+	res := &Deleted{}
+	return res, c.query("DELETE", "/coupons/"+url.QueryEscape(id), nil, res)
 }
 
 // Returns a list of your coupons at the specified range.
 //
 // see https://stripe.com/docs/api#list_coupons
-func (CouponClient) List(limit int, before, after string) ([]*Coupon, bool, error) {
+func (c CouponClient) List(limit int, before, after string) ([]*Coupon, bool, error) {
 	res := struct {
 		ListObject
 		Data []*Coupon
 	}{}
-	err := query("GET", "/coupons", listParams(limit, before, after), &res)
+	err := c.query("GET", "/coupons", listParams(limit, before, after), &res)
 	return res.Data, res.More, err
 }
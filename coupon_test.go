@@ -16,18 +16,18 @@ func init() {
 var (
 	// Coupon with only the required fields
 	c1 = CouponParams{
-		ID:         "test coupon 1",
+		ID:         String("test coupon 1"),
 		PercentOff: 5,
 		Duration:   DurationOnce,
 	}
 
 	// Coupon with all required + optional fields.
 	c2 = CouponParams{
-		ID:               "test coupon 2",
+		ID:               String("test coupon 2"),
 		PercentOff:       10,
 		Duration:         DurationRepeating,
-		MaxRedemptions:   100,
-		DurationInMonths: 6,
+		MaxRedemptions:   Int64(100),
+		DurationInMonths: Int64(6),
 	}
 )
 
@@ -40,18 +40,18 @@ func TestCreateCoupon(t *testing.T) {
 
 	// Create the coupon, and defer its deletion
 	coupon, err := Coupons.Create(&c1)
-	defer Coupons.Delete(c1.ID)
+	defer Coupons.Delete(*c1.ID)
 
-	if coupon.ID != c1.ID {
-		t.Errorf("Expected Coupon ID %s, got %s", c1.ID, coupon.ID)
+	if coupon.ID != *c1.ID {
+		t.Errorf("Expected Coupon ID %s, got %s", *c1.ID, coupon.ID)
 	}
 	if coupon.Duration != c1.Duration {
 		t.Errorf("Expected Coupon Duration %v, got %v",
 			c1.Duration, coupon.Duration)
 	}
-	if coupon.MaxRedemptions != c1.MaxRedemptions {
-		t.Errorf("Expected Coupon MaxRedemptions %v, got %v",
-			c1.MaxRedemptions, coupon.MaxRedemptions)
+	if coupon.MaxRedemptions != 0 {
+		t.Errorf("Expected Coupon MaxRedemptions 0, got %v",
+			coupon.MaxRedemptions)
 	}
 	if coupon.PercentOff != c1.PercentOff {
 		t.Errorf("Expected Coupon PercentOff %v, got %v",
@@ -75,15 +75,15 @@ func TestCreateCoupon(t *testing.T) {
 func TestRetrieveCoupon(t *testing.T) {
 	// create a request that we can retrieve, defer deletion in case test fails
 	Coupons.Create(&c2)
-	defer Coupons.Delete(c2.ID)
+	defer Coupons.Delete(*c2.ID)
 
 	// now let's retrieve the recently added coupon
-	coupon, err := Coupons.Get(c2.ID)
+	coupon, err := Coupons.Get(*c2.ID)
 	if err != nil {
-		t.Errorf("Expected Coupon %s, got Error %s", c2.ID, err.Error())
+		t.Errorf("Expected Coupon %s, got Error %s", *c2.ID, err.Error())
 	}
-	if coupon.ID != c2.ID {
-		t.Errorf("Expected Coupon ID %s, got %s", c2.ID, coupon.ID)
+	if coupon.ID != *c2.ID {
+		t.Errorf("Expected Coupon ID %s, got %s", *c2.ID, coupon.ID)
 	}
 	if coupon.PercentOff != c2.PercentOff {
 		t.Errorf("Expected Coupon PercentOff %v, got %v",
@@ -109,11 +109,11 @@ func TestDeleteCoupon(t *testing.T) {
 	Coupons.Create(&c1)
 
 	// let's try to delete the coupon
-	ok, err := Coupons.Delete(c1.ID)
+	deleted, err := Coupons.Delete(*c1.ID)
 	if err != nil {
 		t.Errorf("Expected Coupon deletion, got Error %s", err.Error())
 	}
-	if !ok {
+	if !deleted.Deleted {
 		t.Errorf("Expected Coupon deletion true, got false")
 	}
 }
@@ -126,8 +126,8 @@ func TestListCoupon(t *testing.T) {
 	// create 2 dummy coupons that we can retrieve
 	Coupons.Create(&c1)
 	Coupons.Create(&c2)
-	defer Coupons.Delete(c1.ID)
-	defer Coupons.Delete(c2.ID)
+	defer Coupons.Delete(*c1.ID)
+	defer Coupons.Delete(*c2.ID)
 
 	// get the list from Stripe
 	coupons, _, err := Coupons.List(10, "", "")
@@ -0,0 +1,176 @@
+package stripe
+
+// Currency is a lowercase ISO 4217 currency code.
+type Currency string
+
+// ISO 4217 Currency Codes for the currencies Stripe supports.
+const (
+	AED Currency = "aed" // UAE Dirham
+	AFN Currency = "afn" // Afghan Afghani
+	ALL Currency = "all" // Albanian Lek
+	AMD Currency = "amd" // Armenian Dram
+	ANG Currency = "ang" // Netherlands Antillean Guilder
+	AOA Currency = "aoa" // Angolan Kwanza
+	ARS Currency = "ars" // Argentine Peso
+	AUD Currency = "aud" // Australian Dollar (A$)
+	AWG Currency = "awg" // Aruban Florin
+	AZN Currency = "azn" // Azerbaijani Manat
+	BAM Currency = "bam" // Bosnia-Herzegovina Convertible Mark
+	BBD Currency = "bbd" // Barbadian Dollar
+	BDT Currency = "bdt" // Bangladeshi Taka
+	BGN Currency = "bgn" // Bulgarian Lev
+	BHD Currency = "bhd" // Bahraini Dinar
+	BIF Currency = "bif" // Burundian Franc
+	BMD Currency = "bmd" // Bermudan Dollar
+	BND Currency = "bnd" // Brunei Dollar
+	BOB Currency = "bob" // Bolivian Boliviano
+	BRL Currency = "brl" // Brazilian Real
+	BSD Currency = "bsd" // Bahamian Dollar
+	BWP Currency = "bwp" // Botswanan Pula
+	BYN Currency = "byn" // Belarusian Ruble
+	BZD Currency = "bzd" // Belize Dollar
+	CAD Currency = "cad" // Canadian Dollar (CA$)
+	CDF Currency = "cdf" // Congolese Franc
+	CHF Currency = "chf" // Swiss Franc
+	CLP Currency = "clp" // Chilean Peso
+	CNY Currency = "cny" // Chinese Yuan (CN¥)
+	COP Currency = "cop" // Colombian Peso
+	CRC Currency = "crc" // Costa Rican Colón
+	CVE Currency = "cve" // Cape Verdean Escudo
+	CZK Currency = "czk" // Czech Koruna
+	DJF Currency = "djf" // Djiboutian Franc
+	DKK Currency = "dkk" // Danish Krone
+	DOP Currency = "dop" // Dominican Peso
+	DZD Currency = "dzd" // Algerian Dinar
+	EGP Currency = "egp" // Egyptian Pound
+	ETB Currency = "etb" // Ethiopian Birr
+	EUR Currency = "eur" // Euro (€)
+	FJD Currency = "fjd" // Fijian Dollar
+	FKP Currency = "fkp" // Falkland Islands Pound
+	GBP Currency = "gbp" // British Pound Sterling (UK£)
+	GEL Currency = "gel" // Georgian Lari
+	GIP Currency = "gip" // Gibraltar Pound
+	GMD Currency = "gmd" // Gambian Dalasi
+	GNF Currency = "gnf" // Guinean Franc
+	GTQ Currency = "gtq" // Guatemalan Quetzal
+	GYD Currency = "gyd" // Guyanaese Dollar
+	HKD Currency = "hkd" // Hong Kong Dollar (HK$)
+	HNL Currency = "hnl" // Honduran Lempira
+	HTG Currency = "htg" // Haitian Gourde
+	HUF Currency = "huf" // Hungarian Forint
+	IDR Currency = "idr" // Indonesian Rupiah
+	ILS Currency = "ils" // Israeli New Shekel
+	INR Currency = "inr" // Indian Rupee
+	ISK Currency = "isk" // Icelandic Króna
+	JMD Currency = "jmd" // Jamaican Dollar
+	JOD Currency = "jod" // Jordanian Dinar
+	JPY Currency = "jpy" // Japanese Yen (¥)
+	KES Currency = "kes" // Kenyan Shilling
+	KGS Currency = "kgs" // Kyrgystani Som
+	KHR Currency = "khr" // Cambodian Riel
+	KMF Currency = "kmf" // Comorian Franc
+	KRW Currency = "krw" // South Korean Won
+	KWD Currency = "kwd" // Kuwaiti Dinar
+	KYD Currency = "kyd" // Cayman Islands Dollar
+	KZT Currency = "kzt" // Kazakhstani Tenge
+	LAK Currency = "lak" // Laotian Kip
+	LBP Currency = "lbp" // Lebanese Pound
+	LKR Currency = "lkr" // Sri Lankan Rupee
+	LRD Currency = "lrd" // Liberian Dollar
+	LSL Currency = "lsl" // Lesotho Loti
+	MAD Currency = "mad" // Moroccan Dirham
+	MDL Currency = "mdl" // Moldovan Leu
+	MGA Currency = "mga" // Malagasy Ariary
+	MKD Currency = "mkd" // Macedonian Denar
+	MMK Currency = "mmk" // Myanmar Kyat
+	MNT Currency = "mnt" // Mongolian Tugrik
+	MOP Currency = "mop" // Macanese Pataca
+	MUR Currency = "mur" // Mauritian Rupee
+	MVR Currency = "mvr" // Maldivian Rufiyaa
+	MWK Currency = "mwk" // Malawian Kwacha
+	MXN Currency = "mxn" // Mexican Peso
+	MYR Currency = "myr" // Malaysian Ringgit
+	MZN Currency = "mzn" // Mozambican Metical
+	NAD Currency = "nad" // Namibian Dollar
+	NGN Currency = "ngn" // Nigerian Naira
+	NIO Currency = "nio" // Nicaraguan Córdoba
+	NOK Currency = "nok" // Norwegian Krone
+	NPR Currency = "npr" // Nepalese Rupee
+	NZD Currency = "nzd" // New Zealand Dollar
+	OMR Currency = "omr" // Omani Rial
+	PAB Currency = "pab" // Panamanian Balboa
+	PEN Currency = "pen" // Peruvian Sol
+	PGK Currency = "pgk" // Papua New Guinean Kina
+	PHP Currency = "php" // Philippine Peso
+	PKR Currency = "pkr" // Pakistani Rupee
+	PLN Currency = "pln" // Polish Zloty
+	PYG Currency = "pyg" // Paraguayan Guarani
+	QAR Currency = "qar" // Qatari Rial
+	RON Currency = "ron" // Romanian Leu
+	RSD Currency = "rsd" // Serbian Dinar
+	RUB Currency = "rub" // Russian Ruble
+	RWF Currency = "rwf" // Rwandan Franc
+	SAR Currency = "sar" // Saudi Riyal
+	SBD Currency = "sbd" // Solomon Islands Dollar
+	SCR Currency = "scr" // Seychellois Rupee
+	SEK Currency = "sek" // Swedish Krona
+	SGD Currency = "sgd" // Singapore Dollar
+	SHP Currency = "shp" // St. Helena Pound
+	SLE Currency = "sle" // Sierra Leonean Leone
+	SOS Currency = "sos" // Somali Shilling
+	SRD Currency = "srd" // Surinamese Dollar
+	STD Currency = "std" // São Tomé and Príncipe Dobra
+	SZL Currency = "szl" // Swazi Lilangeni
+	THB Currency = "thb" // Thai Baht
+	TJS Currency = "tjs" // Tajikistani Somoni
+	TND Currency = "tnd" // Tunisian Dinar
+	TOP Currency = "top" // Tongan Paʻanga
+	TRY Currency = "try" // Turkish Lira
+	TTD Currency = "ttd" // Trinidad and Tobago Dollar
+	TWD Currency = "twd" // New Taiwan Dollar
+	TZS Currency = "tzs" // Tanzanian Shilling
+	UAH Currency = "uah" // Ukrainian Hryvnia
+	UGX Currency = "ugx" // Ugandan Shilling
+	USD Currency = "usd" // US Dollar ($)
+	UYU Currency = "uyu" // Uruguayan Peso
+	UZS Currency = "uzs" // Uzbekistani Som
+	VND Currency = "vnd" // Vietnamese Dong
+	VUV Currency = "vuv" // Vanuatu Vatu
+	WST Currency = "wst" // Samoan Tala
+	XAF Currency = "xaf" // Central African CFA Franc
+	XCD Currency = "xcd" // East Caribbean Dollar
+	XOF Currency = "xof" // West African CFA Franc
+	XPF Currency = "xpf" // CFP Franc
+	YER Currency = "yer" // Yemeni Rial
+	ZAR Currency = "zar" // South African Rand
+	ZMW Currency = "zmw" // Zambian Kwacha
+)
+
+// currencies are the Currency Codes defined above.
+var currencies = map[Currency]bool{
+	AED: true, AFN: true, ALL: true, AMD: true, ANG: true, AOA: true, ARS: true,
+	AUD: true, AWG: true, AZN: true, BAM: true, BBD: true, BDT: true, BGN: true,
+	BHD: true, BIF: true, BMD: true, BND: true, BOB: true, BRL: true, BSD: true,
+	BWP: true, BYN: true, BZD: true, CAD: true, CDF: true, CHF: true, CLP: true,
+	CNY: true, COP: true, CRC: true, CVE: true, CZK: true, DJF: true, DKK: true,
+	DOP: true, DZD: true, EGP: true, ETB: true, EUR: true, FJD: true, FKP: true,
+	GBP: true, GEL: true, GIP: true, GMD: true, GNF: true, GTQ: true, GYD: true,
+	HKD: true, HNL: true, HTG: true, HUF: true, IDR: true, ILS: true, INR: true,
+	ISK: true, JMD: true, JOD: true, JPY: true, KES: true, KGS: true, KHR: true,
+	KMF: true, KRW: true, KWD: true, KYD: true, KZT: true, LAK: true, LBP: true,
+	LKR: true, LRD: true, LSL: true, MAD: true, MDL: true, MGA: true, MKD: true,
+	MMK: true, MNT: true, MOP: true, MUR: true, MVR: true, MWK: true, MXN: true,
+	MYR: true, MZN: true, NAD: true, NGN: true, NIO: true, NOK: true, NPR: true,
+	NZD: true, OMR: true, PAB: true, PEN: true, PGK: true, PHP: true, PKR: true,
+	PLN: true, PYG: true, QAR: true, RON: true, RSD: true, RUB: true, RWF: true,
+	SAR: true, SBD: true, SCR: true, SEK: true, SGD: true, SHP: true, SLE: true,
+	SOS: true, SRD: true, STD: true, SZL: true, THB: true, TJS: true, TND: true,
+	TOP: true, TRY: true, TTD: true, TWD: true, TZS: true, UAH: true, UGX: true,
+	USD: true, UYU: true, UZS: true, VND: true, VUV: true, WST: true, XAF: true,
+	XCD: true, XOF: true, XPF: true, YER: true, ZAR: true, ZMW: true,
+}
+
+// Supported reports whether c is one of the Currency Codes Stripe supports.
+func (c Currency) Supported() bool {
+	return currencies[c]
+}
@@ -1,25 +1,31 @@
 package stripe
 
 import (
+	"encoding/json"
 	"net/url"
-	"strconv"
 )
 
 // Customer encapsulates details about a Customer registered in Stripe.
 //
 // see https://stripe.com/docs/api#customer_object
 type Customer struct {
+	APIResource
+
 	ID            string            `json:"id"`
 	Description   string            `json:"description,omitempty"`
 	Email         string            `json:"email,omitempty"`
+	Phone         string            `json:"phone,omitempty"`
+	Address       *Address          `json:"address,omitempty"`
+	Shipping      *ShippingDetails  `json:"shipping,omitempty"`
 	Created       UnixTime          `json:"created"`
-	Balance       int               `json:"account_balance,omitempty"`
-	Currency      string            `json:"currency"`
+	Balance       int64             `json:"account_balance,omitempty"`
+	Currency      Currency          `json:"currency"`
 	Delinquent    bool              `json:"delinquent,omitempty"`
 	Cards         *CardList         `json:"cards,omitempty"`
 	Discount      *Discount         `json:"discount,omitempty"`
 	Subscriptions *SubscriptionList `json:"subscriptions,omitempty"`
 	Livemode      bool              `json:"livemode"`
+	TestClock     string            `json:"test_clock,omitempty"`
 	DefaultCard   string            `json:"default_card"`
 	Metadata      map[string]string `json:"metadata,omitempty"`
 }
@@ -53,174 +59,141 @@ type Discount struct {
 
 // CustomerParams encapsulates options for creating and updating Customers.
 type CustomerParams struct {
+	Params
+
 	// (Optional) The customer's email address.
-	Email string
+	Email *string `form:"email"`
 
 	// (Optional) An arbitrary string which you can attach to a customer object.
-	Description string
+	// Set to an empty string to clear it.
+	Description *string `form:"description"`
 
 	// (Optional) Customer's Active Credit Card
-	Card *CardParams
+	Card *CardParams `form:"card"`
 
 	// (Optional) Customer's Active Credid Card, using a Card Token
-	Token string
+	Token *string `form:"card"`
 
 	// (Optional) If you provide a coupon code, the customer will have a
 	// discount applied on all recurring charges.
-	Coupon string
+	Coupon *string `form:"coupon"`
 
 	// (Optional) The identifier of the plan to subscribe the customer to. If
 	// provided, the returned customer object has a 'subscription' attribute
 	// describing the state of the customer's subscription.
-	Plan string
+	Plan *string `form:"plan"`
 
 	// (Optional) The quantity you’d like to apply to the subscription you’re creating.
-	Quantity int
+	Quantity *int64 `form:"quantity"`
 
 	// (Optional) timestamp representing the end of the trial period
 	// the customer will get before being charged for the first time.
-	TrialEnd *UnixTime
+	TrialEnd *UnixTime `form:"trial_end"`
 
 	// (Optional) Customer's account balance. Negative is credit, positive is added to the next invoice.
-	Balance *int
+	Balance *int64 `form:"account_balance"`
 
 	// (Optional) Customer's default card id.
-	DefaultCard string
+	DefaultCard *string `form:"default_card"`
+
+	// (Optional) The customer's phone number and billing address.
+	Phone   *string  `form:"phone"`
+	Address *Address `form:"address"`
+
+	// (Optional) The customer's shipping name and address.
+	Shipping *ShippingDetails `form:"shipping"`
+
+	// (Optional) The ID of a Test Clock to attach the customer to, so its
+	// subscriptions follow the clock's time. Test mode only, and can only be
+	// set on creation.
+	TestClock *string `form:"test_clock"`
 
 	// (Optional) Metadata.
-	Metadata map[string]string
+	Metadata map[string]string `form:"metadata"`
 }
 
 // CustomerClient encapsulates operations for creating, updating, deleting and
 // querying customers using the Stripe REST API.
-type CustomerClient struct{}
+type CustomerClient struct{ scope }
+
+// With returns a CustomerClient configured with the given options.
+func (c CustomerClient) With(opts ...ClientOption) CustomerClient {
+	c.apply(opts)
+	return c
+}
 
 // Creates a new Customer.
 //
 // see https://stripe.com/docs/api#create_customer
-func (CustomerClient) Create(cust *CustomerParams) (*Customer, error) {
+func (c CustomerClient) Create(cust *CustomerParams) (*Customer, error) {
+	if err := cust.Card.validate(); err != nil {
+		return nil, err
+	}
 	customer := Customer{}
-	params := make(url.Values)
-	appendCustomerParams(params, cust)
+	params := formValues(cust)
 
-	err := query("POST", "/customers", params, &customer)
+	err := c.query("POST", "/customers", params, &customer)
 	return &customer, err
 }
 
 // Retrieves a Customer with the given ID.
 //
 // see https://stripe.com/docs/api#retrieve_customer
-func (CustomerClient) Get(id string) (*Customer, error) {
+func (c CustomerClient) Get(id string) (*Customer, error) {
 	customer := Customer{}
 	path := "/customers/" + url.QueryEscape(id)
-	err := query("GET", path, nil, &customer)
+	err := c.query("GET", path, nil, &customer)
 	return &customer, err
 }
 
 // Updates a Customer with the given ID.
 //
 // see https://stripe.com/docs/api#update_customer
-func (CustomerClient) Update(id string, cust *CustomerParams) (*Customer, error) {
+func (c CustomerClient) Update(id string, cust *CustomerParams) (*Customer, error) {
+	if err := cust.Card.validate(); err != nil {
+		return nil, err
+	}
 	customer := Customer{}
-	params := make(url.Values)
-	appendCustomerParams(params, cust)
+	params := formValues(cust)
 
-	err := query("POST", "/customers/"+url.QueryEscape(id), params, &customer)
+	err := c.query("POST", "/customers/"+url.QueryEscape(id), params, &customer)
 	return &customer, err
 }
 
 // Deletes a Customer (permanently) with the given ID.
 //
 // see https://stripe.com/docs/api#delete_customer
-func (CustomerClient) Delete(id string) (bool, error) {
-	resp := DeleteResp{}
-	err := query("DELETE", "/customers/"+url.QueryEscape(id), nil, &resp)
-	return resp.Deleted, err
+func (c CustomerClient) Delete(id string) (*Deleted, error) {
+	res := &Deleted{}
+	return res, c.query("DELETE", "/customers/"+url.QueryEscape(id), nil, res)
 }
 
 // Returns a list of your Customers at the specified range.
 //
 // see https://stripe.com/docs/api#list_customers
-func (CustomerClient) List(limit int, before, after string) ([]*Customer, bool, error) {
+func (c CustomerClient) List(limit int, before, after string) ([]*Customer, bool, error) {
 	res := struct {
 		ListObject
 		Data []*Customer
 	}{}
-	err := query("GET", "/customers", listParams(limit, before, after), &res)
+	err := c.query("GET", "/customers", listParams(limit, before, after), &res)
 	return res.Data, res.More, err
 }
 
-////////////////////////////////////////////////////////////////////////////////
-// Helper Function(s)
-
-func appendCustomerParams(values url.Values, c *CustomerParams) {
-	// add optional parameters, if specified
-	if c.Email != "" {
-		values.Add("email", c.Email)
-	}
-	if c.Description != "" {
-		values.Add("description", c.Description)
-	}
-	if c.Coupon != "" {
-		values.Add("coupon", c.Coupon)
-	}
-	if c.Plan != "" {
-		values.Add("plan", c.Plan)
-	}
-	if c.TrialEnd != nil {
-		values.Add("trial_end", strconv.FormatInt(c.TrialEnd.Unix(), 10))
-	}
-	if c.Balance != nil {
-		values.Add("account_balance", strconv.Itoa(*c.Balance))
-	}
-	if c.DefaultCard != "" {
-		values.Add("default_card", c.DefaultCard)
-	}
-	appendMetadata(values, c.Metadata)
-
-	// add optional credit card details, if specified
-	if c.Card != nil {
-		appendCardParams(values, true, c.Card)
-	} else if c.Token != "" {
-		values.Add("card", c.Token)
-	}
-}
-
-func appendCardParams(values url.Values, nested bool, c *CardParams) {
-	p := func(s string) string {
-		if nested {
-			return "card[" + s + "]"
+// Each calls fn with each Customer in the specified range as it is decoded,
+// without holding the whole page in memory, and reports whether more
+// Customers are available. An error returned by fn stops the listing and is
+// returned.
+//
+// see https://stripe.com/docs/api#list_customers
+func (c CustomerClient) Each(limit int, before, after string, fn func(*Customer) error) (bool, error) {
+	return c.stream("/customers", listParams(limit, before, after), func(raw json.RawMessage) error {
+		cust := &Customer{}
+		if err := json.Unmarshal(raw, cust); err != nil {
+			return err
 		}
-		return s
-	}
-	if c.Number != "" {
-		values.Add(p("number"), c.Number)
-	}
-	if c.ExpMonth != 0 {
-		values.Add(p("exp_month"), strconv.Itoa(c.ExpMonth))
-	}
-	if c.ExpMonth != 0 {
-		values.Add(p("exp_year"), strconv.Itoa(c.ExpYear))
-	}
-	if c.Name != "" {
-		values.Add(p("name"), c.Name)
-	}
-	if c.CVC != "" {
-		values.Add(p("cvc"), c.CVC)
-	}
-	if c.Address1 != "" {
-		values.Add(p("address_line1"), c.Address1)
-	}
-	if c.Address2 != "" {
-		values.Add(p("address_line2"), c.Address2)
-	}
-	if c.AddressZip != "" {
-		values.Add(p("address_zip"), c.AddressZip)
-	}
-	if c.AddressState != "" {
-		values.Add(p("address_state"), c.AddressState)
-	}
-	if c.AddressCountry != "" {
-		values.Add(p("address_country"), c.AddressCountry)
-	}
+		cust.setRawJSON(raw)
+		cust.normalizeVersion(c.versionFor())
+		return fn(cust)
+	})
 }
@@ -0,0 +1,73 @@
+// Package customer is the Customers API of the stripe package, under a package
+// of its own so that code using it can refer to customer.Params and
+// customer.Create rather than the longer names the stripe package declares
+// them with:
+//
+//	cus, err := customer.Create(&customer.Params{Email: stripe.String("jenny@example.com")})
+//
+// Its functions make requests with the package-level client, configured with
+// stripe.SetKey and the other Set functions. A Client configured otherwise is
+// returned by With, or taken from a stripe.Client created with NewClient.
+package customer
+
+import "github.com/cupcake/stripe"
+
+type (
+	// Customer is a stripe.Customer.
+	Customer = stripe.Customer
+
+	// Params is a stripe.CustomerParams.
+	Params = stripe.CustomerParams
+
+	// Client is a stripe.CustomerClient.
+	Client = stripe.CustomerClient
+)
+
+// With returns a Client configured with the given options.
+func With(opts ...stripe.ClientOption) Client {
+	return stripe.Customers.With(opts...)
+}
+
+// Creates a new Customer.
+//
+// see https://stripe.com/docs/api#create_customer
+func Create(params *Params) (*Customer, error) {
+	return stripe.Customers.Create(params)
+}
+
+// Retrieves a Customer with the given ID.
+//
+// see https://stripe.com/docs/api#retrieve_customer
+func Get(id string) (*Customer, error) {
+	return stripe.Customers.Get(id)
+}
+
+// Updates a Customer with the given ID.
+//
+// see https://stripe.com/docs/api#update_customer
+func Update(id string, params *Params) (*Customer, error) {
+	return stripe.Customers.Update(id, params)
+}
+
+// Deletes a Customer (permanently) with the given ID.
+//
+// see https://stripe.com/docs/api#delete_customer
+func Delete(id string) (*stripe.Deleted, error) {
+	return stripe.Customers.Delete(id)
+}
+
+// Returns a list of your Customers at the specified range.
+//
+// see https://stripe.com/docs/api#list_customers
+func List(limit int, before, after string) ([]*Customer, bool, error) {
+	return stripe.Customers.List(limit, before, after)
+}
+
+// Each calls fn with each Customer in the specified range as it is decoded,
+// and reports whether more Customers are available, as
+// stripe.CustomerClient.Each does.
+//
+// see https://stripe.com/docs/api#list_customers
+func Each(limit int, before, after string, fn func(*Customer) error) (bool, error) {
+	return stripe.Customers.Each(limit, before, after, fn)
+}
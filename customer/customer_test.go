@@ -0,0 +1,32 @@
+package customer
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/cupcake/stripe"
+)
+
+// TestUpdate will test that Update sends the Params through the stripe
+// package's Backend, and decodes the Customer it returns.
+func TestUpdate(t *testing.T) {
+	var path string
+	var params url.Values
+	stripe.SetBackend(stripe.BackendFunc(func(method, p string, values url.Values, v interface{}) error {
+		path, params = method+" "+p, values
+		return json.Unmarshal([]byte(`{"id":"cus_1","email":"jenny@example.com"}`), v)
+	}))
+	defer stripe.SetBackend(nil)
+
+	cus, err := Update("cus_1", &Params{Email: stripe.String("jenny@example.com")})
+	if err != nil {
+		t.Fatalf("Expected Customer, got Error %s", err.Error())
+	}
+	if cus.ID != "cus_1" || cus.Email != "jenny@example.com" {
+		t.Errorf("Expected Customer cus_1 with email jenny@example.com, got %s with %s", cus.ID, cus.Email)
+	}
+	if path != "POST /customers/cus_1" || params.Get("email") != "jenny@example.com" {
+		t.Errorf("Expected POST /customers/cus_1 with the email, got %s with %v", path, params)
+	}
+}
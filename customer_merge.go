@@ -0,0 +1,119 @@
+package stripe
+
+import (
+	"net/url"
+	"sort"
+)
+
+// CustomerDuplicates are Customers with the same email address and a saved
+// Card with the same fingerprint, found by FindDuplicates.
+type CustomerDuplicates struct {
+	// The Customer the others are duplicates of: the one created first.
+	Canonical *Customer
+
+	// The Canonical Customer's Card with the fingerprint.
+	Card *Card
+
+	// The other Customers, ordered by when they were created.
+	Duplicates []*Customer
+}
+
+// FindDuplicates returns the Customers with the given email address that have
+// a saved Card with the given fingerprint, designating the oldest of them as
+// the canonical one. The result has no Duplicates if only one Customer
+// matches, and is nil if none do.
+func (c CustomerClient) FindDuplicates(email, fingerprint string) (*CustomerDuplicates, error) {
+	var found []*Customer
+	cards := make(map[string]*Card)
+	values := url.Values{"email": {email}, "limit": {"100"}}
+	for {
+		res := struct {
+			ListObject
+			Data []*Customer
+		}{}
+		if err := c.query("GET", "/customers", values, &res); err != nil {
+			return nil, err
+		}
+		for _, cust := range res.Data {
+			card, err := CardClient{c.scope}.FindByFingerprint(cust.ID, fingerprint)
+			if err != nil {
+				return nil, err
+			}
+			if card != nil {
+				found = append(found, cust)
+				cards[cust.ID] = card
+			}
+		}
+		if !res.More || len(res.Data) == 0 {
+			break
+		}
+		values.Set("starting_after", res.Data[len(res.Data)-1].ID)
+	}
+	if len(found) == 0 {
+		return nil, nil
+	}
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].Created.Before(found[j].Created.Time)
+	})
+	return &CustomerDuplicates{Canonical: found[0], Card: cards[found[0].ID], Duplicates: found[1:]}, nil
+}
+
+// MergeDuplicates consolidates the Customers found by FindDuplicates onto
+// the canonical one: its copy of the card becomes its default card and the
+// default source of each of its Subscriptions, replacing references to other
+// cards, and the duplicates are tagged with the metadata key "duplicate_of"
+// set to its ID, so they can be filtered out or deleted later.
+//
+// Subscriptions cannot move between Customers, so those of the duplicates
+// are returned for the caller to cancel or recreate on the canonical
+// Customer.
+func (c CustomerClient) MergeDuplicates(d *CustomerDuplicates) ([]*Subscription, error) {
+	if d.Canonical.DefaultCard != d.Card.ID {
+		if _, err := c.Update(d.Canonical.ID, &CustomerParams{DefaultCard: String(d.Card.ID)}); err != nil {
+			return nil, err
+		}
+	}
+	subs := SubscriptionClient{c.scope}
+	canonical, err := subs.all(d.Canonical.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, sub := range canonical {
+		params := &SubscriptionParams{}
+		params.AddExtra("default_source", d.Card.ID)
+		if _, err := subs.Update(d.Canonical.ID, sub.ID, params); err != nil {
+			return nil, err
+		}
+	}
+
+	var stranded []*Subscription
+	for _, dup := range d.Duplicates {
+		params := &CustomerParams{Metadata: map[string]string{"duplicate_of": d.Canonical.ID}}
+		if _, err := c.Update(dup.ID, params); err != nil {
+			return stranded, err
+		}
+		dupSubs, err := subs.all(dup.ID)
+		if err != nil {
+			return stranded, err
+		}
+		stranded = append(stranded, dupSubs...)
+	}
+	return stranded, nil
+}
+
+// all lists all of the Customer's Subscriptions.
+func (c SubscriptionClient) all(customerID string) ([]*Subscription, error) {
+	var all []*Subscription
+	after := ""
+	for {
+		subs, more, err := c.List(customerID, 100, "", after)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, subs...)
+		if !more || len(subs) == 0 {
+			return all, nil
+		}
+		after = subs[len(subs)-1].ID
+	}
+}
@@ -0,0 +1,69 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMergeDuplicateCustomers(t *testing.T) {
+	updates := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.Method == "POST" {
+			updates[r.URL.Path] = r.Form.Encode()
+			w.Write([]byte(`{}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/customers":
+			if r.Form.Get("email") != "jo@example.com" {
+				t.Errorf("Expected customers to be listed by email, got %v", r.Form)
+			}
+			w.Write([]byte(`{"data":[
+				{"id":"cus_new","created":1700000200,"default_card":"card_new"},
+				{"id":"cus_old","created":1700000000,"default_card":"card_other"},
+				{"id":"cus_other","created":1600000000}]}`))
+		case "/v1/customers/cus_new/cards":
+			w.Write([]byte(`{"data":[{"id":"card_new","fingerprint":"fp_1"}]}`))
+		case "/v1/customers/cus_old/cards":
+			w.Write([]byte(`{"data":[{"id":"card_other","fingerprint":"fp_2"},{"id":"card_old","fingerprint":"fp_1"}]}`))
+		case "/v1/customers/cus_other/cards":
+			w.Write([]byte(`{"data":[{"id":"card_x","fingerprint":"fp_3"}]}`))
+		case "/v1/customers/cus_old/subscriptions":
+			w.Write([]byte(`{"data":[{"id":"sub_old"}]}`))
+		case "/v1/customers/cus_new/subscriptions":
+			w.Write([]byte(`{"data":[{"id":"sub_new"}]}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	customers := Customers.With(WithBaseURL(server.URL))
+	d, err := customers.FindDuplicates("jo@example.com", "fp_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Canonical.ID != "cus_old" || d.Card.ID != "card_old" || len(d.Duplicates) != 1 || d.Duplicates[0].ID != "cus_new" {
+		t.Fatalf("Expected cus_new to be a duplicate of cus_old, got %+v", d)
+	}
+
+	stranded, err := customers.MergeDuplicates(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"/v1/customers/cus_old":                       "default_card=card_old",
+		"/v1/customers/cus_old/subscriptions/sub_old": "default_source=card_old",
+		"/v1/customers/cus_new":                       "metadata%5Bduplicate_of%5D=cus_old",
+	}
+	for path, form := range want {
+		if updates[path] != form {
+			t.Errorf("Expected %s to be updated with %s, got %q", path, form, updates[path])
+		}
+	}
+	if len(stranded) != 1 || stranded[0].ID != "sub_new" {
+		t.Errorf("Expected sub_new to be returned, got %v", stranded)
+	}
+}
@@ -0,0 +1,60 @@
+package stripe
+
+import ()
+
+// CustomerSession grants client-side Elements, such as the Payment Element or
+// a pricing table, temporary access to a Customer's saved details.
+//
+// see https://stripe.com/docs/api/customer_sessions/object
+type CustomerSession struct {
+	APIResource
+
+	ClientSecret string   `json:"client_secret"`
+	Created      UnixTime `json:"created"`
+	Customer     string   `json:"customer"`
+	ExpiresAt    UnixTime `json:"expires_at"`
+	Livemode     bool     `json:"livemode"`
+}
+
+// CustomerSessionComponent configures a single component of a Customer
+// Session.
+type CustomerSessionComponent struct {
+	// Whether the component is enabled.
+	Enabled bool `form:"enabled"`
+
+	// (Optional) Component features keyed by name, e.g. the Payment
+	// Element's "payment_method_save" or "payment_method_redisplay", with
+	// values such as "enabled" or "disabled".
+	Features map[string]string `form:"features"`
+}
+
+// CustomerSessionParams encapsulates options for creating a new Customer
+// Session.
+type CustomerSessionParams struct {
+	Params
+
+	// The ID of the Customer the session is for.
+	Customer string `form:"customer"`
+
+	// The components to configure, keyed by component name: buy_button,
+	// payment_element or pricing_table.
+	Components map[string]*CustomerSessionComponent `form:"components"`
+}
+
+// CustomerSessionClient encapsulates operations for creating customer
+// sessions using the Stripe REST API.
+type CustomerSessionClient struct{ scope }
+
+// With returns a CustomerSessionClient configured with the given options.
+func (c CustomerSessionClient) With(opts ...ClientOption) CustomerSessionClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Customer Session.
+//
+// see https://stripe.com/docs/api/customer_sessions/create
+func (c CustomerSessionClient) Create(params *CustomerSessionParams) (*CustomerSession, error) {
+	res := &CustomerSession{}
+	return res, c.query("POST", "/customer_sessions", formValues(params), res)
+}
@@ -17,18 +17,18 @@ func init() {
 var (
 	// Customer with only the required fields
 	cust1 = CustomerParams{
-		Email:       "test1@test.com",
-		Description: "a test customer",
+		Email:       String("test1@test.com"),
+		Description: String("a test customer"),
 	}
 
 	// Customer with all required fields + required credit card fields.
 	cust2 = CustomerParams{
-		Email:       "test2@test.com",
-		Description: "a 2nd test customer",
+		Email:       String("test2@test.com"),
+		Description: String("a 2nd test customer"),
 		Coupon:      c1.ID,
-		Plan:        p1.ID,
+		Plan:        String(p1.ID),
 		Card: &CardParams{
-			Name:     "John Smith",
+			Name:     String("John Smith"),
 			Number:   "4242424242424242",
 			ExpYear:  time.Now().Year() + 1,
 			ExpMonth: 1,
@@ -37,16 +37,16 @@ var (
 
 	// Another Customer with only the required fields
 	cust3 = CustomerParams{
-		Email:       "test3@test.com",
-		Description: "a 3rd test customer",
+		Email:       String("test3@test.com"),
+		Description: String("a 3rd test customer"),
 	}
 
 	// A customer with the required fields + a credit card
 	cust4 = CustomerParams{
-		Email:       "test3@test.com",
-		Description: "a 3rd test customer",
+		Email:       String("test3@test.com"),
+		Description: String("a 3rd test customer"),
 		Card: &CardParams{
-			Name:     "John Smith",
+			Name:     String("John Smith"),
 			Number:   "4242424242424242",
 			ExpYear:  time.Now().Year() + 1,
 			ExpMonth: 1,
@@ -65,11 +65,11 @@ func TestCreateCustomer(t *testing.T) {
 	if err != nil {
 		t.Errorf("Expected Customer, got Error %s", err.Error())
 	}
-	if cust.Email != cust1.Email {
-		t.Errorf("Expected Customer Email %s, got %v", cust1.Email, cust.Email)
+	if cust.Email != *cust1.Email {
+		t.Errorf("Expected Customer Email %s, got %v", *cust1.Email, cust.Email)
 	}
-	if cust.Description != cust1.Description {
-		t.Errorf("Expected Customer Desc %s, got %v", cust1.Description, cust.Description)
+	if cust.Description != *cust1.Description {
+		t.Errorf("Expected Customer Desc %s, got %v", *cust1.Description, cust.Description)
 	}
 }
 
@@ -82,8 +82,8 @@ func TestCreateCustomerToken(t *testing.T) {
 
 	// Create a Charge that uses a Token
 	cust := CustomerParams{
-		Token:       token.ID,
-		Description: "Customer for site@stripe.com",
+		Token:       String(token.ID),
+		Description: String("Customer for site@stripe.com"),
 	}
 
 	// Create the charge
@@ -109,7 +109,7 @@ func TestRetrieveCustomer(t *testing.T) {
 	Plans.Create(&p1)
 	Coupons.Create(&c1)
 	defer Plans.Delete(p1.ID)
-	defer Coupons.Delete(c1.ID)
+	defer Coupons.Delete(*c1.ID)
 
 	// Create the customer, and defer its deletion
 	resp, err := Customers.Create(&cust2)
@@ -124,19 +124,19 @@ func TestRetrieveCustomer(t *testing.T) {
 	if err != nil {
 		t.Errorf("Expected Customer, got Error %s", err.Error())
 	}
-	if cust.Email != cust2.Email {
-		t.Errorf("Expected Customer Email %s, got %v", cust2.Email, cust.Email)
+	if cust.Email != *cust2.Email {
+		t.Errorf("Expected Customer Email %s, got %v", *cust2.Email, cust.Email)
 	}
-	if cust.Description != cust2.Description {
-		t.Errorf("Expected Customer Desc %s, got %v", cust2.Description, cust.Description)
+	if cust.Description != *cust2.Description {
+		t.Errorf("Expected Customer Desc %s, got %v", *cust2.Description, cust.Description)
 	}
 	if len(cust.Cards.Data) == 0 {
 		t.Errorf("Expected Credit Card %s, got nil", cust2.Card.Number)
 		return
 	}
 
-	if cust.Cards.Data[0].Name != cust2.Card.Name {
-		t.Errorf("Expected Card Name %s, got %s", cust2.Card.Name, cust.Cards.Data[0].Name)
+	if cust.Cards.Data[0].Name != *cust2.Card.Name {
+		t.Errorf("Expected Card Name %s, got %s", *cust2.Card.Name, cust.Cards.Data[0].Name)
 	}
 	if cust.Cards.Data[0].Last4 != "4242" {
 		t.Errorf("Expected Card Last4 %s, got %s", "4242", cust.Cards.Data[0].Last4)
@@ -159,8 +159,8 @@ func TestUpdateCustomer(t *testing.T) {
 	resp, _ := Customers.Create(&cust1)
 	defer Customers.Delete(resp.ID)
 
-	balance := -100
-	cust, err := Customers.Update(resp.ID, &CustomerParams{Email: "joe@email.com", Balance: &balance})
+	balance := int64(-100)
+	cust, err := Customers.Update(resp.ID, &CustomerParams{Email: String("joe@email.com"), Balance: &balance})
 	if err != nil {
 		t.Errorf("Expected Customer update, got Error %s", err.Error())
 	}
@@ -181,11 +181,11 @@ func TestDeleteCustomer(t *testing.T) {
 	defer Customers.Delete(resp.ID)
 
 	// let's try to delete the customer
-	ok, err := Customers.Delete(resp.ID)
+	deleted, err := Customers.Delete(resp.ID)
 	if err != nil {
 		t.Errorf("Expected Customer deletion, got Error %s", err.Error())
 	}
-	if !ok {
+	if !deleted.Deleted {
 		t.Errorf("Expected Customer deleted true, got false")
 	}
 }
@@ -212,3 +212,27 @@ func TestListCustomers(t *testing.T) {
 		t.Errorf("Expected 2 Customers, got %d", len(customers))
 	}
 }
+
+// TestCustomerAddressParams will test that the billing address and shipping
+// details are nested under their parameter names.
+func TestCustomerAddressParams(t *testing.T) {
+	values := formValues(&CustomerParams{
+		Address: &Address{Line1: "1 Main St", Country: "US"},
+		Shipping: &ShippingDetails{
+			Name:    "George Costanza",
+			Address: &Address{Line1: "129 W 81st St", City: "New York"},
+		},
+	})
+	want := map[string]string{
+		"address[line1]":           "1 Main St",
+		"address[country]":         "US",
+		"shipping[name]":           "George Costanza",
+		"shipping[address][line1]": "129 W 81st St",
+		"shipping[address][city]":  "New York",
+	}
+	for k, v := range want {
+		if got := values.Get(k); got != v {
+			t.Errorf("Expected %s %q, got %q", k, v, got)
+		}
+	}
+}
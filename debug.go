@@ -0,0 +1,221 @@
+package stripe
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// the logger full requests and responses are dumped to, if any
+var _debug *log.Logger
+
+// SetDebugLogger will log the full request and response of every Stripe API
+// call to l, including their headers and bodies, to find out why Stripe
+// rejected a request. Card numbers, CVCs, bank account numbers, API keys and
+// other secrets are redacted before they are logged, as SanitizeParams
+// redacts them for audit logs, as is the Authorization header.
+// The bodies of pages listed with Each are not logged, as they are parsed
+// while they are received. Setting it to nil, the default, stops logging.
+func SetDebugLogger(l *log.Logger) {
+	_debug = l
+}
+
+// debugRequest dumps the request, with its body of the given content type,
+// to the debug logger.
+func (s scope) debugRequest(req *http.Request, body string, contentType string) {
+	if _debug == nil {
+		return
+	}
+	if contentType == "application/json" {
+		body = redactJSON([]byte(body))
+	} else {
+		body = redactForm(body)
+	}
+	_debug.Printf("REQUEST: %s %s %s\n%s\n%s", req.Method, req.URL.String(), s.logCorrelation(), redactHeaders(req.Header), body)
+}
+
+// debugResponse dumps the response to the debug logger.
+func (s scope) debugResponse(res response) {
+	if _debug == nil {
+		return
+	}
+	_debug.Printf("RESPONSE: %d %s %s\n%s", res.status, res.requestID, s.logCorrelation(), redactJSON(res.body))
+}
+
+// redactHeaders formats the headers one per line, sorted by name, with the
+// credentials of the Authorization header and any secrets redacted.
+func redactHeaders(h http.Header) string {
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	lines := make([]string, 0, len(names))
+	for _, name := range names {
+		value := strings.Join(h[name], ", ")
+		if name == "Authorization" {
+			// keep the scheme, e.g. "Bearer REDACTED"
+			if i := strings.IndexByte(value, ' '); i >= 0 {
+				value = value[:i+1] + redacted
+			} else {
+				value = redacted
+			}
+		}
+		lines = append(lines, name+": "+secretValues.ReplaceAllString(value, redacted))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// redactForm formats the form encoded body sanitized with SanitizeParams,
+// and unescaped, so nested parameters can be read.
+func redactForm(body string) string {
+	values, err := url.ParseQuery(body)
+	if err != nil {
+		return secretValues.ReplaceAllString(body, redacted)
+	}
+	values = SanitizeParams(values)
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var pairs []string
+	for _, key := range keys {
+		for _, value := range values[key] {
+			pairs = append(pairs, key+"="+value)
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// redactJSON returns the JSON body with the values of sensitive fields and
+// any secrets redacted, as SanitizeParams redacts form parameters.
+func redactJSON(body []byte) string {
+	var data interface{}
+	if json.Unmarshal(body, &data) != nil {
+		return secretValues.ReplaceAllString(string(body), redacted)
+	}
+	out, err := json.Marshal(redactValue(data))
+	if err != nil {
+		return redacted
+	}
+	return string(out)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, isString := value.(string); isString && sensitiveParams[key] {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactValue(value)
+		}
+	case string:
+		return secretValues.ReplaceAllString(v, redacted)
+	}
+	return v
+}
+
+// FormatParams returns the form-encoded body sent to Stripe for params, a
+// Params struct encoded with form tags or the url.Values a Backend receives,
+// with its secrets redacted as SanitizeParams redacts them, to see exactly
+// what a request sends. Params encoded by their client methods, rather than
+// with form tags, can be formatted from the values passed to a Backend set
+// with WithBackend.
+func FormatParams(params interface{}) string {
+	return SanitizeParams(paramValues(params)).Encode()
+}
+
+// FormatParamsJSON returns params, as taken by FormatParams, with their
+// secrets redacted and formatted as indented JSON, nested as Stripe decodes
+// the form: card[number] is a field of an object, and expand[] and items[0]
+// are arrays.
+func FormatParamsJSON(params interface{}) string {
+	out, err := json.MarshalIndent(nestParams(SanitizeParams(paramValues(params))), "", "  ")
+	if err != nil {
+		return redacted
+	}
+	return string(out)
+}
+
+func paramValues(params interface{}) url.Values {
+	if values, ok := params.(url.Values); ok {
+		return values
+	}
+	return formValues(params)
+}
+
+// nestParams decodes the bracket notation of the form parameters into nested
+// objects and arrays.
+func nestParams(values url.Values) interface{} {
+	root := make(map[string]interface{})
+	for key, vals := range values {
+		path := paramPath(key)
+		node := root
+		for i, name := range path {
+			if i == len(path)-1 || (i == len(path)-2 && path[i+1] == "") {
+				if len(vals) == 1 && i == len(path)-1 {
+					node[name] = vals[0]
+				} else {
+					list := make([]interface{}, len(vals))
+					for j, v := range vals {
+						list[j] = v
+					}
+					node[name] = list
+				}
+				break
+			}
+			child, ok := node[name].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				node[name] = child
+			}
+			node = child
+		}
+	}
+	return indexedLists(root)
+}
+
+// paramPath splits a form parameter name into its parts, e.g. items, 0 and
+// price for items[0][price].
+func paramPath(key string) []string {
+	i := strings.IndexByte(key, '[')
+	if i < 0 || !strings.HasSuffix(key, "]") {
+		return []string{key}
+	}
+	return append([]string{key[:i]}, strings.Split(key[i+1:len(key)-1], "][")...)
+}
+
+// indexedLists replaces the objects whose fields are the indexes 0 to n-1
+// with arrays.
+func indexedLists(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+	for key, value := range m {
+		m[key] = indexedLists(value)
+	}
+	list := make([]interface{}, len(m))
+	for key, value := range m {
+		i, err := strconv.Atoi(key)
+		if err != nil || i < 0 || i >= len(m) || strconv.Itoa(i) != key {
+			return m
+		}
+		list[i] = value
+	}
+	if len(list) == 0 {
+		return m
+	}
+	return list
+}
@@ -0,0 +1,76 @@
+package stripe
+
+// DestinationRefund is the result of RefundDestination.
+type DestinationRefund struct {
+	// The Charge once refunded.
+	Charge *Charge
+
+	// The reversal of the Charge's Transfer to the connected account, or nil
+	// if it has none.
+	Reversal *Reversal
+
+	// The refund of the platform's ApplicationFee, or nil if it has none.
+	FeeRefund *FeeRefund
+}
+
+// RefundDestination refunds amount of a destination charge, or all of what
+// remains if amount is 0, and takes the same share of the funds back from the
+// connected account: it reverses that share of the Charge's Transfer, and
+// refunds that share of the platform's ApplicationFee.
+//
+// Each of the requests is sent with an Idempotency-Key derived from key, so
+// that repeating the call with the same key after a failure completes the
+// steps that did not happen without repeating those that did. The amount
+// must then be given explicitly, since after the refund nothing remains. A key
+// is generated if it is empty.
+//
+// see https://stripe.com/docs/connect/destination-charges#issuing-refunds
+func (c ChargeClient) RefundDestination(id string, amount int64, key string) (*DestinationRefund, error) {
+	if key == "" {
+		key = newIdempotencyKey()
+	}
+	charge, err := c.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if amount == 0 {
+		amount = charge.Amount - charge.AmountRefunded
+	}
+	share := func(total int64) int64 {
+		if charge.Amount == 0 {
+			return 0
+		}
+		return (total*amount + charge.Amount/2) / charge.Amount
+	}
+	with := func(suffix string) scope {
+		s := c.scope
+		s.idempotencyKey = key + "-" + suffix
+		return s
+	}
+
+	res := &DestinationRefund{}
+	if res.Charge, err = (ChargeClient{with("refund")}).RefundAmount(id, amount); err != nil {
+		return nil, err
+	}
+	if charge.Transfer != "" {
+		transfer, err := TransferClient{c.scope}.Get(charge.Transfer)
+		if err != nil {
+			return res, err
+		}
+		params := &ReversalParams{Amount: Int64(share(transfer.Amount))}
+		if res.Reversal, err = (ReversalClient{with("reversal")}).Create(charge.Transfer, params); err != nil {
+			return res, err
+		}
+	}
+	if charge.ApplicationFee != "" {
+		fee, err := ApplicationFeeClient{c.scope}.Get(charge.ApplicationFee)
+		if err != nil {
+			return res, err
+		}
+		params := &FeeRefundParams{Amount: Int64(share(fee.Amount))}
+		if res.FeeRefund, err = (FeeRefundClient{with("fee_refund")}).Create(charge.ApplicationFee, params); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
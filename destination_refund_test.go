@@ -0,0 +1,51 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRefundDestination(t *testing.T) {
+	keys := map[string]string{}
+	amounts := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.Method == "POST" {
+			keys[r.URL.Path] = r.Header.Get("Idempotency-Key")
+			amounts[r.URL.Path] = r.Form.Get("amount")
+		}
+		switch r.URL.Path {
+		case "/v1/charges/ch_1":
+			w.Write([]byte(`{"id":"ch_1","amount":10000,"transfer":"tr_1","application_fee":"fee_1"}`))
+		case "/v1/charges/ch_1/refund":
+			w.Write([]byte(`{"id":"ch_1","amount":10000,"amount_refunded":2500}`))
+		case "/v1/transfers/tr_1":
+			w.Write([]byte(`{"id":"tr_1","amount":9000}`))
+		case "/v1/transfers/tr_1/reversals":
+			w.Write([]byte(`{"id":"trr_1"}`))
+		case "/v1/application_fees/fee_1":
+			w.Write([]byte(`{"id":"fee_1","amount":1000}`))
+		case "/v1/application_fees/fee_1/refunds":
+			w.Write([]byte(`{"id":"fr_1"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	res, err := Charges.With(WithBaseURL(server.URL)).RefundDestination("ch_1", 2500, "order_6735")
+	if err != nil || res.Reversal == nil || res.FeeRefund == nil {
+		t.Fatalf("Expected refund, reversal and fee refund, got %+v, %v", res, err)
+	}
+	want := map[string][2]string{
+		"/v1/charges/ch_1/refund":            {"2500", "order_6735-refund"},
+		"/v1/transfers/tr_1/reversals":       {"2250", "order_6735-reversal"},
+		"/v1/application_fees/fee_1/refunds": {"250", "order_6735-fee_refund"},
+	}
+	for path, w := range want {
+		if amounts[path] != w[0] || keys[path] != w[1] {
+			t.Errorf("Expected %s with amount %s and key %s, got %s and %s", path, w[0], w[1], amounts[path], keys[path])
+		}
+	}
+}
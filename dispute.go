@@ -0,0 +1,237 @@
+package stripe
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+)
+
+var EvidenceFieldError = errors.New("stripe: dispute evidence field does not accept a file")
+
+// DisputeStatus is the status of a Dispute.
+type DisputeStatus string
+
+// Dispute Statuses
+const (
+	DisputeWarningNeedsResponse DisputeStatus = "warning_needs_response"
+	DisputeWarningUnderReview   DisputeStatus = "warning_under_review"
+	DisputeWarningClosed        DisputeStatus = "warning_closed"
+	DisputeNeedsResponse        DisputeStatus = "needs_response"
+	DisputeUnderReview          DisputeStatus = "under_review"
+	DisputeWon                  DisputeStatus = "won"
+	DisputeLost                 DisputeStatus = "lost"
+)
+
+// DisputeReason is the reason the customer gave their bank for a Dispute.
+type DisputeReason string
+
+// Dispute Reasons
+const (
+	DisputeReasonBankCannotProcess       DisputeReason = "bank_cannot_process"
+	DisputeReasonCheckReturned           DisputeReason = "check_returned"
+	DisputeReasonCreditNotProcessed      DisputeReason = "credit_not_processed"
+	DisputeReasonCustomerInitiated       DisputeReason = "customer_initiated"
+	DisputeReasonDebitNotAuthorized      DisputeReason = "debit_not_authorized"
+	DisputeReasonDuplicate               DisputeReason = "duplicate"
+	DisputeReasonFraudulent              DisputeReason = "fraudulent"
+	DisputeReasonGeneral                 DisputeReason = "general"
+	DisputeReasonIncorrectAccountDetails DisputeReason = "incorrect_account_details"
+	DisputeReasonInsufficientFunds       DisputeReason = "insufficient_funds"
+	DisputeReasonProductNotReceived      DisputeReason = "product_not_received"
+	DisputeReasonProductUnacceptable     DisputeReason = "product_unacceptable"
+	DisputeReasonSubscriptionCanceled    DisputeReason = "subscription_canceled"
+	DisputeReasonUnrecognized            DisputeReason = "unrecognized"
+)
+
+// Dispute represents a chargeback or inquiry raised by a customer's card
+// issuer against a Charge.
+//
+// see https://stripe.com/docs/api#dispute_object
+type Dispute struct {
+	APIResource
+
+	ID                 string            `json:"id"`
+	Charge             string            `json:"charge"`
+	PaymentIntent      string            `json:"payment_intent,omitempty"`
+	Livemode           bool              `json:"livemode"`
+	Amount             int64             `json:"amount"`
+	Created            UnixTime          `json:"created"`
+	Currency           Currency          `json:"currency"`
+	Reason             DisputeReason     `json:"reason"`
+	Status             DisputeStatus     `json:"status"`
+	BalanceTransaction string            `json:"balance_transaction"`
+	Evidence           *DisputeEvidence  `json:"evidence,omitempty"`
+	EvidenceDueBy      *UnixTime         `json:"evidence_due_by,omitempty"`
+	EvidenceDetails    *EvidenceDetails  `json:"evidence_details,omitempty"`
+	Protected          bool              `json:"is_protected,omitempty"`
+	ChargeRefundable   bool              `json:"is_charge_refundable,omitempty"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+}
+
+// DisputeEvidence holds the evidence submitted in response to a Dispute.
+// Fields documented as files take the ID of a File uploaded with the purpose
+// dispute_evidence.
+//
+// see https://stripe.com/docs/disputes/categories
+type DisputeEvidence struct {
+	AccessActivityLog            string `json:"access_activity_log,omitempty" form:"access_activity_log"`
+	BillingAddress               string `json:"billing_address,omitempty" form:"billing_address"`
+	CancellationPolicy           string `json:"cancellation_policy,omitempty" form:"cancellation_policy"` // file
+	CancellationPolicyDisclosure string `json:"cancellation_policy_disclosure,omitempty" form:"cancellation_policy_disclosure"`
+	CancellationRebuttal         string `json:"cancellation_rebuttal,omitempty" form:"cancellation_rebuttal"`
+	CustomerCommunication        string `json:"customer_communication,omitempty" form:"customer_communication"` // file
+	CustomerEmailAddress         string `json:"customer_email_address,omitempty" form:"customer_email_address"`
+	CustomerName                 string `json:"customer_name,omitempty" form:"customer_name"`
+	CustomerPurchaseIP           string `json:"customer_purchase_ip,omitempty" form:"customer_purchase_ip"`
+	CustomerSignature            string `json:"customer_signature,omitempty" form:"customer_signature"`                         // file
+	DuplicateChargeDocumentation string `json:"duplicate_charge_documentation,omitempty" form:"duplicate_charge_documentation"` // file
+	DuplicateChargeExplanation   string `json:"duplicate_charge_explanation,omitempty" form:"duplicate_charge_explanation"`
+	DuplicateChargeID            string `json:"duplicate_charge_id,omitempty" form:"duplicate_charge_id"`
+	ProductDescription           string `json:"product_description,omitempty" form:"product_description"`
+	Receipt                      string `json:"receipt,omitempty" form:"receipt"`             // file
+	RefundPolicy                 string `json:"refund_policy,omitempty" form:"refund_policy"` // file
+	RefundPolicyDisclosure       string `json:"refund_policy_disclosure,omitempty" form:"refund_policy_disclosure"`
+	RefundRefusalExplanation     string `json:"refund_refusal_explanation,omitempty" form:"refund_refusal_explanation"`
+	ServiceDate                  string `json:"service_date,omitempty" form:"service_date"`
+	ServiceDocumentation         string `json:"service_documentation,omitempty" form:"service_documentation"` // file
+	ShippingAddress              string `json:"shipping_address,omitempty" form:"shipping_address"`
+	ShippingCarrier              string `json:"shipping_carrier,omitempty" form:"shipping_carrier"`
+	ShippingDate                 string `json:"shipping_date,omitempty" form:"shipping_date"`
+	ShippingDocumentation        string `json:"shipping_documentation,omitempty" form:"shipping_documentation"` // file
+	ShippingTrackingNumber       string `json:"shipping_tracking_number,omitempty" form:"shipping_tracking_number"`
+	UncategorizedFile            string `json:"uncategorized_file,omitempty" form:"uncategorized_file"` // file
+	UncategorizedText            string `json:"uncategorized_text,omitempty" form:"uncategorized_text"`
+}
+
+// UnmarshalJSON accepts both the evidence object and the single free-form
+// string returned by older API versions, which is stored as UncategorizedText.
+func (e *DisputeEvidence) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &e.UncategorizedText)
+	}
+	type evidence DisputeEvidence
+	return json.Unmarshal(data, (*evidence)(e))
+}
+
+// EvidenceDetails describes the state of the evidence submitted for a
+// Dispute.
+type EvidenceDetails struct {
+	DueBy           *UnixTime `json:"due_by,omitempty"`
+	HasEvidence     bool      `json:"has_evidence"`
+	PastDue         bool      `json:"past_due"`
+	SubmissionCount int       `json:"submission_count"`
+}
+
+// DisputeParams encapsulates options for updating a Dispute.
+type DisputeParams struct {
+	Params
+
+	// (Optional) Evidence to attach to the dispute. Only non-empty fields are
+	// sent, so evidence can be built up over several updates.
+	Evidence *DisputeEvidence `form:"evidence"`
+
+	// (Optional) Whether to immediately submit the evidence to the bank.
+	// Defaults to true; set to false to stage evidence without submitting it.
+	Submit *bool `form:"submit"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// DisputeListParams encapsulates options for filtering a list of Disputes.
+type DisputeListParams struct {
+	ListParams
+
+	// (Optional) Only return disputes for the Charge with the given ID.
+	Charge string `form:"charge"`
+
+	// (Optional) Only return disputes for the PaymentIntent with the given ID.
+	PaymentIntent string `form:"payment_intent"`
+
+	// (Optional) Only return disputes created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// DisputeClient encapsulates operations for updating, closing and querying
+// disputes using the Stripe REST API.
+type DisputeClient struct{ scope }
+
+// With returns a DisputeClient configured with the given options.
+func (c DisputeClient) With(opts ...ClientOption) DisputeClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Dispute with the given ID.
+//
+// see https://stripe.com/docs/api#retrieve_dispute
+func (c DisputeClient) Get(id string) (*Dispute, error) {
+	res := &Dispute{}
+	return res, c.query("GET", "/disputes/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the Dispute with the given ID, attaching evidence and metadata.
+// Evidence must be submitted before the dispute's EvidenceDetails.DueBy.
+//
+// see https://stripe.com/docs/api#update_dispute
+func (c DisputeClient) Update(id string, params *DisputeParams) (*Dispute, error) {
+	res := &Dispute{}
+	return res, c.query("POST", "/disputes/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Closes the Dispute with the given ID, conceding it as lost. This cannot be
+// undone.
+//
+// see https://stripe.com/docs/api#close_dispute
+func (c DisputeClient) Close(id string) (*Dispute, error) {
+	res := &Dispute{}
+	return res, c.query("POST", "/disputes/"+url.QueryEscape(id)+"/close", nil, res)
+}
+
+// Returns a list of Disputes matching the params.
+//
+// see https://stripe.com/docs/api#list_disputes
+func (c DisputeClient) List(params *DisputeListParams) ([]*Dispute, bool, error) {
+	res := struct {
+		ListObject
+		Data []*Dispute
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/disputes", values, &res)
+	return res.Data, res.More, err
+}
+
+// AttachEvidence uploads the contents of r as a File with the purpose
+// dispute_evidence and sets its ID on the named evidence field of the Dispute,
+// e.g. "receipt" or "shipping_documentation". The evidence is staged rather
+// than submitted, so several files can be attached before calling Update with
+// Submit set to true.
+func (c DisputeClient) AttachEvidence(id, field, filename string, r io.Reader) (*Dispute, *File, error) {
+	if !evidenceFileFields[field] {
+		return nil, nil, EvidenceFieldError
+	}
+	file, err := FileClient{c.scope}.Create(FilePurposeDisputeEvidence, filename, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	values := url.Values{
+		"evidence[" + field + "]": {file.ID},
+		"submit":                  {"false"},
+	}
+	res := &Dispute{}
+	return res, file, c.query("POST", "/disputes/"+url.QueryEscape(id), values, res)
+}
+
+// evidenceFileFields is the set of evidence fields that take a File ID.
+var evidenceFileFields = map[string]bool{
+	"cancellation_policy":            true,
+	"customer_communication":         true,
+	"customer_signature":             true,
+	"duplicate_charge_documentation": true,
+	"receipt":                        true,
+	"refund_policy":                  true,
+	"service_documentation":          true,
+	"shipping_documentation":         true,
+	"uncategorized_file":             true,
+}
@@ -0,0 +1,102 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// TestDisputeEvidence will test that dispute evidence decodes from both the
+// evidence object and the legacy free-form string.
+func TestDisputeEvidence(t *testing.T) {
+	d := Dispute{}
+	if err := json.Unmarshal([]byte(`{"id":"dp_1","evidence":"shipped on time"}`), &d); err != nil {
+		t.Fatalf("Expected Dispute, got Error %s", err.Error())
+	}
+	if d.Evidence.UncategorizedText != "shipped on time" {
+		t.Errorf("Expected legacy evidence as UncategorizedText, got %q", d.Evidence.UncategorizedText)
+	}
+
+	d = Dispute{}
+	if err := json.Unmarshal([]byte(`{"id":"dp_1","evidence":{"receipt":"file_1","customer_name":"Jane"}}`), &d); err != nil {
+		t.Fatalf("Expected Dispute, got Error %s", err.Error())
+	}
+	if d.Evidence.Receipt != "file_1" || d.Evidence.CustomerName != "Jane" {
+		t.Errorf("Expected evidence object to be decoded, got %+v", d.Evidence)
+	}
+}
+
+// TestDisputeStatusAndReason will test that a dispute's status and reason
+// decode to their typed constants.
+func TestDisputeStatusAndReason(t *testing.T) {
+	d := Dispute{}
+	if err := json.Unmarshal([]byte(`{"id":"dp_1","status":"needs_response","reason":"product_not_received"}`), &d); err != nil {
+		t.Fatalf("Expected Dispute, got Error %s", err.Error())
+	}
+	switch {
+	case d.Status != DisputeNeedsResponse:
+		t.Errorf("Expected Status %s, got %s", DisputeNeedsResponse, d.Status)
+	case d.Reason != DisputeReasonProductNotReceived:
+		t.Errorf("Expected Reason %s, got %s", DisputeReasonProductNotReceived, d.Reason)
+	}
+}
+
+// TestDisputeAttachEvidence will test that a file is uploaded as dispute
+// evidence and its ID staged on the dispute.
+func TestDisputeAttachEvidence(t *testing.T) {
+	var form url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/files":
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if r.FormValue("purpose") != FilePurposeDisputeEvidence {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			f, h, err := r.FormFile("file")
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			data, _ := ioutil.ReadAll(f)
+			fmt.Fprintf(w, `{"id":"file_1","filename":%q,"size":%d,"purpose":"dispute_evidence"}`, h.Filename, len(data))
+		case "/v1/disputes/dp_1":
+			r.ParseForm()
+			form = r.PostForm
+			w.Write([]byte(`{"id":"dp_1","evidence":{"receipt":"file_1"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	defer SetUrl(_url)
+	defer SetFilesUrl(_filesUrl)
+	SetUrl(server.URL)
+	SetFilesUrl(server.URL)
+
+	dispute, file, err := Disputes.AttachEvidence("dp_1", "receipt", "receipt.pdf", strings.NewReader("%PDF-1.4"))
+	if err != nil {
+		t.Fatalf("Expected Dispute, got Error %s", err.Error())
+	}
+	if file.Filename != "receipt.pdf" || file.Size != 8 {
+		t.Errorf("Expected uploaded File receipt.pdf of 8 bytes, got %s of %d", file.Filename, file.Size)
+	}
+	if form.Get("evidence[receipt]") != "file_1" || form.Get("submit") != "false" {
+		t.Errorf("Expected staged evidence[receipt]=file_1, got %v", form)
+	}
+	if dispute.Evidence.Receipt != "file_1" {
+		t.Errorf("Expected Dispute receipt file_1, got %s", dispute.Evidence.Receipt)
+	}
+
+	if _, _, err := Disputes.AttachEvidence("dp_1", "customer_name", "x.txt", strings.NewReader("")); err != EvidenceFieldError {
+		t.Errorf("Expected EvidenceFieldError, got %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package stripe
+
+import "net/url"
+
+// InvoiceRecovery is the result of RetryWithPaymentMethod.
+type InvoiceRecovery struct {
+	// The Invoice after the payment was attempted.
+	Invoice *Invoice
+
+	// The PaymentIntent of the Invoice, if it is still unpaid. Its Outcome
+	// tells what has to happen next, e.g. PaymentRequiresAction if the
+	// customer has to authenticate the payment on-session with its
+	// ClientSecret.
+	PaymentIntent *PaymentIntent
+}
+
+// Paid reports whether the Invoice was paid.
+func (r *InvoiceRecovery) Paid() bool {
+	return r.Invoice != nil && r.Invoice.Paid
+}
+
+// RetryWithPaymentMethod recovers the failed payment of an open Invoice with
+// a new payment method from the Customer, such as one they entered after
+// their card was declined: it attaches the PaymentMethod to the Customer,
+// makes it their default for invoices, so later renewals use it too, and
+// attempts to pay the Invoice with it.
+//
+// If the payment does not succeed because the customer has to authenticate
+// it, the Invoice's PaymentIntent is returned in the InvoiceRecovery with no
+// error. Other declines are returned as errors, alongside the InvoiceRecovery.
+//
+// If the client has an Idempotency-Key, each of the requests is sent with a
+// key derived from it, so that repeating the call with the same key after a
+// failure does not repeat the steps that succeeded.
+//
+// see https://stripe.com/docs/billing/subscriptions/overview#requires-payment-method
+func (c InvoiceClient) RetryWithPaymentMethod(invoiceID, customerID, paymentMethod string) (*InvoiceRecovery, error) {
+	step := func(suffix string) InvoiceClient {
+		s := c
+		if s.idempotencyKey != "" {
+			s.idempotencyKey += "-" + suffix
+		}
+		return s
+	}
+	var pm struct{ ID string }
+	values := url.Values{"customer": {customerID}}
+	if err := step("attach").query("POST", "/payment_methods/"+url.QueryEscape(paymentMethod)+"/attach", values, &pm); err != nil {
+		return nil, err
+	}
+	values = url.Values{"invoice_settings[default_payment_method]": {paymentMethod}}
+	if err := step("customer").query("POST", "/customers/"+url.QueryEscape(customerID), values, &Customer{}); err != nil {
+		return nil, err
+	}
+
+	rec := &InvoiceRecovery{Invoice: &Invoice{}}
+	path := "/invoices/" + url.QueryEscape(invoiceID)
+	err := step("pay").query("POST", path+"/pay", url.Values{"payment_method": {paymentMethod}}, rec.Invoice)
+	c.uncache(path)
+	if err == nil && rec.Invoice.Paid {
+		return rec, nil
+	}
+	if err != nil {
+		// the Invoice is not returned when the payment fails
+		if err := c.query("GET", path, nil, rec.Invoice); err != nil {
+			return rec, err
+		}
+	}
+	if rec.Invoice.PaymentIntent != "" {
+		pi, err := PaymentIntentClient{c.scope}.Get(rec.Invoice.PaymentIntent)
+		if err != nil {
+			return rec, err
+		}
+		rec.PaymentIntent = pi
+		if pi.Outcome() == PaymentRequiresAction {
+			return rec, nil
+		}
+	}
+	return rec, err
+}
@@ -0,0 +1,61 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRetryWithPaymentMethod(t *testing.T) {
+	var steps, keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		steps = append(steps, r.Method+" "+r.URL.Path)
+		if key := r.Header.Get("Idempotency-Key"); key != "" {
+			keys = append(keys, key)
+		}
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v1/payment_methods/pm_new/attach", "POST /v1/payment_methods/pm_3ds/attach":
+			w.Write([]byte(`{"id":"pm_new"}`))
+		case "POST /v1/customers/cus_1":
+			if r.Form.Get("invoice_settings[default_payment_method]") == "" {
+				t.Errorf("Expected the default payment method to be set, got %v", r.Form)
+			}
+			w.Write([]byte(`{"id":"cus_1"}`))
+		case "POST /v1/invoices/in_1/pay":
+			if r.Form.Get("payment_method") == "pm_3ds" {
+				w.WriteHeader(402)
+				w.Write([]byte(`{"error":{"type":"card_error","code":"invoice_payment_intent_requires_action","message":"Payment requires action"}}`))
+				return
+			}
+			w.Write([]byte(`{"id":"in_1","paid":true}`))
+		case "GET /v1/invoices/in_1":
+			w.Write([]byte(`{"id":"in_1","paid":false,"payment_intent":"pi_1"}`))
+		case "GET /v1/payment_intents/pi_1":
+			w.Write([]byte(`{"id":"pi_1","status":"requires_action","client_secret":"pi_1_secret"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	rec, err := Invoices.With(WithBaseURL(server.URL)).RetryWithPaymentMethod("in_1", "cus_1", "pm_new")
+	if err != nil || !rec.Paid() || rec.PaymentIntent != nil {
+		t.Errorf("Expected the invoice to be paid, got %+v, %v", rec, err)
+	}
+	if len(steps) != 3 {
+		t.Errorf("Expected attach, update and pay requests, got %v", steps)
+	}
+
+	rec, err = Invoices.With(WithBaseURL(server.URL)).RetryWithPaymentMethod("in_1", "cus_1", "pm_3ds")
+	if err != nil || rec.Paid() || rec.PaymentIntent == nil || rec.PaymentIntent.Outcome() != PaymentRequiresAction {
+		t.Errorf("Expected the payment to require action, got %+v, %v", rec, err)
+	}
+
+	keys = nil
+	Invoices.With(WithBaseURL(server.URL), WithIdempotencyKey("key_1")).RetryWithPaymentMethod("in_1", "cus_1", "pm_new")
+	if want := "key_1-attach,key_1-customer,key_1-pay"; strings.Join(keys, ",") != want {
+		t.Errorf("Expected Idempotency-Keys %s, got %v", want, keys)
+	}
+}
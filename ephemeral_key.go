@@ -0,0 +1,81 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+// EphemeralKey is a short-lived key that grants a mobile SDK limited access
+// to a single object, such as a Customer. The SDKs expect the key's JSON
+// exactly as Stripe returned it, which is kept in RawJSON.
+//
+// see https://stripe.com/docs/mobile/ephemeral-keys
+type EphemeralKey struct {
+	ID                string             `json:"id"`
+	AssociatedObjects []AssociatedObject `json:"associated_objects"`
+	Created           UnixTime           `json:"created"`
+	Expires           UnixTime           `json:"expires"`
+	Secret            string             `json:"secret,omitempty"`
+	Livemode          bool               `json:"livemode"`
+
+	// The JSON-encoded key, to be passed unmodified to the mobile SDK.
+	RawJSON json.RawMessage `json:"-"`
+}
+
+// AssociatedObject identifies an object an EphemeralKey grants access to.
+type AssociatedObject struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// UnmarshalJSON decodes the key and keeps a copy of the original JSON.
+func (k *EphemeralKey) UnmarshalJSON(data []byte) error {
+	type key EphemeralKey
+	if err := json.Unmarshal(data, (*key)(k)); err != nil {
+		return err
+	}
+	k.RawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// EphemeralKeyParams encapsulates options for creating a new Ephemeral Key.
+type EphemeralKeyParams struct {
+	Params
+
+	// The Stripe-Version used by the mobile SDK requesting the key, which the
+	// key is created for. Required.
+	APIVersion string `form:"-"`
+
+	// The ID of the object to grant access to; one is required.
+	Customer            string `form:"customer"`
+	IssuingCard         string `form:"issuing_card"`
+	VerificationSession string `form:"verification_session"`
+}
+
+// EphemeralKeyClient encapsulates operations for creating and revoking
+// ephemeral keys using the Stripe REST API.
+type EphemeralKeyClient struct{ scope }
+
+// With returns an EphemeralKeyClient configured with the given options.
+func (c EphemeralKeyClient) With(opts ...ClientOption) EphemeralKeyClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Ephemeral Key. The request is sent with the SDK's API version
+// rather than the library's, as Stripe requires.
+//
+// see https://stripe.com/docs/mobile/ephemeral-keys
+func (c EphemeralKeyClient) Create(params *EphemeralKeyParams) (*EphemeralKey, error) {
+	c.version = params.APIVersion
+	res := &EphemeralKey{}
+	return res, c.query("POST", "/ephemeral_keys", formValues(params), res)
+}
+
+// Revokes the Ephemeral Key with the given ID before it expires.
+//
+// see https://stripe.com/docs/mobile/ephemeral-keys
+func (c EphemeralKeyClient) Delete(id string) (*EphemeralKey, error) {
+	res := &EphemeralKey{}
+	return res, c.query("DELETE", "/ephemeral_keys/"+url.QueryEscape(id), nil, res)
+}
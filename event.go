@@ -0,0 +1,84 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+// Event represents a change to an object in your Stripe account, as delivered
+// to a webhook endpoint.
+//
+// see https://stripe.com/docs/api#event_object
+type Event struct {
+	APIResource
+
+	ID              string    `json:"id"`
+	Type            string    `json:"type"`
+	Created         UnixTime  `json:"created"`
+	Livemode        bool      `json:"livemode"`
+	APIVersion      string    `json:"api_version,omitempty"`
+	PendingWebhooks int       `json:"pending_webhooks"`
+	Request         string    `json:"request,omitempty"`
+	Data            EventData `json:"data"`
+}
+
+// EventData holds the object the event is about, along with the previous
+// values of any attributes that changed.
+type EventData struct {
+	Object             json.RawMessage `json:"object"`
+	PreviousAttributes json.RawMessage `json:"previous_attributes,omitempty"`
+}
+
+// Decode unmarshals the event's object (i.e. the Charge, Customer, Invoice,
+// etc) into the value pointed to by v.
+func (e *Event) Decode(v interface{}) error {
+	return json.Unmarshal(e.Data.Object, v)
+}
+
+// EventListParams encapsulates options for filtering a list of Events.
+type EventListParams struct {
+	ListParams
+
+	// (Optional) Only return events of the given type, e.g.
+	// "invoice.paid", or of the types it matches with a wildcard, e.g.
+	// "invoice.*".
+	Type string `form:"type"`
+
+	// (Optional) Only return events of one of the given types, of which up
+	// to 20 can be given.
+	Types []string `form:"types"`
+
+	// (Optional) Only return events created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// EventClient encapsulates operations for retrieving Events. Stripe keeps
+// events for 30 days.
+type EventClient struct{ scope }
+
+// With returns an EventClient configured with the given options.
+func (c EventClient) With(opts ...ClientOption) EventClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Event with the given ID.
+//
+// see https://stripe.com/docs/api#retrieve_event
+func (c EventClient) Get(id string) (*Event, error) {
+	event := Event{}
+	err := c.query("GET", "/events/"+url.QueryEscape(id), nil, &event)
+	return &event, err
+}
+
+// Returns a list of Events matching the params, most recent first.
+//
+// see https://stripe.com/docs/api#list_events
+func (c EventClient) List(params *EventListParams) ([]*Event, bool, error) {
+	res := struct {
+		ListObject
+		Data []*Event
+	}{}
+	err := c.query("GET", "/events", formValues(params), &res)
+	return res.Data, res.More, err
+}
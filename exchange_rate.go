@@ -0,0 +1,113 @@
+package stripe
+
+import (
+	"math"
+	"net/url"
+)
+
+// ExchangeRate holds the rates Stripe uses to convert from a base currency,
+// given by its ID, to every other supported currency.
+//
+// see https://stripe.com/docs/api#exchange_rate_object
+type ExchangeRate struct {
+	APIResource
+
+	ID    string               `json:"id"`
+	Rates map[Currency]float64 `json:"rates"`
+}
+
+// Convert estimates how much the amount, in cents of the base currency, is
+// worth in cents of the given currency. The result is rounded to the nearest
+// unit and is only an estimate; the rate applied to a charge may differ. The
+// boolean is false if no rate is available for the currency.
+func (r *ExchangeRate) Convert(amount int64, currency Currency) (int64, bool) {
+	if string(currency) == r.ID {
+		return amount, true
+	}
+	rate, ok := r.Rates[currency]
+	if !ok {
+		return 0, false
+	}
+	return int64(math.Floor(float64(amount)*rate + 0.5)), true
+}
+
+// FXQuote locks an exchange rate between one or more source currencies and a
+// target currency for a period of time.
+//
+// see https://docs.stripe.com/api/fx_quotes/object
+type FXQuote struct {
+	APIResource
+
+	ID            string                  `json:"id"`
+	Created       UnixTime                `json:"created"`
+	LockDuration  string                  `json:"lock_duration"`
+	LockExpiresAt *UnixTime               `json:"lock_expires_at,omitempty"`
+	LockStatus    string                  `json:"lock_status"`
+	Rates         map[string]*FXQuoteRate `json:"rates"`
+	ToCurrency    Currency                `json:"to_currency"`
+}
+
+// FXQuoteRate is the rate quoted for converting from a single currency.
+type FXQuoteRate struct {
+	ExchangeRate float64 `json:"exchange_rate"`
+}
+
+// FXQuoteParams encapsulates options for creating a new FX Quote.
+type FXQuoteParams struct {
+	Params
+
+	// The currency to convert to.
+	ToCurrency Currency `form:"to_currency"`
+
+	// The currencies to convert from.
+	FromCurrencies []Currency `form:"from_currencies"`
+
+	// How long the rate is locked for: none, five_minutes, hour or day.
+	LockDuration string `form:"lock_duration"`
+}
+
+// ExchangeRateClient encapsulates operations for querying exchange rates and
+// creating FX quotes using the Stripe REST API.
+type ExchangeRateClient struct{ scope }
+
+// With returns an ExchangeRateClient configured with the given options.
+func (c ExchangeRateClient) With(opts ...ClientOption) ExchangeRateClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Exchange Rates from the given base currency.
+//
+// see https://stripe.com/docs/api#retrieve_exchange_rate
+func (c ExchangeRateClient) Get(currency Currency) (*ExchangeRate, error) {
+	res := &ExchangeRate{}
+	return res, c.query("GET", "/exchange_rates/"+url.QueryEscape(string(currency)), nil, res)
+}
+
+// Returns the Exchange Rates for every supported base currency.
+//
+// see https://stripe.com/docs/api#list_exchange_rates
+func (c ExchangeRateClient) List(limit int, before, after string) ([]*ExchangeRate, bool, error) {
+	res := struct {
+		ListObject
+		Data []*ExchangeRate
+	}{}
+	err := c.query("GET", "/exchange_rates", listParams(limit, before, after), &res)
+	return res.Data, res.More, err
+}
+
+// Creates a new FX Quote, optionally locking its rates.
+//
+// see https://docs.stripe.com/api/fx_quotes/create
+func (c ExchangeRateClient) Quote(params *FXQuoteParams) (*FXQuote, error) {
+	res := &FXQuote{}
+	return res, c.query("POST", "/fx_quotes", formValues(params), res)
+}
+
+// Retrieves the FX Quote with the given ID.
+//
+// see https://docs.stripe.com/api/fx_quotes/retrieve
+func (c ExchangeRateClient) GetQuote(id string) (*FXQuote, error) {
+	res := &FXQuote{}
+	return res, c.query("GET", "/fx_quotes/"+url.QueryEscape(id), nil, res)
+}
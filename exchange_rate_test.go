@@ -0,0 +1,38 @@
+package stripe
+
+import (
+	"testing"
+)
+
+func TestExchangeRateConvert(t *testing.T) {
+	rate := ExchangeRate{ID: "usd", Rates: map[Currency]float64{EUR: 0.9, JPY: 150.123}}
+
+	tests := []struct {
+		currency Currency
+		want     int64
+		ok       bool
+	}{
+		{USD, 1000, true},
+		{EUR, 900, true},
+		{JPY, 150123, true},
+		{GBP, 0, false},
+	}
+	for _, test := range tests {
+		got, ok := rate.Convert(1000, test.currency)
+		if got != test.want || ok != test.ok {
+			t.Errorf("convert to %s [%d %v]; want [%d %v]", test.currency, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+// TestGetExchangeRate will test that we can retrieve the rates from USD.
+func TestGetExchangeRate(t *testing.T) {
+	rate, err := ExchangeRates.Get(USD)
+	if err != nil {
+		t.Errorf("Expected Exchange Rate, got Error %s", err.Error())
+		return
+	}
+	if _, ok := rate.Rates[EUR]; !ok {
+		t.Errorf("Expected Exchange Rate from USD to EUR")
+	}
+}
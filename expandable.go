@@ -0,0 +1,139 @@
+package stripe
+
+import "encoding/json"
+
+// Fields that reference another API resource are returned by Stripe as the
+// resource's ID, unless the field was expanded in the request in which case
+// the full object is returned in its place. The Expandable types below accept
+// either form, exposing the ID in both cases and the object only when it was
+// expanded.
+//
+// see https://stripe.com/docs/api#expanding_objects
+
+// isJSONString reports whether data holds a JSON string, i.e. an unexpanded ID.
+func isJSONString(data []byte) bool {
+	return len(data) > 0 && data[0] == '"'
+}
+
+func marshalID(id string) ([]byte, error) {
+	if id == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(id)
+}
+
+// ExpandableCharge references a Charge by ID, or holds the Charge itself if
+// it was expanded.
+type ExpandableCharge struct {
+	id     string
+	object *Charge
+}
+
+// ID returns the ID of the referenced Charge.
+func (e ExpandableCharge) ID() string {
+	if e.object != nil {
+		return e.object.ID
+	}
+	return e.id
+}
+
+// Object returns the referenced Charge, or nil if it was not expanded.
+func (e ExpandableCharge) Object() *Charge {
+	return e.object
+}
+
+// UnmarshalJSON accepts either the Charge's ID or the expanded Charge object.
+func (e *ExpandableCharge) UnmarshalJSON(data []byte) error {
+	*e = ExpandableCharge{}
+	if isJSONString(data) {
+		return json.Unmarshal(data, &e.id)
+	}
+	return json.Unmarshal(data, &e.object)
+}
+
+// MarshalJSON encodes the expanded Charge object if set, otherwise the ID, or
+// null if there is no Charge.
+func (e ExpandableCharge) MarshalJSON() ([]byte, error) {
+	if e.object != nil {
+		return json.Marshal(e.object)
+	}
+	return marshalID(e.id)
+}
+
+// ExpandableCustomer references a Customer by ID, or holds the Customer itself
+// if it was expanded.
+type ExpandableCustomer struct {
+	id     string
+	object *Customer
+}
+
+// ID returns the ID of the referenced Customer.
+func (e ExpandableCustomer) ID() string {
+	if e.object != nil {
+		return e.object.ID
+	}
+	return e.id
+}
+
+// Object returns the referenced Customer, or nil if it was not expanded.
+func (e ExpandableCustomer) Object() *Customer {
+	return e.object
+}
+
+// UnmarshalJSON accepts either the Customer's ID or the expanded Customer
+// object.
+func (e *ExpandableCustomer) UnmarshalJSON(data []byte) error {
+	*e = ExpandableCustomer{}
+	if isJSONString(data) {
+		return json.Unmarshal(data, &e.id)
+	}
+	return json.Unmarshal(data, &e.object)
+}
+
+// MarshalJSON encodes the expanded Customer object if set, otherwise the ID, or
+// null if there is no Customer.
+func (e ExpandableCustomer) MarshalJSON() ([]byte, error) {
+	if e.object != nil {
+		return json.Marshal(e.object)
+	}
+	return marshalID(e.id)
+}
+
+// ExpandablePaymentIntent references a PaymentIntent by ID, or holds the
+// PaymentIntent itself if it was expanded.
+type ExpandablePaymentIntent struct {
+	id     string
+	object *PaymentIntent
+}
+
+// ID returns the ID of the referenced PaymentIntent.
+func (e ExpandablePaymentIntent) ID() string {
+	if e.object != nil {
+		return e.object.ID
+	}
+	return e.id
+}
+
+// Object returns the referenced PaymentIntent, or nil if it was not expanded.
+func (e ExpandablePaymentIntent) Object() *PaymentIntent {
+	return e.object
+}
+
+// UnmarshalJSON accepts either the PaymentIntent's ID or the expanded
+// PaymentIntent object.
+func (e *ExpandablePaymentIntent) UnmarshalJSON(data []byte) error {
+	*e = ExpandablePaymentIntent{}
+	if isJSONString(data) {
+		return json.Unmarshal(data, &e.id)
+	}
+	return json.Unmarshal(data, &e.object)
+}
+
+// MarshalJSON encodes the expanded PaymentIntent object if set, otherwise the
+// ID, or null if there is no PaymentIntent.
+func (e ExpandablePaymentIntent) MarshalJSON() ([]byte, error) {
+	if e.object != nil {
+		return json.Marshal(e.object)
+	}
+	return marshalID(e.id)
+}
@@ -0,0 +1,36 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestExpandableDecode will test that expandable fields accept either an ID
+// or the expanded object.
+func TestExpandableDecode(t *testing.T) {
+	body := `{"id":"in_1","charge":"ch_1","customer":{"id":"cus_1","email":"gopher@example.com"}}`
+	invoice := Invoice{}
+	if err := json.Unmarshal([]byte(body), &invoice); err != nil {
+		t.Fatalf("Expected Invoice, got Error %s", err.Error())
+	}
+	if invoice.Charge.ID() != "ch_1" || invoice.Charge.Object() != nil {
+		t.Errorf("Expected unexpanded Charge ch_1, got %+v", invoice.Charge)
+	}
+	if invoice.Customer.ID() != "cus_1" {
+		t.Errorf("Expected Customer ID cus_1, got %s", invoice.Customer.ID())
+	}
+	if cust := invoice.Customer.Object(); cust == nil || cust.Email != "gopher@example.com" {
+		t.Errorf("Expected expanded Customer, got %+v", cust)
+	}
+
+	// a null reference decodes to an empty ID and encodes back to null
+	if err := json.Unmarshal([]byte(`{"charge":null}`), &invoice); err != nil {
+		t.Fatalf("Expected Invoice, got Error %s", err.Error())
+	}
+	if invoice.Charge.ID() != "" {
+		t.Errorf("Expected no Charge, got %s", invoice.Charge.ID())
+	}
+	if data, _ := json.Marshal(invoice.Charge); string(data) != "null" {
+		t.Errorf("Expected null, got %s", data)
+	}
+}
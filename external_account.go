@@ -0,0 +1,195 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// External Account object types
+const (
+	ExternalAccountBank = "bank_account"
+	ExternalAccountCard = "card"
+)
+
+// BankAccount represents a bank account that payouts can be sent to.
+//
+// see https://stripe.com/docs/api#bank_account_object
+type BankAccount struct {
+	ID                 string            `json:"id"`
+	Account            string            `json:"account,omitempty"`
+	AccountHolderName  string            `json:"account_holder_name,omitempty"`
+	AccountHolderType  string            `json:"account_holder_type,omitempty"`
+	BankName           string            `json:"bank_name,omitempty"`
+	Country            string            `json:"country"`
+	Currency           Currency          `json:"currency"`
+	DefaultForCurrency bool              `json:"default_for_currency"`
+	Fingerprint        string            `json:"fingerprint"`
+	Last4              string            `json:"last4"`
+	RoutingNumber      string            `json:"routing_number,omitempty"`
+	Status             string            `json:"status"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+}
+
+// ExternalAccount is a payout destination of a connected account, which is
+// either a BankAccount or a debit Card depending on Object.
+type ExternalAccount struct {
+	APIResource
+
+	Object      string
+	BankAccount *BankAccount
+	Card        *Card
+}
+
+func (e *ExternalAccount) UnmarshalJSON(data []byte) error {
+	obj := struct {
+		Object string `json:"object"`
+	}{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	e.Object = obj.Object
+	switch obj.Object {
+	case ExternalAccountCard:
+		e.Card = &Card{}
+		return json.Unmarshal(data, e.Card)
+	default:
+		e.BankAccount = &BankAccount{}
+		return json.Unmarshal(data, e.BankAccount)
+	}
+}
+
+func (e ExternalAccount) MarshalJSON() ([]byte, error) {
+	if e.Card != nil {
+		return json.Marshal(e.Card)
+	}
+	return json.Marshal(e.BankAccount)
+}
+
+// ID returns the ID of the underlying bank account or card.
+func (e *ExternalAccount) ID() string {
+	switch {
+	case e.Card != nil:
+		return e.Card.ID
+	case e.BankAccount != nil:
+		return e.BankAccount.ID
+	}
+	return ""
+}
+
+// BankAccountParams encapsulates the details of a bank account to attach.
+type BankAccountParams struct {
+	// The type of the details, always ExternalAccountBank.
+	Object string `form:"object"`
+
+	// The country in which the bank account is located.
+	Country string `form:"country"`
+
+	// The currency paid out to the bank account.
+	Currency Currency `form:"currency"`
+
+	// The bank account number.
+	AccountNumber string `form:"account_number"`
+
+	// (Optional) The routing number, sort code, or other country-appropriate
+	// institution number.
+	RoutingNumber *string `form:"routing_number"`
+
+	// (Optional) The name of the person or business that owns the account.
+	AccountHolderName *string `form:"account_holder_name"`
+
+	// (Optional) The type of entity that holds the account: individual or
+	// company.
+	AccountHolderType *string `form:"account_holder_type"`
+}
+
+// ExternalAccountParams encapsulates options for creating and updating
+// External Accounts.
+type ExternalAccountParams struct {
+	Params
+
+	// (Optional) A bank account or debit card token. Either Token or
+	// BankAccount is required when creating.
+	Token *string `form:"external_account"`
+
+	// (Optional) Bank account details, if not using a Token.
+	BankAccount *BankAccountParams `form:"external_account"`
+
+	// (Optional) When updating, the new name of the account holder.
+	AccountHolderName *string `form:"account_holder_name"`
+
+	// (Optional) When set to true, this becomes the default external account
+	// for its currency.
+	DefaultForCurrency *bool `form:"default_for_currency"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// ExternalAccountClient encapsulates operations for managing the payout
+// destinations of a connected account using the Stripe REST API.
+type ExternalAccountClient struct{ scope }
+
+// With returns an ExternalAccountClient configured with the given options.
+func (c ExternalAccountClient) With(opts ...ClientOption) ExternalAccountClient {
+	c.apply(opts)
+	return c
+}
+
+func (c ExternalAccountClient) path(accountID, externalID string) string {
+	p := fmt.Sprintf("/accounts/%s/external_accounts", url.QueryEscape(accountID))
+	if externalID != "" {
+		p += "/" + url.QueryEscape(externalID)
+	}
+	return p
+}
+
+// Creates a new External Account on the given connected account.
+//
+// see https://stripe.com/docs/api#account_create_bank_account
+func (c ExternalAccountClient) Create(accountID string, params *ExternalAccountParams) (*ExternalAccount, error) {
+	res := &ExternalAccount{}
+	return res, c.query("POST", c.path(accountID, ""), formValues(params), res)
+}
+
+// Retrieves the External Account with the given ID.
+//
+// see https://stripe.com/docs/api#account_retrieve_bank_account
+func (c ExternalAccountClient) Get(accountID, externalID string) (*ExternalAccount, error) {
+	res := &ExternalAccount{}
+	return res, c.query("GET", c.path(accountID, externalID), nil, res)
+}
+
+// Updates the External Account with the given ID.
+//
+// see https://stripe.com/docs/api#account_update_bank_account
+func (c ExternalAccountClient) Update(accountID, externalID string, params *ExternalAccountParams) (*ExternalAccount, error) {
+	res := &ExternalAccount{}
+	return res, c.query("POST", c.path(accountID, externalID), formValues(params), res)
+}
+
+// Deletes the External Account with the given ID. The default external
+// account for a currency cannot be deleted.
+//
+// see https://stripe.com/docs/api#account_delete_bank_account
+func (c ExternalAccountClient) Delete(accountID, externalID string) (*Deleted, error) {
+	res := &Deleted{}
+	return res, c.query("DELETE", c.path(accountID, externalID), nil, res)
+}
+
+// Returns a list of the External Accounts of the connected account. The
+// object argument may be used to return only bank accounts or only cards, or
+// left empty to return both.
+//
+// see https://stripe.com/docs/api#account_list_bank_accounts
+func (c ExternalAccountClient) List(accountID, object string, limit int, before, after string) ([]*ExternalAccount, bool, error) {
+	res := struct {
+		ListObject
+		Data []*ExternalAccount
+	}{}
+	params := listParams(limit, before, after)
+	if object != "" {
+		params.Add("object", object)
+	}
+	err := c.query("GET", c.path(accountID, ""), params, &res)
+	return res.Data, res.More, err
+}
@@ -0,0 +1,205 @@
+package stripe
+
+import (
+	"io"
+	"net/url"
+)
+
+// File Purposes
+const (
+	FilePurposeAccountRequirement         = "account_requirement"
+	FilePurposeAdditionalVerification     = "additional_verification"
+	FilePurposeBusinessIcon               = "business_icon"
+	FilePurposeBusinessLogo               = "business_logo"
+	FilePurposeCustomerSignature          = "customer_signature"
+	FilePurposeDisputeEvidence            = "dispute_evidence"
+	FilePurposeIdentityDocument           = "identity_document"
+	FilePurposeIssuingRegulatoryReporting = "issuing_regulatory_reporting"
+	FilePurposePCIDocument                = "pci_document"
+	FilePurposeTaxDocumentUserUpload      = "tax_document_user_upload"
+)
+
+// File represents a file hosted on Stripe's servers, such as dispute evidence
+// you uploaded or a report generated by Stripe.
+//
+// see https://stripe.com/docs/api#file_object
+type File struct {
+	APIResource
+
+	ID        string    `json:"id"`
+	Created   UnixTime  `json:"created"`
+	ExpiresAt *UnixTime `json:"expires_at,omitempty"`
+	Filename  string    `json:"filename,omitempty"`
+	Purpose   string    `json:"purpose"`
+	Size      int       `json:"size"`
+	Title     string    `json:"title,omitempty"`
+	Type      string    `json:"type,omitempty"`
+	URL       string    `json:"url,omitempty"`
+}
+
+// Download copies the contents of the file to w. Files are downloaded from
+// Stripe using your secret key, so the file's URL should not be shared.
+func (f *File) Download(w io.Writer) error {
+	return scope{}.download(f.URL, w)
+}
+
+// FileListParams encapsulates options for filtering a list of Files.
+type FileListParams struct {
+	ListParams
+
+	// (Optional) Only return files with the given purpose.
+	Purpose string `form:"purpose"`
+
+	// (Optional) Only return files created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// FileClient encapsulates operations for uploading and querying files using
+// the Stripe REST API.
+type FileClient struct{ scope }
+
+// With returns a FileClient configured with the given options.
+func (c FileClient) With(opts ...ClientOption) FileClient {
+	c.apply(opts)
+	return c
+}
+
+// Uploads the contents of r to Stripe as a new File with the given purpose
+// and filename. Files are uploaded to files.stripe.com as multipart form data.
+//
+// see https://stripe.com/docs/api#create_file
+func (c FileClient) Create(purpose, filename string, r io.Reader) (*File, error) {
+	values := url.Values{"purpose": {purpose}}
+	res := &File{}
+	return res, c.upload("/files", values, filename, r, res)
+}
+
+// Retrieves the File with the given ID.
+//
+// see https://stripe.com/docs/api#retrieve_file
+func (c FileClient) Get(id string) (*File, error) {
+	res := &File{}
+	return res, c.query("GET", "/files/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of Files matching the params.
+//
+// see https://stripe.com/docs/api#list_files
+func (c FileClient) List(params *FileListParams) ([]*File, bool, error) {
+	res := struct {
+		ListObject
+		Data []*File
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/files", values, &res)
+	return res.Data, res.More, err
+}
+
+// Download copies the contents of the File to w, on behalf of the client's
+// connected account if one is set.
+func (c FileClient) Download(f *File, w io.Writer) error {
+	return c.download(f.URL, w)
+}
+
+// FileLink represents a public URL that can be used to share a File without
+// your secret key.
+//
+// see https://stripe.com/docs/api#file_link_object
+type FileLink struct {
+	APIResource
+
+	ID        string            `json:"id"`
+	Created   UnixTime          `json:"created"`
+	Expired   bool              `json:"expired"`
+	ExpiresAt *UnixTime         `json:"expires_at,omitempty"`
+	File      string            `json:"file"`
+	URL       string            `json:"url"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	Livemode  bool              `json:"livemode"`
+}
+
+// FileLinkParams encapsulates options for creating and updating File Links.
+type FileLinkParams struct {
+	Params
+
+	// The ID of the File to link to. Can only be set on creation.
+	File string `form:"file"`
+
+	// (Optional) The time the link expires. Links never expire by default.
+	ExpiresAt *UnixTime `form:"expires_at"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// FileLinkListParams encapsulates options for filtering a list of File Links.
+type FileLinkListParams struct {
+	ListParams
+
+	// (Optional) Only return links to the File with the given ID.
+	File string `form:"file"`
+
+	// (Optional) Only return links that have (true) or have not (false)
+	// expired.
+	Expired *bool `form:"expired"`
+
+	// (Optional) Only return links created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// FileLinkClient encapsulates operations for creating, updating and querying
+// file links using the Stripe REST API.
+type FileLinkClient struct{ scope }
+
+// With returns a FileLinkClient configured with the given options.
+func (c FileLinkClient) With(opts ...ClientOption) FileLinkClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new File Link.
+//
+// see https://stripe.com/docs/api#create_file_link
+func (c FileLinkClient) Create(params *FileLinkParams) (*FileLink, error) {
+	res := &FileLink{}
+	return res, c.query("POST", "/file_links", formValues(params), res)
+}
+
+// Retrieves the File Link with the given ID.
+//
+// see https://stripe.com/docs/api#retrieve_file_link
+func (c FileLinkClient) Get(id string) (*FileLink, error) {
+	res := &FileLink{}
+	return res, c.query("GET", "/file_links/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the expiry or metadata of the File Link with the given ID.
+//
+// see https://stripe.com/docs/api#update_file_link
+func (c FileLinkClient) Update(id string, params *FileLinkParams) (*FileLink, error) {
+	res := &FileLink{}
+	return res, c.query("POST", "/file_links/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Expires the File Link with the given ID immediately.
+//
+// see https://stripe.com/docs/api#update_file_link
+func (c FileLinkClient) Expire(id string) (*FileLink, error) {
+	values := url.Values{"expires_at": {"now"}}
+	res := &FileLink{}
+	return res, c.query("POST", "/file_links/"+url.QueryEscape(id), values, res)
+}
+
+// Returns a list of File Links matching the params.
+//
+// see https://stripe.com/docs/api#list_file_links
+func (c FileLinkClient) List(params *FileLinkListParams) ([]*FileLink, bool, error) {
+	res := struct {
+		ListObject
+		Data []*FileLink
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/file_links", values, &res)
+	return res.Data, res.More, err
+}
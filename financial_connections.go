@@ -0,0 +1,245 @@
+package stripe
+
+import "net/url"
+
+// Financial Connections Permissions
+const (
+	PermissionBalances      = "balances"
+	PermissionOwnership     = "ownership"
+	PermissionPaymentMethod = "payment_method"
+	PermissionTransactions  = "transactions"
+)
+
+// AccountHolder identifies the Customer or connected Account that owns a
+// Financial Connections Account.
+type AccountHolder struct {
+	Type     string `json:"type" form:"type"`
+	Account  string `json:"account,omitempty" form:"account"`
+	Customer string `json:"customer,omitempty" form:"customer"`
+}
+
+// FinancialConnectionsSession represents a single flow in which a user links
+// one or more of their bank accounts through Financial Connections.
+//
+// see https://stripe.com/docs/api/financial_connections/sessions/object
+type FinancialConnectionsSession struct {
+	APIResource
+
+	ID            string                           `json:"id"`
+	AccountHolder *AccountHolder                   `json:"account_holder"`
+	Accounts      *FinancialConnectionsAccountList `json:"accounts"`
+	ClientSecret  string                           `json:"client_secret"`
+	Permissions   []string                         `json:"permissions"`
+	Prefetch      []string                         `json:"prefetch,omitempty"`
+	ReturnURL     string                           `json:"return_url,omitempty"`
+	Livemode      bool                             `json:"livemode"`
+}
+
+// FinancialConnectionsAccount represents a bank account linked through
+// Financial Connections, including any balance and ownership data that has
+// been retrieved for it.
+//
+// see https://stripe.com/docs/api/financial_connections/accounts/object
+type FinancialConnectionsAccount struct {
+	APIResource
+
+	ID                          string                `json:"id"`
+	AccountHolder               *AccountHolder        `json:"account_holder"`
+	Balance                     *LinkedAccountBalance `json:"balance,omitempty"`
+	BalanceRefresh              *AccountRefresh       `json:"balance_refresh,omitempty"`
+	Category                    string                `json:"category"`
+	Created                     UnixTime              `json:"created"`
+	DisplayName                 string                `json:"display_name,omitempty"`
+	InstitutionName             string                `json:"institution_name"`
+	Last4                       string                `json:"last4,omitempty"`
+	Ownership                   string                `json:"ownership,omitempty"`
+	OwnershipRefresh            *AccountRefresh       `json:"ownership_refresh,omitempty"`
+	Permissions                 []string              `json:"permissions,omitempty"`
+	Status                      string                `json:"status"`
+	Subcategory                 string                `json:"subcategory"`
+	SupportedPaymentMethodTypes []string              `json:"supported_payment_method_types"`
+	TransactionRefresh          *AccountRefresh       `json:"transaction_refresh,omitempty"`
+	Livemode                    bool                  `json:"livemode"`
+}
+
+// FinancialConnectionsAccountList represents a list of Financial Connections
+// Accounts.
+type FinancialConnectionsAccountList struct {
+	ListObject
+	Data []*FinancialConnectionsAccount `json:"data"`
+}
+
+// LinkedAccountBalance holds the most recently retrieved balances of a linked
+// bank account, keyed by currency.
+type LinkedAccountBalance struct {
+	AsOf    UnixTime           `json:"as_of"`
+	Current map[Currency]int64 `json:"current"`
+	Type    string             `json:"type"`
+	Cash    *struct {
+		Available map[Currency]int64 `json:"available"`
+	} `json:"cash,omitempty"`
+	Credit *struct {
+		Used map[Currency]int64 `json:"used"`
+	} `json:"credit,omitempty"`
+}
+
+// AccountRefresh describes the most recent attempt to refresh data for a
+// linked bank account.
+type AccountRefresh struct {
+	LastAttemptedAt UnixTime `json:"last_attempted_at"`
+	Status          string   `json:"status"`
+}
+
+// FinancialConnectionsTransaction represents a transaction on a linked bank
+// account.
+//
+// see https://stripe.com/docs/api/financial_connections/transactions/object
+type FinancialConnectionsTransaction struct {
+	APIResource
+
+	ID                 string   `json:"id"`
+	Account            string   `json:"account"`
+	Amount             int64    `json:"amount"`
+	Currency           Currency `json:"currency"`
+	Description        string   `json:"description"`
+	Status             string   `json:"status"`
+	TransactedAt       UnixTime `json:"transacted_at"`
+	TransactionRefresh string   `json:"transaction_refresh"`
+	Updated            UnixTime `json:"updated"`
+	Livemode           bool     `json:"livemode"`
+}
+
+// FinancialConnectionsSessionParams encapsulates options for creating a new
+// Financial Connections Session.
+type FinancialConnectionsSessionParams struct {
+	Params
+
+	// The Customer or connected Account that will own the linked accounts.
+	AccountHolder *AccountHolder `form:"account_holder"`
+
+	// The data the user is asked to grant access to, e.g. balances, ownership,
+	// payment_method or transactions.
+	Permissions []string `form:"permissions"`
+
+	// (Optional) Data to retrieve as soon as accounts are linked.
+	Prefetch []string `form:"prefetch"`
+
+	// (Optional) Only allow linking accounts at institutions in the given
+	// countries.
+	Countries []string `form:"filters[countries]"`
+
+	// (Optional) The URL to redirect to after the flow, for non-modal
+	// integrations.
+	ReturnURL *string `form:"return_url"`
+}
+
+// FinancialConnectionsAccountListParams encapsulates options for filtering a
+// list of Financial Connections Accounts. One filter is required.
+type FinancialConnectionsAccountListParams struct {
+	ListParams
+
+	// (Optional) Only return accounts owned by the given account holder.
+	AccountHolder *AccountHolder `form:"account_holder"`
+
+	// (Optional) Only return accounts linked in the Session with the given ID.
+	Session string `form:"session"`
+}
+
+// FinancialConnectionsSessionClient encapsulates operations for creating
+// Financial Connections sessions using the Stripe REST API.
+type FinancialConnectionsSessionClient struct{ scope }
+
+// With returns a FinancialConnectionsSessionClient configured with the given options.
+func (c FinancialConnectionsSessionClient) With(opts ...ClientOption) FinancialConnectionsSessionClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Financial Connections Session, whose client secret is passed
+// to Stripe.js to launch the flow.
+//
+// see https://stripe.com/docs/api/financial_connections/sessions/create
+func (c FinancialConnectionsSessionClient) Create(params *FinancialConnectionsSessionParams) (*FinancialConnectionsSession, error) {
+	res := &FinancialConnectionsSession{}
+	return res, c.query("POST", "/financial_connections/sessions", formValues(params), res)
+}
+
+// Retrieves the Financial Connections Session with the given ID.
+//
+// see https://stripe.com/docs/api/financial_connections/sessions/retrieve
+func (c FinancialConnectionsSessionClient) Get(id string) (*FinancialConnectionsSession, error) {
+	res := &FinancialConnectionsSession{}
+	return res, c.query("GET", "/financial_connections/sessions/"+url.QueryEscape(id), nil, res)
+}
+
+// FinancialConnectionsAccountClient encapsulates operations for querying and
+// refreshing linked bank accounts using the Stripe REST API.
+type FinancialConnectionsAccountClient struct{ scope }
+
+// With returns a FinancialConnectionsAccountClient configured with the given options.
+func (c FinancialConnectionsAccountClient) With(opts ...ClientOption) FinancialConnectionsAccountClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Financial Connections Account with the given ID.
+//
+// see https://stripe.com/docs/api/financial_connections/accounts/retrieve
+func (c FinancialConnectionsAccountClient) Get(id string) (*FinancialConnectionsAccount, error) {
+	res := &FinancialConnectionsAccount{}
+	return res, c.query("GET", "/financial_connections/accounts/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of Financial Connections Accounts matching the params.
+//
+// see https://stripe.com/docs/api/financial_connections/accounts/list
+func (c FinancialConnectionsAccountClient) List(params *FinancialConnectionsAccountListParams) ([]*FinancialConnectionsAccount, bool, error) {
+	res := struct {
+		ListObject
+		Data []*FinancialConnectionsAccount
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/financial_connections/accounts", values, &res)
+	return res.Data, res.More, err
+}
+
+// Refreshes the given features (balance, ownership or transactions) of the
+// Financial Connections Account with the given ID. Refreshes happen
+// asynchronously; check the account's refresh status for the result.
+//
+// see https://stripe.com/docs/api/financial_connections/accounts/refresh
+func (c FinancialConnectionsAccountClient) Refresh(id string, features ...string) (*FinancialConnectionsAccount, error) {
+	values := make(url.Values)
+	for _, f := range features {
+		values.Add("features[]", f)
+	}
+	res := &FinancialConnectionsAccount{}
+	return res, c.query("POST", "/financial_connections/accounts/"+url.QueryEscape(id)+"/refresh", values, res)
+}
+
+// Disconnects the Financial Connections Account with the given ID, so no
+// further data can be retrieved for it.
+//
+// see https://stripe.com/docs/api/financial_connections/accounts/disconnect
+func (c FinancialConnectionsAccountClient) Disconnect(id string) (*FinancialConnectionsAccount, error) {
+	res := &FinancialConnectionsAccount{}
+	return res, c.query("POST", "/financial_connections/accounts/"+url.QueryEscape(id)+"/disconnect", nil, res)
+}
+
+// Returns a list of the transactions of the Financial Connections Account
+// with the given ID, which must have been refreshed with the transactions
+// feature.
+//
+// see https://stripe.com/docs/api/financial_connections/transactions/list
+func (c FinancialConnectionsAccountClient) Transactions(id string, limit int, before, after string) ([]*FinancialConnectionsTransaction, bool, error) {
+	res := struct {
+		ListObject
+		Data []*FinancialConnectionsTransaction
+	}{}
+	values := listParams(limit, before, after)
+	values.Add("account", id)
+
+	err := c.query("GET", "/financial_connections/transactions", values, &res)
+	return res.Data, res.More, err
+}
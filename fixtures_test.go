@@ -0,0 +1,183 @@
+package stripe
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fixtures maps each file in testdata, without its extension, to the type of
+// the resource it holds.
+var fixtures = map[string]func() interface{}{
+	"account":                           func() interface{} { return &Account{} },
+	"account_link":                      func() interface{} { return &AccountLink{} },
+	"account_session":                   func() interface{} { return &AccountSession{} },
+	"account_tax_settings":              func() interface{} { return &AccountTaxSettings{} },
+	"apple_pay_domain":                  func() interface{} { return &ApplePayDomain{} },
+	"application_fee":                   func() interface{} { return &ApplicationFee{} },
+	"balance":                           func() interface{} { return &Balance{} },
+	"balance_transaction":               func() interface{} { return &BalanceTransaction{} },
+	"capability":                        func() interface{} { return &Capability{} },
+	"card":                              func() interface{} { return &Card{} },
+	"charge":                            func() interface{} { return &Charge{} },
+	"checkout_session":                  func() interface{} { return &CheckoutSession{} },
+	"climate_order":                     func() interface{} { return &ClimateOrder{} },
+	"climate_product":                   func() interface{} { return &ClimateProduct{} },
+	"climate_supplier":                  func() interface{} { return &ClimateSupplier{} },
+	"connection_token":                  func() interface{} { return &ConnectionToken{} },
+	"country_spec":                      func() interface{} { return &CountrySpec{} },
+	"coupon":                            func() interface{} { return &Coupon{} },
+	"customer":                          func() interface{} { return &Customer{} },
+	"customer_session":                  func() interface{} { return &CustomerSession{} },
+	"dispute":                           func() interface{} { return &Dispute{} },
+	"early_fraud_warning":               func() interface{} { return &EarlyFraudWarning{} },
+	"ephemeral_key":                     func() interface{} { return &EphemeralKey{} },
+	"event":                             func() interface{} { return &Event{} },
+	"exchange_rate":                     func() interface{} { return &ExchangeRate{} },
+	"external_account":                  func() interface{} { return &ExternalAccount{} },
+	"fee_refund":                        func() interface{} { return &FeeRefund{} },
+	"file":                              func() interface{} { return &File{} },
+	"file_link":                         func() interface{} { return &FileLink{} },
+	"financial_account":                 func() interface{} { return &FinancialAccount{} },
+	"financial_connections_account":     func() interface{} { return &FinancialConnectionsAccount{} },
+	"financial_connections_session":     func() interface{} { return &FinancialConnectionsSession{} },
+	"financial_connections_transaction": func() interface{} { return &FinancialConnectionsTransaction{} },
+	"fx_quote":                          func() interface{} { return &FXQuote{} },
+	"inbound_transfer":                  func() interface{} { return &InboundTransfer{} },
+	"invoice":                           func() interface{} { return &Invoice{} },
+	"invoice_item":                      func() interface{} { return &InvoiceItem{} },
+	"invoice_line_item":                 func() interface{} { return &InvoiceLineItem{} },
+	"issuing_authorization":             func() interface{} { return &IssuingAuthorization{} },
+	"issuing_card":                      func() interface{} { return &IssuingCard{} },
+	"issuing_cardholder":                func() interface{} { return &IssuingCardholder{} },
+	"issuing_dispute":                   func() interface{} { return &IssuingDispute{} },
+	"issuing_transaction":               func() interface{} { return &IssuingTransaction{} },
+	"mandate":                           func() interface{} { return &Mandate{} },
+	"meter":                             func() interface{} { return &Meter{} },
+	"meter_event":                       func() interface{} { return &MeterEvent{} },
+	"meter_event_session":               func() interface{} { return &MeterEventSession{} },
+	"meter_event_summary":               func() interface{} { return &MeterEventSummary{} },
+	"o_auth_token":                      func() interface{} { return &OAuthToken{} },
+	"outbound_payment":                  func() interface{} { return &OutboundPayment{} },
+	"outbound_transfer":                 func() interface{} { return &OutboundTransfer{} },
+	"payment_intent":                    func() interface{} { return &PaymentIntent{} },
+	"payment_method":                    func() interface{} { return &PaymentMethod{} },
+	"payment_method_configuration":      func() interface{} { return &PaymentMethodConfiguration{} },
+	"payment_method_domain":             func() interface{} { return &PaymentMethodDomain{} },
+	"payout":                            func() interface{} { return &Payout{} },
+	"person":                            func() interface{} { return &Person{} },
+	"plan":                              func() interface{} { return &Plan{} },
+	"quote":                             func() interface{} { return &Quote{} },
+	"quote_line_item":                   func() interface{} { return &QuoteLineItem{} },
+	"radar_session":                     func() interface{} { return &RadarSession{} },
+	"received_credit":                   func() interface{} { return &ReceivedCredit{} },
+	"received_debit":                    func() interface{} { return &ReceivedDebit{} },
+	"report_run":                        func() interface{} { return &ReportRun{} },
+	"report_type":                       func() interface{} { return &ReportType{} },
+	"reversal":                          func() interface{} { return &Reversal{} },
+	"review":                            func() interface{} { return &Review{} },
+	"scheduled_query_run":               func() interface{} { return &ScheduledQueryRun{} },
+	"subscription":                      func() interface{} { return &Subscription{} },
+	"tax_calculation":                   func() interface{} { return &TaxCalculation{} },
+	"tax_line_item":                     func() interface{} { return &TaxLineItem{} },
+	"tax_registration":                  func() interface{} { return &TaxRegistration{} },
+	"tax_transaction":                   func() interface{} { return &TaxTransaction{} },
+	"terminal_location":                 func() interface{} { return &TerminalLocation{} },
+	"terminal_reader":                   func() interface{} { return &TerminalReader{} },
+	"test_clock":                        func() interface{} { return &TestClock{} },
+	"token":                             func() interface{} { return &Token{} },
+	"topup":                             func() interface{} { return &Topup{} },
+	"transfer":                          func() interface{} { return &Transfer{} },
+	"treasury_transaction":              func() interface{} { return &TreasuryTransaction{} },
+	"usage_record":                      func() interface{} { return &UsageRecord{} },
+	"value_list":                        func() interface{} { return &ValueList{} },
+	"value_list_item":                   func() interface{} { return &ValueListItem{} },
+	"verification_report":               func() interface{} { return &VerificationReport{} },
+	"verification_session":              func() interface{} { return &VerificationSession{} },
+}
+
+// TestFixtures decodes the API response for every resource in testdata and
+// encodes it again, ensuring each field of the response survives the round
+// trip, so missing or misspelled json tags are caught.
+func TestFixtures(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".json")
+		if _, ok := fixtures[name]; !ok {
+			t.Errorf("Expected a resource type for fixture %s", file)
+		}
+	}
+
+	for name, newResource := range fixtures {
+		data, err := ioutil.ReadFile(filepath.Join("testdata", name+".json"))
+		if err != nil {
+			t.Errorf("Expected fixture for %s, got Error %s", name, err.Error())
+			continue
+		}
+		v := newResource()
+		if err := json.Unmarshal(data, v); err != nil {
+			t.Errorf("Expected %s to decode, got Error %s", name, err.Error())
+			continue
+		}
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			t.Errorf("Expected %s to encode, got Error %s", name, err.Error())
+			continue
+		}
+
+		var want, got interface{}
+		json.Unmarshal(data, &want)
+		json.Unmarshal(encoded, &got)
+		for _, diff := range roundTripDiff(name, want, got) {
+			t.Errorf("Expected %s", diff)
+		}
+	}
+}
+
+// roundTripDiff describes each value in want, found at path, that is missing
+// from or different in got. Values in got that are not in want are ignored,
+// since fields without omitempty are always encoded.
+func roundTripDiff(path string, want, got interface{}) []string {
+	switch want := want.(type) {
+	case map[string]interface{}:
+		obj, ok := got.(map[string]interface{})
+		if !ok {
+			return []string{path + " to be an object, got " + encodeJSON(got)}
+		}
+		var diffs []string
+		for k, v := range want {
+			field, ok := obj[k]
+			if !ok {
+				diffs = append(diffs, path+"."+k+" to survive the round trip")
+				continue
+			}
+			diffs = append(diffs, roundTripDiff(path+"."+k, v, field)...)
+		}
+		return diffs
+	case []interface{}:
+		arr, ok := got.([]interface{})
+		if !ok || len(arr) != len(want) {
+			return []string{path + " to be " + encodeJSON(want) + ", got " + encodeJSON(got)}
+		}
+		var diffs []string
+		for i := range want {
+			diffs = append(diffs, roundTripDiff(path+"[]", want[i], arr[i])...)
+		}
+		return diffs
+	}
+	if !reflect.DeepEqual(want, got) {
+		return []string{path + " to be " + encodeJSON(want) + ", got " + encodeJSON(got)}
+	}
+	return nil
+}
+
+func encodeJSON(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
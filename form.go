@@ -0,0 +1,208 @@
+package stripe
+
+import (
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	unixTimeType = reflect.TypeOf(UnixTime{})
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// formValues encodes the struct pointed to by params as the url.Values sent
+// to Stripe, driven by `form` struct tags:
+//
+//	Email    string            `form:"email"`
+//	Address  *Address          `form:"address"`         // address[line1]=...
+//	Metadata map[string]string `form:"metadata"`        // metadata[key]=...
+//	Expand   []string          `form:"expand"`          // expand[]=...
+//	Items    []ItemParams      `form:"items"`           // items[0][price]=...
+//	Tiers    []int             `form:"tiers,indexed"`   // tiers[0]=...
+//	Front    string            `form:"document[front]"` // document[front]=...
+//	Methods  map[string]*Pref  `form:",inline"`         // card[...]=...
+//	Internal string            `form:"-"`               // never sent
+//
+// Zero values (empty strings, 0, false, nil, empty slices and maps) are
+// omitted, so optional parameters need no special handling. Non-nil pointers
+// are always sent, which is how an explicit false or 0 is expressed. Map
+// entries are always sent, so metadata keys can be unset with an empty value.
+// Timestamps (UnixTime and time.Time) are sent as Unix seconds. A tag can be
+// a bracketed path, for parameters Stripe nests more deeply than the struct.
+// The entries of an inline map are parameters of their own, for those Stripe
+// names after an open-ended set, such as payment method types. Embedded
+// structs without a tag, such as ListParams, are flattened into
+// their parent. Fields without a form tag are not sent. Extra parameters
+// added to an embedded Params with AddExtra are added last.
+func formValues(params interface{}) url.Values {
+	values := make(url.Values)
+	appendForm(values, params)
+	return values
+}
+
+// appendForm adds the fields of the struct pointed to by params to values.
+func appendForm(values url.Values, params interface{}) {
+	v := reflect.ValueOf(params)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		encodeStruct(values, "", v)
+	}
+	if p, ok := params.(interface{ base() *Params }); ok {
+		p.base().appendExtra(values)
+	}
+}
+
+func encodeStruct(values url.Values, prefix string, v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, ok := field.Tag.Lookup("form")
+		if tag == "-" {
+			continue
+		}
+		if !ok {
+			// flatten untagged embedded structs, such as ListParams
+			if field.Anonymous {
+				fv := v.Field(i)
+				if fv.Kind() == reflect.Ptr {
+					if fv.IsNil() {
+						continue
+					}
+					fv = fv.Elem()
+				}
+				if fv.Kind() == reflect.Struct {
+					encodeStruct(values, prefix, fv)
+				}
+			}
+			continue
+		}
+		if field.PkgPath != "" {
+			continue // unexported
+		}
+
+		name, opts := tag, ""
+		if i := strings.Index(tag, ","); i >= 0 {
+			name, opts = tag[:i], tag[i+1:]
+		}
+		if opts == "inline" {
+			encodeInline(values, prefix, v.Field(i))
+			continue
+		}
+		key := name
+		if prefix != "" {
+			key = nestKey(prefix, name)
+		}
+		encodeValue(values, key, v.Field(i), opts == "indexed", false)
+	}
+}
+
+// encodeInline adds the entries of the map v to values as parameters of
+// their own, nested under prefix if it is not empty.
+func encodeInline(values url.Values, prefix string, v reflect.Value) {
+	if v.Kind() != reflect.Map {
+		return
+	}
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+	for _, k := range keys {
+		key := k.String()
+		if prefix != "" {
+			key = nestKey(prefix, key)
+		}
+		encodeValue(values, key, v.MapIndex(k), false, true)
+	}
+}
+
+// nestKey returns the key of the parameter name nested under prefix. A name
+// that is itself a path, e.g. "online[ip_address]", has its first element
+// nested: "customer_acceptance[online][ip_address]".
+func nestKey(prefix, name string) string {
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		return prefix + "[" + name[:i] + "]" + name[i:]
+	}
+	return prefix + "[" + name + "]"
+}
+
+// encodeValue adds v to values under key. Zero values are skipped unless
+// explicit is set, which is the case for values reached through a non-nil
+// pointer or a map entry.
+func encodeValue(values url.Values, key string, v reflect.Value, indexed, explicit bool) {
+	switch v.Type() {
+	case unixTimeType:
+		if t := v.Interface().(UnixTime); !t.IsZero() || explicit {
+			values.Add(key, strconv.FormatInt(t.Unix(), 10))
+		}
+		return
+	case timeType:
+		if t := v.Interface().(time.Time); !t.IsZero() || explicit {
+			values.Add(key, strconv.FormatInt(t.Unix(), 10))
+		}
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			encodeValue(values, key, v.Elem(), indexed, true)
+		}
+	case reflect.Struct:
+		encodeStruct(values, key, v)
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, k := range keys {
+			encodeValue(values, key+"["+k.String()+"]", v.MapIndex(k), false, true)
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			if v.Len() > 0 || explicit {
+				values.Add(key, string(v.Bytes()))
+			}
+			return
+		}
+		elem := v.Type().Elem()
+		for elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+		// objects can only be sent in a list with an explicit index
+		if elem.Kind() == reflect.Struct && elem != unixTimeType && elem != timeType {
+			indexed = true
+		}
+		for i := 0; i < v.Len(); i++ {
+			k := key + "[]"
+			if indexed {
+				k = key + "[" + strconv.Itoa(i) + "]"
+			}
+			encodeValue(values, k, v.Index(i), false, true)
+		}
+	case reflect.String:
+		if s := v.String(); s != "" || explicit {
+			values.Add(key, s)
+		}
+	case reflect.Bool:
+		if b := v.Bool(); b || explicit {
+			values.Add(key, strconv.FormatBool(b))
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n := v.Int(); n != 0 || explicit {
+			values.Add(key, strconv.FormatInt(n, 10))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n := v.Uint(); n != 0 || explicit {
+			values.Add(key, strconv.FormatUint(n, 10))
+		}
+	case reflect.Float32, reflect.Float64:
+		if f := v.Float(); f != 0 || explicit {
+			values.Add(key, strconv.FormatFloat(f, 'f', -1, 64))
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package stripe
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type formAddress struct {
+	Line1   string `form:"line1"`
+	Country string `form:"country"`
+}
+
+type formItem struct {
+	Price    string `form:"price"`
+	Quantity int    `form:"quantity"`
+	Tax      string `form:"tax_rates[rate]"`
+}
+
+type formParams struct {
+	ListParams
+
+	Email    string                  `form:"email"`
+	Address  *formAddress            `form:"address"`
+	Metadata map[string]string       `form:"metadata"`
+	Expand   []string                `form:"expand"`
+	Items    []formItem              `form:"items"`
+	Front    string                  `form:"document[front]"`
+	Tiers    []int                   `form:"tiers,indexed"`
+	Capture  *bool                   `form:"capture"`
+	Amount   int                     `form:"amount"`
+	Rate     float64                 `form:"rate"`
+	Created  *DateRange              `form:"created"`
+	Methods  map[string]*formAddress `form:",inline"`
+	Internal string                  `form:"-"`
+	Untagged string
+}
+
+// TestFormValues will test that params are encoded using their form tags.
+func TestFormValues(t *testing.T) {
+	capture := false
+	since := UnixTime{time.Unix(1700000000, 0)}
+	params := formParams{
+		ListParams: ListParams{Limit: 10, After: "obj_1"},
+		Email:      "gopher@example.com",
+		Address:    &formAddress{Line1: "1 Main St"},
+		Metadata:   map[string]string{"b": "2", "a": ""},
+		Expand:     []string{"customer", "invoice"},
+		Items:      []formItem{{Price: "price_1", Quantity: 2}, {Price: "price_2", Tax: "txr_1"}},
+		Front:      "file_1",
+		Tiers:      []int{5, 10},
+		Capture:    &capture,
+		Rate:       1.25,
+		Created:    &DateRange{GTE: &since},
+		Methods:    map[string]*formAddress{"card": {Country: "US"}},
+		Internal:   "secret",
+		Untagged:   "secret",
+	}
+	params.AddExtra("beta_feature", "on")
+	params.AddExtra("email", "override@example.com")
+
+	want := url.Values{
+		"limit":                     {"10"},
+		"starting_after":            {"obj_1"},
+		"email":                     {"override@example.com"},
+		"beta_feature":              {"on"},
+		"address[line1]":            {"1 Main St"},
+		"metadata[a]":               {""},
+		"metadata[b]":               {"2"},
+		"expand[]":                  {"customer", "invoice"},
+		"items[0][price]":           {"price_1"},
+		"items[0][quantity]":        {"2"},
+		"items[1][price]":           {"price_2"},
+		"items[1][tax_rates][rate]": {"txr_1"},
+		"document[front]":           {"file_1"},
+		"tiers[0]":                  {"5"},
+		"tiers[1]":                  {"10"},
+		"capture":                   {"false"},
+		"rate":                      {"1.25"},
+		"created[gte]":              {"1700000000"},
+		"card[country]":             {"US"},
+	}
+	if got := formValues(&params); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+// TestFormValuesEmpty will test that zero and nil params encode to nothing.
+func TestFormValuesEmpty(t *testing.T) {
+	if got := formValues(&formParams{}); len(got) != 0 {
+		t.Errorf("Expected no values, got %v", got)
+	}
+	if got := formValues((*formParams)(nil)); len(got) != 0 {
+		t.Errorf("Expected no values, got %v", got)
+	}
+}
@@ -0,0 +1,8 @@
+package stripe
+
+// Resources without hand-written types are generated from Stripe's OpenAPI
+// spec by cmd/stripegen into files ending in _gen.go. To add a resource,
+// append its ID from the spec; to update the generated files after an API
+// change, run go generate.
+//
+//go:generate go run ./cmd/stripegen -o tax_rate_gen.go tax_rate
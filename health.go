@@ -0,0 +1,61 @@
+package stripe
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// Health classifies the result of Ping.
+type Health string
+
+// Health Values
+const (
+	// Stripe was reached and accepted the API key.
+	HealthOK Health = "ok"
+
+	// Stripe was reached but rejected the API key as invalid, expired or
+	// revoked.
+	HealthBadKey Health = "bad_key"
+
+	// Stripe could not be reached, or had an error of its own.
+	HealthUnreachable Health = "unreachable"
+
+	// The request failed for another reason, given by the error.
+	HealthFailed Health = "failed"
+)
+
+// Ping makes a minimal authenticated request to the Stripe API with the key
+// set with SetKey, as Client.Ping does for a Client.
+func Ping(ctx context.Context) (Health, error) {
+	return (&Client{}).Ping(ctx)
+}
+
+// Ping makes a minimal authenticated request to the Stripe API with the
+// Client's key and configuration, retrieving the balance, and classifies the
+// result, e.g. for the readiness probe of a billing service. A restricted key
+// without access to the balance, or being rate limited, still counts as
+// healthy, since Stripe accepted the key. The error the request failed with,
+// if any, is returned alongside.
+func (c *Client) Ping(ctx context.Context) (Health, error) {
+	s := c.scope
+	s.ctx = ctx
+	var res json.RawMessage
+	err := s.query("GET", "/balance", nil, &res)
+	switch e := err.(type) {
+	case nil:
+		return HealthOK, nil
+	case *Error:
+		switch {
+		case e.Code == 401:
+			return HealthBadKey, err
+		case e.Code == 403 || e.Code == 429:
+			return HealthOK, err
+		case e.Code >= 500:
+			return HealthUnreachable, err
+		}
+	case *url.Error:
+		return HealthUnreachable, err
+	}
+	return HealthFailed, err
+}
@@ -0,0 +1,62 @@
+package stripe
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestPing will test that the response to the balance request is classified
+// by whether Stripe was reached and accepted the key.
+func TestPing(t *testing.T) {
+	tests := map[int]Health{
+		200: HealthOK,
+		401: HealthBadKey,
+		403: HealthOK,
+		429: HealthOK,
+		503: HealthUnreachable,
+		400: HealthFailed,
+	}
+	defer SetUrl(_url)
+	for status, want := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/balance" {
+				t.Errorf("Expected GET /v1/balance, got %s %s", r.Method, r.URL.Path)
+			}
+			w.WriteHeader(status)
+			w.Write([]byte(`{"object":"balance","error":{"message":"status"}}`))
+		}))
+		SetUrl(server.URL)
+		if got, _ := Ping(context.Background()); got != want {
+			t.Errorf("Expected %s for status %d, got %s", want, status, got)
+		}
+		server.Close()
+	}
+
+	// the last server is closed, so Stripe is unreachable
+	if got, err := Ping(context.Background()); got != HealthUnreachable || err == nil {
+		t.Errorf("Expected unreachable, got %s and %v", got, err)
+	}
+}
+
+// TestClientPing will test that a Client pings Stripe with its own key and
+// configuration.
+func TestClientPing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer sk_test_client" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":{"message":"Invalid API Key provided"}}`))
+			return
+		}
+		w.Write([]byte(`{"object":"balance"}`))
+	}))
+	defer server.Close()
+
+	if got, err := NewClient("sk_test_client", WithBaseURL(server.URL)).Ping(context.Background()); got != HealthOK {
+		t.Errorf("Expected the Client's key to be accepted, got %s and %v", got, err)
+	}
+	if got, _ := NewClient("sk_test_revoked", WithBaseURL(server.URL)).Ping(context.Background()); got != HealthBadKey {
+		t.Errorf("Expected a bad key, got %s", got)
+	}
+}
@@ -0,0 +1,257 @@
+package stripe
+
+import "net/url"
+
+// Verification Session Statuses
+const (
+	VerificationRequiresInput = "requires_input"
+	VerificationProcessing    = "processing"
+	VerificationVerified      = "verified"
+	VerificationCanceled      = "canceled"
+)
+
+// Verification Types
+const (
+	VerificationDocumentType = "document"
+	VerificationIDNumberType = "id_number"
+)
+
+// VerificationSession represents an attempt to verify a user's identity with
+// Stripe Identity, from creation until the checks have completed.
+//
+// see https://stripe.com/docs/api/identity/verification_sessions/object
+type VerificationSession struct {
+	APIResource
+
+	ID                     string               `json:"id"`
+	ClientSecret           string               `json:"client_secret,omitempty"`
+	Created                UnixTime             `json:"created"`
+	LastError              *VerificationError   `json:"last_error,omitempty"`
+	LastVerificationReport string               `json:"last_verification_report,omitempty"`
+	Options                *VerificationOptions `json:"options,omitempty"`
+	Status                 string               `json:"status"`
+	Type                   string               `json:"type"`
+	URL                    string               `json:"url,omitempty"`
+	VerifiedOutputs        *VerifiedOutputs     `json:"verified_outputs,omitempty"`
+	Metadata               map[string]string    `json:"metadata,omitempty"`
+	Livemode               bool                 `json:"livemode"`
+}
+
+// VerificationError explains why a verification check could not be
+// completed.
+type VerificationError struct {
+	Code   string `json:"code"`
+	Reason string `json:"reason"`
+}
+
+// VerificationOptions configures the checks performed by a
+// VerificationSession.
+type VerificationOptions struct {
+	Document *DocumentOptions `json:"document,omitempty" form:"document"`
+}
+
+// DocumentOptions configures the identity document check.
+type DocumentOptions struct {
+	AllowedTypes          []string `json:"allowed_types,omitempty" form:"allowed_types"`
+	RequireIDNumber       bool     `json:"require_id_number" form:"require_id_number"`
+	RequireLiveCapture    bool     `json:"require_live_capture" form:"require_live_capture"`
+	RequireMatchingSelfie bool     `json:"require_matching_selfie" form:"require_matching_selfie"`
+}
+
+// VerifiedOutputs holds the user details confirmed by a successful
+// verification.
+type VerifiedOutputs struct {
+	FirstName    string   `json:"first_name,omitempty"`
+	LastName     string   `json:"last_name,omitempty"`
+	Address      *Address `json:"address,omitempty"`
+	DOB          *DOB     `json:"dob,omitempty"`
+	IDNumberType string   `json:"id_number_type,omitempty"`
+}
+
+// VerificationSessionParams encapsulates options for creating a new
+// Verification Session.
+type VerificationSessionParams struct {
+	Params
+
+	// The type of verification check: document or id_number.
+	Type string `form:"type"`
+
+	// (Optional) Options for the document check.
+	Options *VerificationOptions `form:"options"`
+
+	// (Optional) The URL the user is redirected to after completing
+	// verification through the session's URL.
+	ReturnURL *string `form:"return_url"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// VerificationSessionListParams encapsulates options for filtering a list of
+// Verification Sessions.
+type VerificationSessionListParams struct {
+	ListParams
+
+	// (Optional) Only return sessions with the given status.
+	Status string `form:"status"`
+
+	// (Optional) Only return sessions created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// VerificationSessionClient encapsulates operations for creating, cancelling
+// and querying identity verification sessions using the Stripe REST API.
+type VerificationSessionClient struct{ scope }
+
+// With returns a VerificationSessionClient configured with the given options.
+func (c VerificationSessionClient) With(opts ...ClientOption) VerificationSessionClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Verification Session.
+//
+// see https://stripe.com/docs/api/identity/verification_sessions/create
+func (c VerificationSessionClient) Create(params *VerificationSessionParams) (*VerificationSession, error) {
+	res := &VerificationSession{}
+	return res, c.query("POST", "/identity/verification_sessions", formValues(params), res)
+}
+
+// Retrieves the Verification Session with the given ID.
+//
+// see https://stripe.com/docs/api/identity/verification_sessions/retrieve
+func (c VerificationSessionClient) Get(id string) (*VerificationSession, error) {
+	res := &VerificationSession{}
+	return res, c.query("GET", "/identity/verification_sessions/"+url.QueryEscape(id), nil, res)
+}
+
+// Cancels the Verification Session with the given ID, which must not have
+// been verified or already canceled.
+//
+// see https://stripe.com/docs/api/identity/verification_sessions/cancel
+func (c VerificationSessionClient) Cancel(id string) (*VerificationSession, error) {
+	res := &VerificationSession{}
+	return res, c.query("POST", "/identity/verification_sessions/"+url.QueryEscape(id)+"/cancel", nil, res)
+}
+
+// Redacts the Verification Session with the given ID, permanently removing
+// the collected personal data from Stripe.
+//
+// see https://stripe.com/docs/api/identity/verification_sessions/redact
+func (c VerificationSessionClient) Redact(id string) (*VerificationSession, error) {
+	res := &VerificationSession{}
+	return res, c.query("POST", "/identity/verification_sessions/"+url.QueryEscape(id)+"/redact", nil, res)
+}
+
+// Returns a list of Verification Sessions matching the params.
+//
+// see https://stripe.com/docs/api/identity/verification_sessions/list
+func (c VerificationSessionClient) List(params *VerificationSessionListParams) ([]*VerificationSession, bool, error) {
+	res := struct {
+		ListObject
+		Data []*VerificationSession
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/identity/verification_sessions", values, &res)
+	return res.Data, res.More, err
+}
+
+// VerificationReport holds the result of the checks performed during a
+// VerificationSession.
+//
+// see https://stripe.com/docs/api/identity/verification_reports/object
+type VerificationReport struct {
+	APIResource
+
+	ID                  string               `json:"id"`
+	Created             UnixTime             `json:"created"`
+	Document            *DocumentCheck       `json:"document,omitempty"`
+	IDNumber            *IDNumberCheck       `json:"id_number,omitempty"`
+	Selfie              *SelfieCheck         `json:"selfie,omitempty"`
+	Options             *VerificationOptions `json:"options,omitempty"`
+	Type                string               `json:"type"`
+	VerificationSession string               `json:"verification_session"`
+	Livemode            bool                 `json:"livemode"`
+}
+
+// DocumentCheck holds the result of an identity document check.
+type DocumentCheck struct {
+	Status         string             `json:"status"`
+	Error          *VerificationError `json:"error,omitempty"`
+	FirstName      string             `json:"first_name,omitempty"`
+	LastName       string             `json:"last_name,omitempty"`
+	Address        *Address           `json:"address,omitempty"`
+	DOB            *DOB               `json:"dob,omitempty"`
+	ExpirationDate *DOB               `json:"expiration_date,omitempty"`
+	IssuedDate     *DOB               `json:"issued_date,omitempty"`
+	IssuingCountry string             `json:"issuing_country,omitempty"`
+	Type           string             `json:"type,omitempty"`
+	Files          []string           `json:"files,omitempty"`
+}
+
+// IDNumberCheck holds the result of an ID number check.
+type IDNumberCheck struct {
+	Status       string             `json:"status"`
+	Error        *VerificationError `json:"error,omitempty"`
+	FirstName    string             `json:"first_name,omitempty"`
+	LastName     string             `json:"last_name,omitempty"`
+	DOB          *DOB               `json:"dob,omitempty"`
+	IDNumberType string             `json:"id_number_type,omitempty"`
+}
+
+// SelfieCheck holds the result of matching a selfie against the photo on an
+// identity document.
+type SelfieCheck struct {
+	Status   string             `json:"status"`
+	Error    *VerificationError `json:"error,omitempty"`
+	Document string             `json:"document,omitempty"`
+	Selfie   string             `json:"selfie,omitempty"`
+}
+
+// VerificationReportListParams encapsulates options for filtering a list of
+// Verification Reports.
+type VerificationReportListParams struct {
+	ListParams
+
+	// (Optional) Only return reports for the Verification Session with the
+	// given ID.
+	VerificationSession string `form:"verification_session"`
+
+	// (Optional) Only return reports of the given type.
+	Type string `form:"type"`
+
+	// (Optional) Only return reports created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// VerificationReportClient encapsulates operations for querying identity
+// verification reports using the Stripe REST API.
+type VerificationReportClient struct{ scope }
+
+// With returns a VerificationReportClient configured with the given options.
+func (c VerificationReportClient) With(opts ...ClientOption) VerificationReportClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Verification Report with the given ID.
+//
+// see https://stripe.com/docs/api/identity/verification_reports/retrieve
+func (c VerificationReportClient) Get(id string) (*VerificationReport, error) {
+	res := &VerificationReport{}
+	return res, c.query("GET", "/identity/verification_reports/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of Verification Reports matching the params.
+//
+// see https://stripe.com/docs/api/identity/verification_reports/list
+func (c VerificationReportClient) List(params *VerificationReportListParams) ([]*VerificationReport, bool, error) {
+	res := struct {
+		ListObject
+		Data []*VerificationReport
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/identity/verification_reports", values, &res)
+	return res.Data, res.More, err
+}
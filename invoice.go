@@ -1,38 +1,66 @@
 package stripe
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 )
 
+// InvoiceStatus is the status of an Invoice.
+type InvoiceStatus string
+
+// Invoice Statuses, returned by API versions since 2018-11-08 and derived
+// from Closed and Paid for earlier versions.
+const (
+	InvoiceDraft         InvoiceStatus = "draft"
+	InvoiceOpen          InvoiceStatus = "open"
+	InvoicePaid          InvoiceStatus = "paid"
+	InvoiceUncollectible InvoiceStatus = "uncollectible"
+	InvoiceVoid          InvoiceStatus = "void"
+)
+
 // Invoice represents statements of what a customer owes for a particular
 // billing period, including subscriptions, invoice items, and any automatic
 // proration adjustments if necessary.
 //
 // see https://stripe.com/docs/api#invoice_object
 type Invoice struct {
-	ID                 string            `json:"id"`
-	AmountDue          int               `json:"amount_due"`
-	AttemptCount       int               `json:"attempt_count"`
-	Attempted          bool              `json:"attempted"`
-	Closed             bool              `json:"closed"`
-	Paid               bool              `json:"paid"`
-	PeriodEnd          UnixTime          `json:"period_end"`
-	PeriodStart        UnixTime          `json:"period_start"`
-	Subtotal           int               `json:"subtotal"`
-	Total              int               `json:"total"`
-	Currency           string            `json:"currency"`
-	Charge             string            `json:"charge,omitempty"`
-	Customer           string            `json:"customer"`
-	Date               UnixTime          `json:"date"`
-	Discount           *Discount         `json:"discount,omitempty"`
-	Lines              *InvoiceLines     `json:"lines"`
-	StartingBalance    int               `json:"starting_balance"`
-	EndingBalance      int               `json:"ending_balance"`
-	NextPaymentAttempt *UnixTime         `json:"next_payment_attempt,omitempty"`
-	Livemode           bool              `json:"livemode"`
-	Metadata           map[string]string `json:"metadata"`
-	Description        string            `json:"omitempty"`
+	APIResource
+
+	ID                 string             `json:"id"`
+	AmountDue          int64              `json:"amount_due"`
+	AttemptCount       int                `json:"attempt_count"`
+	Attempted          bool               `json:"attempted"`
+	Closed             bool               `json:"closed"`
+	Status             InvoiceStatus      `json:"status,omitempty"`
+	Paid               bool               `json:"paid"`
+	PeriodEnd          UnixTime           `json:"period_end"`
+	PeriodStart        UnixTime           `json:"period_start"`
+	Subtotal           int64              `json:"subtotal"`
+	Tax                int64              `json:"tax"`
+	Total              int64              `json:"total"`
+	Currency           Currency           `json:"currency"`
+	Charge             ExpandableCharge   `json:"charge"`
+	Customer           ExpandableCustomer `json:"customer"`
+	Date               UnixTime           `json:"date"`
+	Discount           *Discount          `json:"discount,omitempty"`
+	Lines              *InvoiceLines      `json:"lines"`
+	StartingBalance    int64              `json:"starting_balance"`
+	EndingBalance      int64              `json:"ending_balance"`
+	NextPaymentAttempt *UnixTime          `json:"next_payment_attempt,omitempty"`
+	Livemode           bool               `json:"livemode"`
+	Metadata           map[string]string  `json:"metadata"`
+	Description        string             `json:"description,omitempty"`
+	PaymentIntent      string             `json:"payment_intent,omitempty"`
+
+	// The tax applied, as a percentage by older API versions and as rates by
+	// newer ones. TaxRates returns either as rates.
+	TaxPercent      *float64   `json:"tax_percent,omitempty"`
+	DefaultTaxRates []*TaxRate `json:"default_tax_rates,omitempty"`
+
+	// The fee the platform collects when the invoice, issued on a connected
+	// account, is paid.
+	ApplicationFeeAmount int64 `json:"application_fee_amount,omitempty"`
 }
 
 // InvoiceLines represents an individual line items that is part of an invoice.
@@ -42,10 +70,12 @@ type InvoiceLines struct {
 }
 
 type InvoiceLineItem struct {
+	APIResource
+
 	ID          string            `json:"id"`
 	Livemode    bool              `json:"livemode"`
-	Amount      int               `json:"amount"`
-	Currency    string            `json:"currency"`
+	Amount      int64             `json:"amount"`
+	Currency    Currency          `json:"currency"`
 	Period      Period            `json:"period"`
 	Proration   bool              `json:"proration"`
 	Type        string            `json:"type"`
@@ -61,56 +91,154 @@ type Period struct {
 }
 
 type InvoiceParams struct {
+	Params
+
 	// The customer ID to invoice
-	Customer string
+	Customer string `form:"customer"`
 
-	// (Optional) Invoice description
-	Description string
+	// (Optional) Invoice description. Set to an empty string to clear it.
+	Description *string `form:"description"`
 
 	// (Optional) Invoice metadata
-	Metadata map[string]string
+	Metadata map[string]string `form:"metadata"`
 
 	// (Optional) The ID of the subscription to invoice. If not set, the created
 	// invoice will include all pending invoice items for the customer.
-	Subscription string
+	Subscription *string `form:"subscription"`
 
 	// (Optional) Boolean representing whether an invoice is closed or not.
-	Closed *bool
+	Closed *bool `form:"closed"`
+
+	// (Optional) The ID of a connected account the invoice is issued on
+	// behalf of.
+	OnBehalfOf *string `form:"on_behalf_of"`
+
+	// (Optional) The fee the platform collects when the invoice, issued on a
+	// connected account, is paid, in the smallest unit of the currency.
+	ApplicationFeeAmount *int64 `form:"application_fee_amount"`
+
+	// (Optional) The text shown on the customer's statement when the invoice
+	// is paid, replacing the account's default: 5 to 22 Latin characters
+	// including a letter, and none of < > \ ' " *. Subscriptions take no
+	// descriptor of their own; set it on their invoices instead.
+	StatementDescriptor *string `form:"statement_descriptor"`
 }
 
 // InvoiceClient encapsulates operations for querying invoices using the Stripe
 // REST API.
-type InvoiceClient struct{}
+type InvoiceClient struct{ scope }
+
+// With returns an InvoiceClient configured with the given options.
+func (c InvoiceClient) With(opts ...ClientOption) InvoiceClient {
+	c.apply(opts)
+	return c
+}
 
 // Retrieves the invoice with the given ID.
 //
 // see https://stripe.com/docs/api#retrieve_invoice
-func (InvoiceClient) Get(id string) (*Invoice, error) {
+func (c InvoiceClient) Get(id string) (*Invoice, error) {
 	res := &Invoice{}
-	return res, query("GET", "/invoices/"+url.QueryEscape(id), nil, res)
+	return res, c.cachedGet("/invoices/"+url.QueryEscape(id), res, func() bool { return res.Closed && res.Paid })
 }
 
-func (InvoiceClient) Create(params *InvoiceParams) (*Invoice, error) {
+func (c InvoiceClient) Create(params *InvoiceParams) (*Invoice, error) {
+	if err := checkDescriptors(params.StatementDescriptor, nil); err != nil {
+		return nil, err
+	}
 	res := &Invoice{}
-	return res, query("POST", "/invoices", invoiceValues(params), res)
+	return res, c.query("POST", "/invoices", formValues(params), res)
 }
 
-func (InvoiceClient) Update(id string, params *InvoiceParams) (*Invoice, error) {
+func (c InvoiceClient) Update(id string, params *InvoiceParams) (*Invoice, error) {
+	if err := checkDescriptors(params.StatementDescriptor, nil); err != nil {
+		return nil, err
+	}
 	res := &Invoice{}
-	return res, query("POST", "/invoices/"+url.QueryEscape(id), invoiceValues(params), res)
+	path := "/invoices/" + url.QueryEscape(id)
+	err := c.query("POST", path, formValues(params), res)
+	c.uncache(path)
+	return res, err
 }
 
-func (InvoiceClient) Pay(id string) (*Invoice, error) {
+func (c InvoiceClient) Pay(id string) (*Invoice, error) {
 	res := &Invoice{}
-	return res, query("POST", fmt.Sprintf("/invoices/%s/pay", url.QueryEscape(id)), nil, res)
+	return res, c.query("POST", fmt.Sprintf("/invoices/%s/pay", url.QueryEscape(id)), nil, res)
 }
 
 // Retrieves the upcoming invoice the given customer ID.
 //
 // see https://stripe.com/docs/api#retrieve_customer_invoice
-func (InvoiceClient) Upcoming(customerID string) (*Invoice, error) {
+func (c InvoiceClient) Upcoming(customerID string) (*Invoice, error) {
 	res := &Invoice{}
-	return res, query("GET", "/invoices/upcoming", url.Values{"customer": {customerID}}, res)
+	return res, c.query("GET", "/invoices/upcoming", url.Values{"customer": {customerID}}, res)
+}
+
+// UpcomingInvoiceLinesParams encapsulates options for listing the line items
+// of a customer's upcoming invoice, optionally previewing a change to one of
+// their subscriptions.
+type UpcomingInvoiceLinesParams struct {
+	ListParams
+
+	// The ID of the customer whose upcoming invoice is previewed.
+	Customer string `form:"customer"`
+
+	// (Optional) The ID of the subscription whose upcoming invoice is
+	// previewed. If not set, the invoice includes all of the customer's
+	// subscriptions and pending invoice items.
+	Subscription *string `form:"subscription"`
+
+	// (Optional) The plan the subscription is previewed switched to.
+	SubscriptionPlan *string `form:"subscription_plan"`
+
+	// (Optional) The quantity the subscription is previewed with.
+	SubscriptionQuantity *int64 `form:"subscription_quantity"`
+
+	// (Optional) Whether the previewed change is prorated. Default is true.
+	SubscriptionProrate *bool `form:"subscription_prorate"`
+
+	// (Optional) The time prorations are calculated from, which should be
+	// sent again as the ProrationDate of the change for its amounts to match
+	// the preview.
+	SubscriptionProrationDate *UnixTime `form:"subscription_proration_date"`
+
+	// (Optional) The end of the trial the subscription is previewed with.
+	SubscriptionTrialEnd *UnixTime `form:"subscription_trial_end"`
+
+	// (Optional) The code of a coupon the invoice is previewed with.
+	Coupon *string `form:"coupon"`
+}
+
+// Returns a page of the line items of the upcoming invoice matching the
+// params, for previews with more line items than Upcoming includes.
+//
+// see https://stripe.com/docs/api#upcoming_invoice_lines
+func (c InvoiceClient) UpcomingLines(params *UpcomingInvoiceLinesParams) ([]*InvoiceLineItem, bool, error) {
+	res := InvoiceLines{}
+	err := c.query("GET", "/invoices/upcoming/lines", formValues(params), &res)
+	return res.Data, res.More, err
+}
+
+// AllUpcomingLines returns all of the line items of the upcoming invoice
+// matching the params, paging through them from params.After, 100 at a time
+// unless params.Limit is set.
+func (c InvoiceClient) AllUpcomingLines(params *UpcomingInvoiceLinesParams) ([]*InvoiceLineItem, error) {
+	page := *params
+	if page.Limit == 0 {
+		page.Limit = 100
+	}
+	var lines []*InvoiceLineItem
+	for {
+		data, more, err := c.UpcomingLines(&page)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, data...)
+		if !more || len(data) == 0 {
+			return lines, nil
+		}
+		page.After = data[len(data)-1].ID
+	}
 }
 
 // Returns a list of Invoices at the specified range.
@@ -127,7 +255,24 @@ func (c InvoiceClient) CustomerList(id string, limit int, before, after string)
 	return c.list(id, limit, before, after)
 }
 
-func (InvoiceClient) list(id string, limit int, before, after string) ([]*Invoice, bool, error) {
+// Each calls fn with each Invoice in the specified range as it is decoded,
+// without holding the whole page in memory, and reports whether more Invoices
+// are available. An error returned by fn stops the listing and is returned.
+//
+// see https://stripe.com/docs/api#list_invoices
+func (c InvoiceClient) Each(limit int, before, after string, fn func(*Invoice) error) (bool, error) {
+	return c.stream("/invoices", listParams(limit, before, after), func(raw json.RawMessage) error {
+		inv := &Invoice{}
+		if err := json.Unmarshal(raw, inv); err != nil {
+			return err
+		}
+		inv.setRawJSON(raw)
+		inv.normalizeVersion(c.versionFor())
+		return fn(inv)
+	})
+}
+
+func (c InvoiceClient) list(id string, limit int, before, after string) ([]*Invoice, bool, error) {
 	res := struct {
 		ListObject
 		Data []*Invoice
@@ -137,24 +282,6 @@ func (InvoiceClient) list(id string, limit int, before, after string) ([]*Invoic
 	if id != "" {
 		params.Add("customer", id)
 	}
-	err := query("GET", "/invoices", params, &res)
+	err := c.query("GET", "/invoices", params, &res)
 	return res.Data, res.More, err
 }
-
-func invoiceValues(inv *InvoiceParams) url.Values {
-	values := make(url.Values)
-	if inv.Customer != "" {
-		values.Add("customer", inv.Customer)
-	}
-	if inv.Description != "" {
-		values.Add("description", inv.Description)
-	}
-	if inv.Subscription != "" {
-		values.Add("subscription", inv.Subscription)
-	}
-	if inv.Closed != nil {
-		values.Add("closed", fmt.Sprintf("%t", *inv.Closed))
-	}
-	appendMetadata(values, inv.Metadata)
-	return values
-}
@@ -0,0 +1,115 @@
+// Package invoice is the Invoices API of the stripe package, under a package
+// of its own so that code using it can refer to invoice.Params and
+// invoice.Pay rather than the longer names the stripe package declares them
+// with:
+//
+//	in, err := invoice.Get("in_1")
+//	if err == nil && in.Status == invoice.Open {
+//		in, err = invoice.Pay(in.ID)
+//	}
+//
+// Its functions make requests with the package-level client, configured with
+// stripe.SetKey and the other Set functions. A Client configured otherwise is
+// returned by With, or taken from a stripe.Client created with NewClient.
+package invoice
+
+import "github.com/cupcake/stripe"
+
+type (
+	// Invoice is a stripe.Invoice.
+	Invoice = stripe.Invoice
+
+	// LineItem is a stripe.InvoiceLineItem.
+	LineItem = stripe.InvoiceLineItem
+
+	// Status is a stripe.InvoiceStatus.
+	Status = stripe.InvoiceStatus
+
+	// Params is a stripe.InvoiceParams.
+	Params = stripe.InvoiceParams
+
+	// UpcomingLinesParams is a stripe.UpcomingInvoiceLinesParams.
+	UpcomingLinesParams = stripe.UpcomingInvoiceLinesParams
+
+	// Client is a stripe.InvoiceClient.
+	Client = stripe.InvoiceClient
+)
+
+// Invoice Statuses.
+const (
+	Draft         = stripe.InvoiceDraft
+	Open          = stripe.InvoiceOpen
+	Paid          = stripe.InvoicePaid
+	Uncollectible = stripe.InvoiceUncollectible
+	Void          = stripe.InvoiceVoid
+)
+
+// With returns a Client configured with the given options.
+func With(opts ...stripe.ClientOption) Client {
+	return stripe.Invoices.With(opts...)
+}
+
+// Retrieves the invoice with the given ID.
+//
+// see https://stripe.com/docs/api#retrieve_invoice
+func Get(id string) (*Invoice, error) {
+	return stripe.Invoices.Get(id)
+}
+
+// Creates an invoice of the customer's pending invoice items.
+//
+// see https://stripe.com/docs/api#create_invoice
+func Create(params *Params) (*Invoice, error) {
+	return stripe.Invoices.Create(params)
+}
+
+// Updates the invoice with the given ID.
+//
+// see https://stripe.com/docs/api#update_invoice
+func Update(id string, params *Params) (*Invoice, error) {
+	return stripe.Invoices.Update(id, params)
+}
+
+// Pays the invoice with the given ID, outside of the normal collection
+// schedule.
+//
+// see https://stripe.com/docs/api#pay_invoice
+func Pay(id string) (*Invoice, error) {
+	return stripe.Invoices.Pay(id)
+}
+
+// Retrieves the upcoming invoice the given customer ID.
+//
+// see https://stripe.com/docs/api#retrieve_customer_invoice
+func Upcoming(customerID string) (*Invoice, error) {
+	return stripe.Invoices.Upcoming(customerID)
+}
+
+// AllUpcomingLines returns all of the line items of the upcoming invoice
+// matching the params, as stripe.InvoiceClient.AllUpcomingLines does.
+func AllUpcomingLines(params *UpcomingLinesParams) ([]*LineItem, error) {
+	return stripe.Invoices.AllUpcomingLines(params)
+}
+
+// Returns a list of Invoices at the specified range.
+//
+// see https://stripe.com/docs/api#list_customer_invoices
+func List(limit int, before, after string) ([]*Invoice, bool, error) {
+	return stripe.Invoices.List(limit, before, after)
+}
+
+// Returns a list of Invoices with the given Customer ID.
+//
+// see https://stripe.com/docs/api#list_customer_invoices
+func CustomerList(id string, limit int, before, after string) ([]*Invoice, bool, error) {
+	return stripe.Invoices.CustomerList(id, limit, before, after)
+}
+
+// Each calls fn with each Invoice in the specified range as it is decoded, and
+// reports whether more Invoices are available, as stripe.InvoiceClient.Each
+// does.
+//
+// see https://stripe.com/docs/api#list_invoices
+func Each(limit int, before, after string, fn func(*Invoice) error) (bool, error) {
+	return stripe.Invoices.Each(limit, before, after, fn)
+}
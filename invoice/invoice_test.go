@@ -0,0 +1,32 @@
+package invoice
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/cupcake/stripe"
+)
+
+// TestPay will test that Pay sends the request through the stripe package's
+// Backend, and that the Status of the Invoice it returns compares equal to
+// the package's constants.
+func TestPay(t *testing.T) {
+	var path string
+	stripe.SetBackend(stripe.BackendFunc(func(method, p string, values url.Values, v interface{}) error {
+		path = method + " " + p
+		return json.Unmarshal([]byte(`{"id":"in_1","status":"paid"}`), v)
+	}))
+	defer stripe.SetBackend(nil)
+
+	in, err := Pay("in_1")
+	if err != nil {
+		t.Fatalf("Expected Invoice, got Error %s", err.Error())
+	}
+	if in.ID != "in_1" || in.Status != Paid {
+		t.Errorf("Expected Invoice in_1 to be paid, got %s %s", in.ID, in.Status)
+	}
+	if path != "POST /invoices/in_1/pay" {
+		t.Errorf("Expected POST /invoices/in_1/pay, got %s", path)
+	}
+}
@@ -0,0 +1,149 @@
+package stripe
+
+import (
+	"encoding/csv"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// InvoiceExportHeader holds the columns of the CSV written by
+// InvoiceClient.Export.
+var InvoiceExportHeader = []string{"invoice", "date", "customer", "kind", "item", "description", "currency", "amount"}
+
+// Kinds of the rows written by InvoiceClient.Export.
+const (
+	// An invoice line item, with its amount before tax.
+	ExportLine = "line"
+
+	// The tax on the invoice as a whole.
+	ExportTax = "tax"
+
+	// The Stripe fees taken from the payment of the invoice, as a negative
+	// amount in the currency of the balance it was paid into.
+	ExportFee = "fee"
+
+	// The amount the payment of the invoice added to the balance, after fees.
+	ExportNet = "net"
+)
+
+// Export writes the Invoices dated within [from, to) to w as CSV, for handing
+// off to accounting: after a header row of InvoiceExportHeader, each Invoice
+// has a row for every line item, one for its tax if any, and, once paid, rows
+// for the fee and net amount of the payment's Balance Transaction. Amounts are
+// in the smallest unit of their currency.
+//
+// Invoices are listed 100 at a time and their lines paged through as needed,
+// so rows are written as they are retrieved. An error stops the export, and
+// rows already written are left in w.
+func (c InvoiceClient) Export(w io.Writer, from, to time.Time) error {
+	out := csv.NewWriter(w)
+	if err := out.Write(InvoiceExportHeader); err != nil {
+		return err
+	}
+
+	var after string
+	for {
+		values := listParams(100, "", after)
+		values.Add("date[gte]", strconv.FormatInt(from.Unix(), 10))
+		values.Add("date[lt]", strconv.FormatInt(to.Unix(), 10))
+		values.Add("expand[]", "data.charge")
+		page := struct {
+			ListObject
+			Data []*Invoice
+		}{}
+		if err := c.query("GET", "/invoices", values, &page); err != nil {
+			return err
+		}
+		for _, inv := range page.Data {
+			if err := c.exportInvoice(out, inv); err != nil {
+				return err
+			}
+		}
+		out.Flush()
+		if err := out.Error(); err != nil {
+			return err
+		}
+		if !page.More || len(page.Data) == 0 {
+			return nil
+		}
+		after = page.Data[len(page.Data)-1].ID
+	}
+}
+
+// exportInvoice writes the rows of the Invoice.
+func (c InvoiceClient) exportInvoice(out *csv.Writer, inv *Invoice) error {
+	row := func(kind, item, description string, currency Currency, amount int64) error {
+		return out.Write([]string{
+			inv.ID,
+			inv.Date.UTC().Format("2006-01-02"),
+			inv.Customer.ID(),
+			kind,
+			item,
+			description,
+			string(currency),
+			strconv.FormatInt(amount, 10),
+		})
+	}
+
+	lines, err := c.allLines(inv)
+	if err != nil {
+		return err
+	}
+	for _, line := range lines {
+		if err := row(ExportLine, line.ID, line.Description, line.Currency, line.Amount); err != nil {
+			return err
+		}
+	}
+	if inv.Tax != 0 {
+		if err := row(ExportTax, "", "", inv.Currency, inv.Tax); err != nil {
+			return err
+		}
+	}
+
+	if !inv.Paid || inv.Charge.ID() == "" {
+		return nil
+	}
+	charge := inv.Charge.Object()
+	if charge == nil {
+		if charge, err = (ChargeClient{c.scope}).Get(inv.Charge.ID()); err != nil {
+			return err
+		}
+	}
+	if charge.BalanceTransaction == "" {
+		return nil
+	}
+	txn, err := BalanceTransactionClient{c.scope}.Get(charge.BalanceTransaction)
+	if err != nil {
+		return err
+	}
+	if err := row(ExportFee, txn.ID, txn.Description, txn.Currency, -txn.Fee); err != nil {
+		return err
+	}
+	return row(ExportNet, txn.ID, txn.Description, txn.Currency, txn.Net)
+}
+
+// allLines returns all of the Invoice's line items, retrieving those that
+// were not included with it.
+func (c InvoiceClient) allLines(inv *Invoice) ([]*InvoiceLineItem, error) {
+	var lines []*InvoiceLineItem
+	var more bool
+	if inv.Lines != nil {
+		lines, more = inv.Lines.Data, inv.Lines.More
+	}
+	for more {
+		after := ""
+		if len(lines) > 0 {
+			after = lines[len(lines)-1].ID
+		}
+		page := InvoiceLines{}
+		path := "/invoices/" + url.QueryEscape(inv.ID) + "/lines"
+		if err := c.query("GET", path, listParams(100, "", after), &page); err != nil {
+			return nil, err
+		}
+		lines = append(lines, page.Data...)
+		more = page.More && len(page.Data) > 0
+	}
+	return lines, nil
+}
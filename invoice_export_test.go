@@ -0,0 +1,62 @@
+package stripe
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestInvoiceExport will test that invoices in the date range are written as
+// line, tax, fee and net rows, paging through both invoices and their lines.
+func TestInvoiceExport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		switch r.URL.Path {
+		case "/v1/invoices":
+			if r.Form.Get("date[gte]") != "1700000000" || r.Form.Get("date[lt]") != "1700086400" || r.Form.Get("expand[]") != "data.charge" {
+				t.Errorf("Expected the date range and an expanded charge, got %v", r.Form)
+			}
+			if r.Form.Get("starting_after") == "" {
+				w.Write([]byte(`{"has_more":true,"data":[{"id":"in_1","date":1700000100,"customer":"cus_1","currency":"usd","tax":80,"paid":true,
+					"charge":{"id":"ch_1","balance_transaction":"txn_1"},
+					"lines":{"has_more":true,"data":[{"id":"il_1","amount":500,"currency":"usd","description":"Seats"}]}}]}`))
+				return
+			}
+			if r.Form.Get("starting_after") != "in_1" {
+				t.Errorf("Expected the second page after in_1, got %v", r.Form)
+			}
+			w.Write([]byte(`{"has_more":false,"data":[{"id":"in_2","date":1700000200,"customer":"cus_2","currency":"usd","paid":false,
+				"lines":{"has_more":false,"data":[{"id":"il_3","amount":1000,"currency":"usd","description":"Plan, \"Pro\""}]}}]}`))
+		case "/v1/invoices/in_1/lines":
+			if r.Form.Get("starting_after") != "il_1" {
+				t.Errorf("Expected lines after il_1, got %v", r.Form)
+			}
+			w.Write([]byte(`{"has_more":false,"data":[{"id":"il_2","amount":300,"currency":"usd","description":"Usage"}]}`))
+		case "/v1/balance_transactions/txn_1":
+			w.Write([]byte(`{"id":"txn_1","currency":"usd","fee":55,"net":825,"description":"Invoice in_1"}`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+			w.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	from := time.Unix(1700000000, 0)
+	if err := Invoices.With(WithBaseURL(server.URL)).Export(&buf, from, from.Add(24*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	want := `invoice,date,customer,kind,item,description,currency,amount
+in_1,2023-11-14,cus_1,line,il_1,Seats,usd,500
+in_1,2023-11-14,cus_1,line,il_2,Usage,usd,300
+in_1,2023-11-14,cus_1,tax,,,usd,80
+in_1,2023-11-14,cus_1,fee,txn_1,Invoice in_1,usd,-55
+in_1,2023-11-14,cus_1,net,txn_1,Invoice in_1,usd,825
+in_2,2023-11-14,cus_2,line,il_3,"Plan, ""Pro""",usd,1000
+`
+	if buf.String() != want {
+		t.Errorf("Expected export\n%s\ngot\n%s", want, buf.String())
+	}
+}
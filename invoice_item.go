@@ -1,18 +1,17 @@
 package stripe
 
-import (
-	"net/url"
-	"strconv"
-)
+import "net/url"
 
 // InvoiceItem represents a charge (or credit) that should be applied to the
 // customer at the end of a billing cycle.
 //
 // see https://stripe.com/docs/api#invoiceitem_object
 type InvoiceItem struct {
+	APIResource
+
 	ID           string            `json:"id"`
-	Amount       int               `json:"amount"`
-	Currency     string            `json:"currency"`
+	Amount       int64             `json:"amount"`
+	Currency     Currency          `json:"currency"`
 	Customer     string            `json:"customer"`
 	Date         UnixTime          `json:"date"`
 	Description  string            `json:"description,omitempty"`
@@ -25,71 +24,63 @@ type InvoiceItem struct {
 
 // InvoiceItemParams encapsulates options for creating a new Invoice Items.
 type InvoiceItemParams struct {
+	Params
+
 	// The ID of the customer who will be billed when this invoice item is
 	// billed.
-	Customer string
+	Customer string `form:"customer"`
 
 	// The integer amount in cents of the charge to be applied to the upcoming
 	// invoice. If you want to apply a credit to the customer's account, pass a
 	// negative amount.
-	Amount int
+	Amount int64 `form:"amount"`
 
 	// 3-letter ISO code for currency.
-	Currency string
+	Currency Currency `form:"currency"`
 
 	// (Optional) An arbitrary string which you can attach to the invoice item.
 	// The description is displayed in the invoice for easy tracking.
-	Description string
+	Description *string `form:"description"`
 
 	// (Optional) The ID of an existing invoice to add this invoice item to.
 	// When left blank, the invoice item will be added to the next upcoming
 	// scheduled invoice.
-	Invoice string
+	Invoice *string `form:"invoice"`
 
 	// (Optional) The ID of a subscription to add this invoice item to.
-	Subscription string
+	Subscription *string `form:"subscription"`
 
-	Metadata map[string]string
+	Metadata map[string]string `form:"metadata"`
 }
 
 // InvoiceItemClient encapsulates operations for creating, updating, deleting
 // and querying invoices using the Stripe REST API.
-type InvoiceItemClient struct{}
+type InvoiceItemClient struct{ scope }
+
+// With returns an InvoiceItemClient configured with the given options.
+func (c InvoiceItemClient) With(opts ...ClientOption) InvoiceItemClient {
+	c.apply(opts)
+	return c
+}
 
 // Create adds an arbitrary charge or credit to the customer's upcoming invoice.
 //
 // see https://stripe.com/docs/api#invoiceitem_object
-func (InvoiceItemClient) Create(params *InvoiceItemParams) (*InvoiceItem, error) {
+func (c InvoiceItemClient) Create(params *InvoiceItemParams) (*InvoiceItem, error) {
 	item := InvoiceItem{}
-	values := url.Values{
-		"amount":   {strconv.Itoa(params.Amount)},
-		"currency": {params.Currency},
-		"customer": {params.Customer},
-	}
-
-	// add optional parameters
-	if params.Description != "" {
-		values.Add("description", params.Description)
-	}
-	if params.Invoice != "" {
-		values.Add("invoice", params.Invoice)
-	}
-	if params.Subscription != "" {
-		values.Add("subscription", params.Subscription)
-	}
-	appendMetadata(values, params.Metadata)
+	values := formValues(params)
 
-	err := query("POST", "/invoiceitems", values, &item)
+	err := c.query("POST", "/invoiceitems", values, &item)
 	return &item, err
 }
 
 // Retrieves the Invoice Item with the given ID.
 //
 // see https://stripe.com/docs/api#retrieve_invoiceitem
-func (InvoiceItemClient) Get(id string) (*InvoiceItem, error) {
+func (c InvoiceItemClient) Get(id string) (*InvoiceItem, error) {
 	item := InvoiceItem{}
 	path := "/invoiceitems/" + url.QueryEscape(id)
-	err := query("GET", path, nil, &item)
+	err := c.query("GET", path, nil, &item)
 	return &item, err
 }
 
@@ -97,32 +88,20 @@ func (InvoiceItemClient) Get(id string) (*InvoiceItem, error) {
 // invoice, using the given Invoice Item ID.
 //
 // see https://stripe.com/docs/api#update_invoiceitem
-func (InvoiceItemClient) Update(id string, params *InvoiceItemParams) (*InvoiceItem, error) {
+func (c InvoiceItemClient) Update(id string, params *InvoiceItemParams) (*InvoiceItem, error) {
 	item := InvoiceItem{}
-	values := make(url.Values)
+	values := formValues(params)
 
-	if params.Description != "" {
-		values.Add("description", params.Description)
-	}
-	if params.Amount != 0 {
-		values.Add("invoice", strconv.Itoa(params.Amount))
-	}
-	appendMetadata(values, params.Metadata)
-
-	err := query("POST", "/invoiceitems/"+url.QueryEscape(id), values, &item)
+	err := c.query("POST", "/invoiceitems/"+url.QueryEscape(id), values, &item)
 	return &item, err
 }
 
 // Removes an Invoice Item with the given ID.
 //
 // see https://stripe.com/docs/api#delete_invoiceitem
-func (InvoiceItemClient) Delete(id string) (bool, error) {
-	resp := DeleteResp{}
-	path := "/invoiceitems/" + url.QueryEscape(id)
-	if err := query("DELETE", path, nil, &resp); err != nil {
-		return false, err
-	}
-	return resp.Deleted, nil
+func (c InvoiceItemClient) Delete(id string) (*Deleted, error) {
+	res := &Deleted{}
+	return res, c.query("DELETE", "/invoiceitems/"+url.QueryEscape(id), nil, res)
 }
 
 // Returns a list of Invoice Items.
@@ -139,12 +118,12 @@ func (c InvoiceItemClient) CustomerList(id string, limit int, before, after stri
 	return c.list(id, limit, before, after)
 }
 
-func (InvoiceItemClient) list(id string, limit int, before, after string) ([]*InvoiceItem, error) {
+func (c InvoiceItemClient) list(id string, limit int, before, after string) ([]*InvoiceItem, error) {
 	res := struct{ Data []*InvoiceItem }{}
 	params := listParams(limit, before, after)
 	if id != "" {
 		params.Add("customer", id)
 	}
-	err := query("GET", "/invoiceitems", params, &res)
+	err := c.query("GET", "/invoiceitems", params, &res)
 	return res.Data, err
 }
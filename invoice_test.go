@@ -0,0 +1,47 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestAllUpcomingLines will test that the line items of a previewed
+// subscription change are paged through with the change sent for each page.
+func TestAllUpcomingLines(t *testing.T) {
+	prorate := false
+	quantity := int64(3)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.URL.Path != "/v1/invoices/upcoming/lines" {
+			t.Fatalf("Unexpected request to %s", r.URL.Path)
+		}
+		if r.Form.Get("customer") != "cus_1" || r.Form.Get("subscription_plan") != "gold" ||
+			r.Form.Get("subscription_quantity") != "3" || r.Form.Get("subscription_prorate") != "false" || r.Form.Get("limit") != "100" {
+			t.Errorf("Expected the change to be previewed, got %v", r.Form)
+		}
+		switch r.Form.Get("starting_after") {
+		case "":
+			w.Write([]byte(`{"has_more":true,"data":[{"id":"il_tmp_1","amount":-500,"proration":true},{"id":"il_tmp_2","amount":1000,"proration":true}]}`))
+		case "il_tmp_2":
+			w.Write([]byte(`{"has_more":false,"data":[{"id":"il_tmp_3","amount":3000,"quantity":3}]}`))
+		default:
+			t.Errorf("Unexpected cursor %s", r.Form.Get("starting_after"))
+		}
+	}))
+	defer server.Close()
+
+	lines, err := Invoices.With(WithBaseURL(server.URL)).AllUpcomingLines(&UpcomingInvoiceLinesParams{
+		Customer:             "cus_1",
+		Subscription:         String("sub_1"),
+		SubscriptionPlan:     String("gold"),
+		SubscriptionQuantity: &quantity,
+		SubscriptionProrate:  &prorate,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 3 || lines[2].ID != "il_tmp_3" || lines[2].Quantity != 3 {
+		t.Errorf("Expected all 3 line items, got %+v", lines)
+	}
+}
@@ -0,0 +1,181 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// Issuing Authorization Event Types
+const (
+	// Sent synchronously when a card is used, and must be answered within two
+	// seconds with WriteAuthorizationResponse. It cannot be handled through a
+	// WebhookProcessor, which acknowledges events before handling them.
+	EventIssuingAuthorizationRequest = "issuing_authorization.request"
+	EventIssuingAuthorizationCreated = "issuing_authorization.created"
+	EventIssuingAuthorizationUpdated = "issuing_authorization.updated"
+)
+
+// Issuing Authorization Statuses
+const (
+	AuthorizationPending  = "pending"
+	AuthorizationClosed   = "closed"
+	AuthorizationReversed = "reversed"
+)
+
+// IssuingAuthorization represents an attempt to use an issued card, which can
+// be approved or declined in real time.
+//
+// see https://stripe.com/docs/api/issuing/authorizations/object
+type IssuingAuthorization struct {
+	APIResource
+
+	ID                  string                 `json:"id"`
+	Amount              int64                  `json:"amount"`
+	Approved            bool                   `json:"approved"`
+	AuthorizationMethod string                 `json:"authorization_method"`
+	Card                *IssuingCard           `json:"card"`
+	Cardholder          string                 `json:"cardholder,omitempty"`
+	Created             UnixTime               `json:"created"`
+	Currency            Currency               `json:"currency"`
+	MerchantAmount      int64                  `json:"merchant_amount"`
+	MerchantCurrency    Currency               `json:"merchant_currency"`
+	MerchantData        *MerchantData          `json:"merchant_data"`
+	PendingRequest      *PendingRequest        `json:"pending_request,omitempty"`
+	RequestHistory      []AuthorizationRequest `json:"request_history,omitempty"`
+	Status              string                 `json:"status"`
+	Wallet              string                 `json:"wallet,omitempty"`
+	Metadata            map[string]string      `json:"metadata,omitempty"`
+	Livemode            bool                   `json:"livemode"`
+}
+
+// MerchantData describes the merchant a card was used at.
+type MerchantData struct {
+	Category     string `json:"category"`
+	CategoryCode string `json:"category_code"`
+	City         string `json:"city,omitempty"`
+	Country      string `json:"country,omitempty"`
+	Name         string `json:"name,omitempty"`
+	NetworkID    string `json:"network_id"`
+	PostalCode   string `json:"postal_code,omitempty"`
+	State        string `json:"state,omitempty"`
+}
+
+// PendingRequest holds the details of an authorization awaiting a decision.
+type PendingRequest struct {
+	Amount               int64    `json:"amount"`
+	Currency             Currency `json:"currency"`
+	IsAmountControllable bool     `json:"is_amount_controllable"`
+	MerchantAmount       int64    `json:"merchant_amount"`
+	MerchantCurrency     Currency `json:"merchant_currency"`
+}
+
+// AuthorizationRequest records a past decision made on an authorization.
+type AuthorizationRequest struct {
+	Amount           int64    `json:"amount"`
+	Approved         bool     `json:"approved"`
+	Created          UnixTime `json:"created"`
+	Currency         Currency `json:"currency"`
+	MerchantAmount   int64    `json:"merchant_amount"`
+	MerchantCurrency Currency `json:"merchant_currency"`
+	Reason           string   `json:"reason"`
+}
+
+// IssuingAuthorizationListParams encapsulates options for filtering a list of
+// Issuing Authorizations.
+type IssuingAuthorizationListParams struct {
+	ListParams
+
+	// (Optional) Only return authorizations for the Card or Cardholder with
+	// the given ID.
+	Card       string `form:"card"`
+	Cardholder string `form:"cardholder"`
+
+	// (Optional) Only return authorizations with the given status.
+	Status string `form:"status"`
+
+	// (Optional) Only return authorizations created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// IssuingAuthorizationClient encapsulates operations for approving, declining
+// and querying card authorizations using the Stripe REST API.
+type IssuingAuthorizationClient struct{ scope }
+
+// With returns an IssuingAuthorizationClient configured with the given options.
+func (c IssuingAuthorizationClient) With(opts ...ClientOption) IssuingAuthorizationClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Issuing Authorization with the given ID.
+//
+// see https://stripe.com/docs/api/issuing/authorizations/retrieve
+func (c IssuingAuthorizationClient) Get(id string) (*IssuingAuthorization, error) {
+	res := &IssuingAuthorization{}
+	return res, c.query("GET", "/issuing/authorizations/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the metadata of the Issuing Authorization with the given ID.
+//
+// see https://stripe.com/docs/api/issuing/authorizations/update
+func (c IssuingAuthorizationClient) Update(id string, metadata map[string]string) (*IssuingAuthorization, error) {
+	values := formValues(struct {
+		Metadata map[string]string `form:"metadata"`
+	}{metadata})
+
+	res := &IssuingAuthorization{}
+	return res, c.query("POST", "/issuing/authorizations/"+url.QueryEscape(id), values, res)
+}
+
+// Approves the pending Issuing Authorization with the given ID. A non-zero
+// amount approves only part of the request, if the amount is controllable.
+//
+// see https://stripe.com/docs/api/issuing/authorizations/approve
+func (c IssuingAuthorizationClient) Approve(id string, amount int64) (*IssuingAuthorization, error) {
+	values := formValues(struct {
+		Amount int64 `form:"amount"`
+	}{amount})
+	res := &IssuingAuthorization{}
+	return res, c.query("POST", "/issuing/authorizations/"+url.QueryEscape(id)+"/approve", values, res)
+}
+
+// Declines the pending Issuing Authorization with the given ID.
+//
+// see https://stripe.com/docs/api/issuing/authorizations/decline
+func (c IssuingAuthorizationClient) Decline(id string) (*IssuingAuthorization, error) {
+	res := &IssuingAuthorization{}
+	return res, c.query("POST", "/issuing/authorizations/"+url.QueryEscape(id)+"/decline", nil, res)
+}
+
+// Returns a list of Issuing Authorizations matching the params.
+//
+// see https://stripe.com/docs/api/issuing/authorizations/list
+func (c IssuingAuthorizationClient) List(params *IssuingAuthorizationListParams) ([]*IssuingAuthorization, bool, error) {
+	res := struct {
+		ListObject
+		Data []*IssuingAuthorization
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/issuing/authorizations", values, &res)
+	return res.Data, res.More, err
+}
+
+// WriteAuthorizationResponse answers an issuing_authorization.request webhook
+// directly in the response body, approving or declining the authorization
+// without a separate API call. A non-zero amount approves only part of the
+// request, if the amount is controllable.
+//
+// see https://stripe.com/docs/issuing/controls/real-time-authorizations
+func WriteAuthorizationResponse(w http.ResponseWriter, approved bool, amount int64) error {
+	body := struct {
+		Approved bool  `json:"approved"`
+		Amount   int64 `json:"amount,omitempty"`
+	}{approved, amount}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Stripe-Version", "2022-08-01")
+	w.WriteHeader(http.StatusOK)
+	return json.NewEncoder(w).Encode(&body)
+}
@@ -0,0 +1,221 @@
+package stripe
+
+import "net/url"
+
+// Issuing Card Types
+const (
+	IssuingCardPhysical = "physical"
+	IssuingCardVirtual  = "virtual"
+)
+
+// Issuing Card Statuses
+const (
+	IssuingCardActive   = "active"
+	IssuingCardInactive = "inactive"
+	IssuingCardCanceled = "canceled"
+)
+
+// IssuingCard represents a physical or virtual card issued to a Cardholder
+// through Stripe Issuing.
+//
+// see https://stripe.com/docs/api/issuing/cards/object
+type IssuingCard struct {
+	APIResource
+
+	ID                 string             `json:"id"`
+	Brand              string             `json:"brand"`
+	CancellationReason string             `json:"cancellation_reason,omitempty"`
+	Cardholder         *IssuingCardholder `json:"cardholder"`
+	Created            UnixTime           `json:"created"`
+	Currency           Currency           `json:"currency"`
+	ExpMonth           int                `json:"exp_month"`
+	ExpYear            int                `json:"exp_year"`
+	Last4              string             `json:"last4"`
+	ReplacedBy         string             `json:"replaced_by,omitempty"`
+	ReplacementFor     string             `json:"replacement_for,omitempty"`
+	ReplacementReason  string             `json:"replacement_reason,omitempty"`
+	Shipping           *IssuingShipping   `json:"shipping,omitempty"`
+	SpendingControls   *SpendingControls  `json:"spending_controls,omitempty"`
+	Status             string             `json:"status"`
+	Type               string             `json:"type"`
+	Metadata           map[string]string  `json:"metadata,omitempty"`
+	Livemode           bool               `json:"livemode"`
+}
+
+// IssuingShipping describes where and how a physical card is shipped.
+type IssuingShipping struct {
+	Address        *Address  `json:"address" form:"address"`
+	Carrier        string    `json:"carrier,omitempty"`
+	ETA            *UnixTime `json:"eta,omitempty"`
+	Name           string    `json:"name" form:"name"`
+	Service        string    `json:"service" form:"service"`
+	Status         string    `json:"status,omitempty"`
+	TrackingNumber string    `json:"tracking_number,omitempty"`
+	TrackingURL    string    `json:"tracking_url,omitempty"`
+	Type           string    `json:"type" form:"type"`
+}
+
+// SpendingControls restricts where and how much an issued card, or all the
+// cards of a Cardholder, can spend.
+//
+// see https://stripe.com/docs/issuing/controls/spending-controls
+type SpendingControls struct {
+	AllowedCategories      []string        `json:"allowed_categories,omitempty" form:"allowed_categories"`
+	BlockedCategories      []string        `json:"blocked_categories,omitempty" form:"blocked_categories"`
+	SpendingLimits         []SpendingLimit `json:"spending_limits,omitempty" form:"spending_limits"`
+	SpendingLimitsCurrency Currency        `json:"spending_limits_currency,omitempty" form:"spending_limits_currency"`
+}
+
+// SpendingLimit caps the amount that can be spent over an interval, such as
+// per_authorization, daily, weekly, monthly, yearly or all_time, optionally
+// only for the given merchant categories.
+type SpendingLimit struct {
+	Amount     int64    `json:"amount" form:"amount"`
+	Categories []string `json:"categories,omitempty" form:"categories"`
+	Interval   string   `json:"interval" form:"interval"`
+}
+
+// IssuingCardParams encapsulates options for creating and updating Issuing
+// Cards.
+type IssuingCardParams struct {
+	Params
+
+	// The ID of the Cardholder the card is issued to. Can only be set on
+	// creation.
+	Cardholder string `form:"cardholder"`
+
+	// The currency of the card. Can only be set on creation.
+	Currency Currency `form:"currency"`
+
+	// The type of card to issue: physical or virtual. Can only be set on
+	// creation.
+	Type string `form:"type"`
+
+	// (Optional) Whether the card is active, inactive or canceled.
+	Status *string `form:"status"`
+
+	// (Optional) The reason for canceling the card, lost or stolen. Can only
+	// be set on update.
+	CancellationReason *string `form:"cancellation_reason"`
+
+	// (Optional) The ID of a card this card replaces, and the reason: damaged,
+	// expired, lost or stolen. Can only be set on creation.
+	ReplacementFor    *string `form:"replacement_for"`
+	ReplacementReason *string `form:"replacement_reason"`
+
+	// (Optional) Where to ship a physical card. Can only be set on creation.
+	Shipping *IssuingShipping `form:"shipping"`
+
+	// (Optional) Rules that control spending on the card.
+	SpendingControls *SpendingControls `form:"spending_controls"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// IssuingCardListParams encapsulates options for filtering a list of Issuing
+// Cards.
+type IssuingCardListParams struct {
+	ListParams
+
+	// (Optional) Only return cards issued to the Cardholder with the given ID.
+	Cardholder string `form:"cardholder"`
+
+	// (Optional) Only return cards of the given type or status.
+	Type   string `form:"type"`
+	Status string `form:"status"`
+
+	// (Optional) Only return cards with the given last four digits or
+	// expiry.
+	Last4    string `form:"last4"`
+	ExpMonth int    `form:"exp_month"`
+	ExpYear  int    `form:"exp_year"`
+
+	// (Optional) Only return cards created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// IssuingCardClient encapsulates operations for creating, updating and
+// querying issued cards using the Stripe REST API.
+type IssuingCardClient struct{ scope }
+
+// With returns an IssuingCardClient configured with the given options.
+func (c IssuingCardClient) With(opts ...ClientOption) IssuingCardClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Issuing Card for a Cardholder.
+//
+// see https://stripe.com/docs/api/issuing/cards/create
+func (c IssuingCardClient) Create(params *IssuingCardParams) (*IssuingCard, error) {
+	res := &IssuingCard{}
+	return res, c.query("POST", "/issuing/cards", formValues(params), res)
+}
+
+// Retrieves the Issuing Card with the given ID.
+//
+// see https://stripe.com/docs/api/issuing/cards/retrieve
+func (c IssuingCardClient) Get(id string) (*IssuingCard, error) {
+	res := &IssuingCard{}
+	return res, c.query("GET", "/issuing/cards/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the Issuing Card with the given ID.
+//
+// see https://stripe.com/docs/api/issuing/cards/update
+func (c IssuingCardClient) Update(id string, params *IssuingCardParams) (*IssuingCard, error) {
+	res := &IssuingCard{}
+	return res, c.query("POST", "/issuing/cards/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Returns a list of Issuing Cards matching the params.
+//
+// see https://stripe.com/docs/api/issuing/cards/list
+func (c IssuingCardClient) List(params *IssuingCardListParams) ([]*IssuingCard, bool, error) {
+	res := struct {
+		ListObject
+		Data []*IssuingCard
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/issuing/cards", values, &res)
+	return res.Data, res.More, err
+}
+
+// Ship marks the physical Issuing Card with the given ID as shipped. Test mode
+// only.
+//
+// see https://stripe.com/docs/api/issuing/cards/test_mode_ship
+func (c IssuingCardClient) Ship(id string) (*IssuingCard, error) {
+	return c.shipping(id, "ship")
+}
+
+// Deliver marks the physical Issuing Card with the given ID as delivered. Test
+// mode only.
+//
+// see https://stripe.com/docs/api/issuing/cards/test_mode_deliver
+func (c IssuingCardClient) Deliver(id string) (*IssuingCard, error) {
+	return c.shipping(id, "deliver")
+}
+
+// Return marks the physical Issuing Card with the given ID as returned to
+// sender. Test mode only.
+//
+// see https://stripe.com/docs/api/issuing/cards/test_mode_return
+func (c IssuingCardClient) Return(id string) (*IssuingCard, error) {
+	return c.shipping(id, "return")
+}
+
+// Fail marks the shipment of the physical Issuing Card with the given ID as
+// failed. Test mode only.
+//
+// see https://stripe.com/docs/api/issuing/cards/test_mode_fail
+func (c IssuingCardClient) Fail(id string) (*IssuingCard, error) {
+	return c.shipping(id, "fail")
+}
+
+func (c IssuingCardClient) shipping(id, action string) (*IssuingCard, error) {
+	res := &IssuingCard{}
+	path := "/test_helpers/issuing/cards/" + url.QueryEscape(id) + "/shipping/" + action
+	return res, c.query("POST", path, nil, res)
+}
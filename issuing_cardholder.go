@@ -0,0 +1,174 @@
+package stripe
+
+import "net/url"
+
+// Cardholder Types
+const (
+	CardholderIndividual = "individual"
+	CardholderCompany    = "company"
+)
+
+// IssuingCardholder represents a person or business entity that is issued
+// cards through Stripe Issuing.
+//
+// see https://stripe.com/docs/api/issuing/cardholders/object
+type IssuingCardholder struct {
+	APIResource
+
+	ID               string                  `json:"id"`
+	Billing          *IssuingBilling         `json:"billing"`
+	Company          *IssuingCompany         `json:"company,omitempty"`
+	Email            string                  `json:"email,omitempty"`
+	Individual       *IssuingIndividual      `json:"individual,omitempty"`
+	Name             string                  `json:"name"`
+	PhoneNumber      string                  `json:"phone_number,omitempty"`
+	PreferredLocales []string                `json:"preferred_locales,omitempty"`
+	Requirements     *CardholderRequirements `json:"requirements,omitempty"`
+	SpendingControls *SpendingControls       `json:"spending_controls,omitempty"`
+	Status           string                  `json:"status"`
+	Type             string                  `json:"type"`
+	Created          UnixTime                `json:"created"`
+	Metadata         map[string]string       `json:"metadata,omitempty"`
+	Livemode         bool                    `json:"livemode"`
+}
+
+// IssuingBilling holds the billing address of a Cardholder.
+type IssuingBilling struct {
+	Address *Address `json:"address"`
+}
+
+// IssuingCompany holds the details of a company Cardholder.
+type IssuingCompany struct {
+	TaxIDProvided bool `json:"tax_id_provided"`
+}
+
+// IssuingIndividual holds the details of an individual Cardholder.
+type IssuingIndividual struct {
+	FirstName    string                  `json:"first_name,omitempty" form:"first_name"`
+	LastName     string                  `json:"last_name,omitempty" form:"last_name"`
+	DOB          *DOB                    `json:"dob,omitempty" form:"dob"`
+	Verification *IndividualVerification `json:"verification,omitempty" form:"verification"`
+	CardIssuing  *CardIssuing            `json:"card_issuing,omitempty" form:"card_issuing"`
+}
+
+// IndividualVerification references the identity document of an individual
+// Cardholder.
+type IndividualVerification struct {
+	Document *VerificationDocument `json:"document,omitempty" form:"document"`
+}
+
+// CardIssuing records the Cardholder's acceptance of the card issuing terms.
+type CardIssuing struct {
+	UserTermsAcceptance *TOSAcceptance `json:"user_terms_acceptance,omitempty" form:"user_terms_acceptance"`
+}
+
+// CardholderRequirements describes the information that must be collected
+// before cards can be issued to the Cardholder.
+type CardholderRequirements struct {
+	DisabledReason string   `json:"disabled_reason,omitempty"`
+	PastDue        []string `json:"past_due,omitempty"`
+}
+
+// IssuingCardholderParams encapsulates options for creating and updating
+// Issuing Cardholders.
+type IssuingCardholderParams struct {
+	Params
+
+	// The cardholder's name, as printed on cards. Can only be set on creation.
+	Name string `form:"name"`
+
+	// The type of cardholder: individual or company. Can only be set on
+	// creation.
+	Type string `form:"type"`
+
+	// The cardholder's billing address, which is required on creation.
+	Billing *Address `form:"billing[address]"`
+
+	// (Optional) The cardholder's email address.
+	Email *string `form:"email"`
+
+	// (Optional) The cardholder's phone number, required for 3D Secure.
+	PhoneNumber *string `form:"phone_number"`
+
+	// (Optional) The tax ID of a company cardholder.
+	CompanyTaxID *string `form:"company[tax_id]"`
+
+	// (Optional) The details of an individual cardholder.
+	Individual *IssuingIndividual `form:"individual"`
+
+	// (Optional) Preferred languages, e.g. "en" or "fr".
+	PreferredLocales []string `form:"preferred_locales"`
+
+	// (Optional) Rules that control spending across all the cardholder's cards.
+	SpendingControls *SpendingControls `form:"spending_controls"`
+
+	// (Optional) Whether the cardholder is active or inactive.
+	Status *string `form:"status"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// IssuingCardholderListParams encapsulates options for filtering a list of
+// Issuing Cardholders.
+type IssuingCardholderListParams struct {
+	ListParams
+
+	// (Optional) Only return cardholders with the given email or phone number.
+	Email       string `form:"email"`
+	PhoneNumber string `form:"phone_number"`
+
+	// (Optional) Only return cardholders of the given type or status.
+	Type   string `form:"type"`
+	Status string `form:"status"`
+
+	// (Optional) Only return cardholders created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// IssuingCardholderClient encapsulates operations for creating, updating and
+// querying cardholders using the Stripe REST API.
+type IssuingCardholderClient struct{ scope }
+
+// With returns an IssuingCardholderClient configured with the given options.
+func (c IssuingCardholderClient) With(opts ...ClientOption) IssuingCardholderClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Issuing Cardholder.
+//
+// see https://stripe.com/docs/api/issuing/cardholders/create
+func (c IssuingCardholderClient) Create(params *IssuingCardholderParams) (*IssuingCardholder, error) {
+	res := &IssuingCardholder{}
+	return res, c.query("POST", "/issuing/cardholders", formValues(params), res)
+}
+
+// Retrieves the Issuing Cardholder with the given ID.
+//
+// see https://stripe.com/docs/api/issuing/cardholders/retrieve
+func (c IssuingCardholderClient) Get(id string) (*IssuingCardholder, error) {
+	res := &IssuingCardholder{}
+	return res, c.query("GET", "/issuing/cardholders/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the Issuing Cardholder with the given ID.
+//
+// see https://stripe.com/docs/api/issuing/cardholders/update
+func (c IssuingCardholderClient) Update(id string, params *IssuingCardholderParams) (*IssuingCardholder, error) {
+	res := &IssuingCardholder{}
+	return res, c.query("POST", "/issuing/cardholders/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Returns a list of Issuing Cardholders matching the params.
+//
+// see https://stripe.com/docs/api/issuing/cardholders/list
+func (c IssuingCardholderClient) List(params *IssuingCardholderListParams) ([]*IssuingCardholder, bool, error) {
+	res := struct {
+		ListObject
+		Data []*IssuingCardholder
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/issuing/cardholders", values, &res)
+	return res.Data, res.More, err
+}
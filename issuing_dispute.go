@@ -0,0 +1,154 @@
+package stripe
+
+import "net/url"
+
+// Issuing Dispute Reasons
+const (
+	IssuingDisputeCanceled                  = "canceled"
+	IssuingDisputeDuplicate                 = "duplicate"
+	IssuingDisputeFraudulent                = "fraudulent"
+	IssuingDisputeMerchandiseNotAsDescribed = "merchandise_not_as_described"
+	IssuingDisputeNotReceived               = "not_received"
+	IssuingDisputeOther                     = "other"
+	IssuingDisputeServiceNotAsDescribed     = "service_not_as_described"
+)
+
+// IssuingDispute represents a dispute raised by you, as the issuer, against an
+// IssuingTransaction.
+//
+// see https://stripe.com/docs/api/issuing/disputes/object
+type IssuingDispute struct {
+	APIResource
+
+	ID          string                  `json:"id"`
+	Amount      int64                   `json:"amount"`
+	Created     UnixTime                `json:"created"`
+	Currency    Currency                `json:"currency"`
+	Evidence    *IssuingDisputeEvidence `json:"evidence"`
+	Status      string                  `json:"status"`
+	Transaction string                  `json:"transaction"`
+	Metadata    map[string]string       `json:"metadata,omitempty"`
+	Livemode    bool                    `json:"livemode"`
+}
+
+// IssuingDisputeEvidence holds the reason for an IssuingDispute, along with
+// the supporting details for that reason. Only the details matching Reason
+// should be set.
+type IssuingDisputeEvidence struct {
+	Reason                    string                 `json:"reason" form:"reason"`
+	Canceled                  *IssuingDisputeDetails `json:"canceled,omitempty" form:"canceled"`
+	Duplicate                 *IssuingDisputeDetails `json:"duplicate,omitempty" form:"duplicate"`
+	Fraudulent                *IssuingDisputeDetails `json:"fraudulent,omitempty" form:"fraudulent"`
+	MerchandiseNotAsDescribed *IssuingDisputeDetails `json:"merchandise_not_as_described,omitempty" form:"merchandise_not_as_described"`
+	NotReceived               *IssuingDisputeDetails `json:"not_received,omitempty" form:"not_received"`
+	Other                     *IssuingDisputeDetails `json:"other,omitempty" form:"other"`
+	ServiceNotAsDescribed     *IssuingDisputeDetails `json:"service_not_as_described,omitempty" form:"service_not_as_described"`
+}
+
+// IssuingDisputeDetails supports the reason given for an IssuingDispute. Which
+// fields apply depends on the reason.
+type IssuingDisputeDetails struct {
+	// The ID of a File with the purpose dispute_evidence.
+	AdditionalDocumentation string    `json:"additional_documentation,omitempty" form:"additional_documentation"`
+	Explanation             string    `json:"explanation,omitempty" form:"explanation"`
+	ProductDescription      string    `json:"product_description,omitempty" form:"product_description"`
+	ProductType             string    `json:"product_type,omitempty" form:"product_type"`
+	OriginalTransaction     string    `json:"original_transaction,omitempty" form:"original_transaction"`
+	CanceledAt              *UnixTime `json:"canceled_at,omitempty" form:"canceled_at"`
+	ExpectedAt              *UnixTime `json:"expected_at,omitempty" form:"expected_at"`
+	ReceivedAt              *UnixTime `json:"received_at,omitempty" form:"received_at"`
+	ReturnedAt              *UnixTime `json:"returned_at,omitempty" form:"returned_at"`
+}
+
+// IssuingDisputeParams encapsulates options for creating and updating Issuing
+// Disputes.
+type IssuingDisputeParams struct {
+	Params
+
+	// The ID of the IssuingTransaction to dispute. Can only be set on
+	// creation.
+	Transaction string `form:"transaction"`
+
+	// (Optional) The amount to dispute. Defaults to the full transaction
+	// amount.
+	Amount *int64 `form:"amount"`
+
+	// (Optional) Evidence supporting the dispute.
+	Evidence *IssuingDisputeEvidence `form:"evidence"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// IssuingDisputeListParams encapsulates options for filtering a list of
+// Issuing Disputes.
+type IssuingDisputeListParams struct {
+	ListParams
+
+	// (Optional) Only return disputes with the given status: unsubmitted,
+	// submitted, won, lost or expired.
+	Status string `form:"status"`
+
+	// (Optional) Only return disputes for the IssuingTransaction with the
+	// given ID.
+	Transaction string `form:"transaction"`
+
+	// (Optional) Only return disputes created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// IssuingDisputeClient encapsulates operations for creating, submitting and
+// querying disputes of issued card transactions using the Stripe REST API.
+type IssuingDisputeClient struct{ scope }
+
+// With returns an IssuingDisputeClient configured with the given options.
+func (c IssuingDisputeClient) With(opts ...ClientOption) IssuingDisputeClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new, unsubmitted Issuing Dispute.
+//
+// see https://stripe.com/docs/api/issuing/disputes/create
+func (c IssuingDisputeClient) Create(params *IssuingDisputeParams) (*IssuingDispute, error) {
+	res := &IssuingDispute{}
+	return res, c.query("POST", "/issuing/disputes", formValues(params), res)
+}
+
+// Retrieves the Issuing Dispute with the given ID.
+//
+// see https://stripe.com/docs/api/issuing/disputes/retrieve
+func (c IssuingDisputeClient) Get(id string) (*IssuingDispute, error) {
+	res := &IssuingDispute{}
+	return res, c.query("GET", "/issuing/disputes/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the Issuing Dispute with the given ID. Evidence can only be updated
+// before the dispute is submitted.
+//
+// see https://stripe.com/docs/api/issuing/disputes/update
+func (c IssuingDisputeClient) Update(id string, params *IssuingDisputeParams) (*IssuingDispute, error) {
+	res := &IssuingDispute{}
+	return res, c.query("POST", "/issuing/disputes/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Submits the Issuing Dispute with the given ID to the card network.
+//
+// see https://stripe.com/docs/api/issuing/disputes/submit
+func (c IssuingDisputeClient) Submit(id string) (*IssuingDispute, error) {
+	res := &IssuingDispute{}
+	return res, c.query("POST", "/issuing/disputes/"+url.QueryEscape(id)+"/submit", nil, res)
+}
+
+// Returns a list of Issuing Disputes matching the params.
+//
+// see https://stripe.com/docs/api/issuing/disputes/list
+func (c IssuingDisputeClient) List(params *IssuingDisputeListParams) ([]*IssuingDispute, bool, error) {
+	res := struct {
+		ListObject
+		Data []*IssuingDispute
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/issuing/disputes", values, &res)
+	return res.Data, res.More, err
+}
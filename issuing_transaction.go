@@ -0,0 +1,95 @@
+package stripe
+
+import "net/url"
+
+// Issuing Transaction Types
+const (
+	IssuingTransactionCapture = "capture"
+	IssuingTransactionRefund  = "refund"
+)
+
+// IssuingTransaction represents money moving on an issued card, usually the
+// capture of an IssuingAuthorization or a refund by the merchant.
+//
+// see https://stripe.com/docs/api/issuing/transactions/object
+type IssuingTransaction struct {
+	APIResource
+
+	ID                 string            `json:"id"`
+	Amount             int64             `json:"amount"`
+	Authorization      string            `json:"authorization,omitempty"`
+	BalanceTransaction string            `json:"balance_transaction,omitempty"`
+	Card               string            `json:"card"`
+	Cardholder         string            `json:"cardholder,omitempty"`
+	Created            UnixTime          `json:"created"`
+	Currency           Currency          `json:"currency"`
+	Dispute            string            `json:"dispute,omitempty"`
+	MerchantAmount     int64             `json:"merchant_amount"`
+	MerchantCurrency   Currency          `json:"merchant_currency"`
+	MerchantData       *MerchantData     `json:"merchant_data"`
+	Type               string            `json:"type"`
+	Wallet             string            `json:"wallet,omitempty"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+	Livemode           bool              `json:"livemode"`
+}
+
+// IssuingTransactionListParams encapsulates options for filtering a list of
+// Issuing Transactions.
+type IssuingTransactionListParams struct {
+	ListParams
+
+	// (Optional) Only return transactions for the Card or Cardholder with the
+	// given ID.
+	Card       string `form:"card"`
+	Cardholder string `form:"cardholder"`
+
+	// (Optional) Only return transactions of the given type.
+	Type string `form:"type"`
+
+	// (Optional) Only return transactions created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// IssuingTransactionClient encapsulates operations for querying issued card
+// transactions using the Stripe REST API.
+type IssuingTransactionClient struct{ scope }
+
+// With returns an IssuingTransactionClient configured with the given options.
+func (c IssuingTransactionClient) With(opts ...ClientOption) IssuingTransactionClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Issuing Transaction with the given ID.
+//
+// see https://stripe.com/docs/api/issuing/transactions/retrieve
+func (c IssuingTransactionClient) Get(id string) (*IssuingTransaction, error) {
+	res := &IssuingTransaction{}
+	return res, c.query("GET", "/issuing/transactions/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the metadata of the Issuing Transaction with the given ID.
+//
+// see https://stripe.com/docs/api/issuing/transactions/update
+func (c IssuingTransactionClient) Update(id string, metadata map[string]string) (*IssuingTransaction, error) {
+	values := formValues(struct {
+		Metadata map[string]string `form:"metadata"`
+	}{metadata})
+
+	res := &IssuingTransaction{}
+	return res, c.query("POST", "/issuing/transactions/"+url.QueryEscape(id), values, res)
+}
+
+// Returns a list of Issuing Transactions matching the params.
+//
+// see https://stripe.com/docs/api/issuing/transactions/list
+func (c IssuingTransactionClient) List(params *IssuingTransactionListParams) ([]*IssuingTransaction, bool, error) {
+	res := struct {
+		ListObject
+		Data []*IssuingTransaction
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/issuing/transactions", values, &res)
+	return res.Data, res.More, err
+}
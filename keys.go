@@ -0,0 +1,213 @@
+package stripe
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// LiveKeyError is returned for requests made with a live mode API key while
+// test mode is required.
+var LiveKeyError = errors.New("stripe: refusing to send a request with a live mode key in test mode")
+
+// KeyFallback describes a request Stripe rejected as unauthorized that was
+// sent again with the fallback API key.
+type KeyFallback struct {
+	Method string
+	Path   string
+
+	// the ID Stripe assigned the rejected request
+	RequestID string
+
+	// the status of the response to the request sent with the fallback key,
+	// or the error if no response was received
+	Status int
+	Err    error
+}
+
+var (
+	// the API key requests rejected with the key set with SetKey are sent
+	// again with, if any
+	_fallbackKey string
+
+	// the function that receives a KeyFallback whenever the fallback key is
+	// used, if any
+	_keyFallback func(*KeyFallback)
+
+	// whether requests with live mode keys are refused
+	_requireTestMode bool
+)
+
+// IsLiveKey reports whether key is a live mode secret, restricted or
+// publishable key, e.g. sk_live_... or rk_live_....
+func IsLiveKey(key string) bool {
+	return keyMode(key) == "live"
+}
+
+// IsTestKey reports whether key is a test mode secret, restricted or
+// publishable key, e.g. sk_test_... or rk_test_....
+func IsTestKey(key string) bool {
+	return keyMode(key) == "test"
+}
+
+// keyMode returns the mode in the prefix of an API key, or an empty string if
+// it is not an API key.
+func keyMode(key string) string {
+	parts := strings.SplitN(key, "_", 3)
+	if len(parts) < 3 || (parts[0] != "sk" && parts[0] != "rk" && parts[0] != "pk") {
+		return ""
+	}
+	return parts[1]
+}
+
+// SetRequireTestMode will refuse, when enabled, to send requests with a live
+// mode API key, returning LiveKeyError instead, so that test suites and
+// staging environments cannot charge real cards if they are given a live key
+// by mistake. Requests sent through a Backend are not checked.
+func SetRequireTestMode(enabled bool) {
+	_requireTestMode = enabled
+}
+
+// checkMode returns LiveKeyError if req is authenticated with a live mode key
+// and the scope requires test mode.
+func (s scope) checkMode(req *http.Request) error {
+	if (s.requireTestMode || _requireTestMode) && IsLiveKey(strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")) {
+		return LiveKeyError
+	}
+	return nil
+}
+
+// SetFallbackKey will set a secondary API key that requests Stripe rejects
+// with a 401 are sent again with, so that requests keep succeeding while the
+// key set with SetKey is rolled: set the new key with SetKey and the old one
+// here, or the other way around, until the old key expires. Setting it to an
+// empty string, the default, disables falling back.
+func SetFallbackKey(key string) {
+	_fallbackKey = key
+}
+
+// SetKeyFallbackHook will set a function that is called whenever a request is
+// sent again with the fallback key, e.g. to alert that the primary key was
+// revoked before its replacement was deployed. The function is called from
+// the goroutine that made the request and should not block.
+func SetKeyFallbackHook(f func(*KeyFallback)) {
+	_keyFallback = f
+}
+
+// apiKey returns the API key requests made with the scope authenticate with.
+func (s scope) apiKey() string {
+	if s.key != "" {
+		return s.key
+	}
+	return _key
+}
+
+// fallbackKey returns the key requests authenticated with apiKey are sent
+// again with once rejected.
+func (s scope) fallbackKey() string {
+	if s.key != "" {
+		return s.fallback
+	}
+	return _fallbackKey
+}
+
+// fallBack sends req again with the fallback key if Stripe rejected it as
+// unauthorized with r, which it discards, returning the new request and its
+// response. Requests authenticated with another key, e.g. one set with
+// WithHeader, are not sent again.
+func (s scope) fallBack(req *http.Request, r *http.Response) (*http.Request, *http.Response, error) {
+	key := s.fallbackKey()
+	if r.StatusCode != http.StatusUnauthorized || key == "" || req.Header.Get("Authorization") != "Bearer "+s.apiKey() {
+		return req, r, nil
+	}
+	retry, err := retryRequest(req)
+	if err != nil {
+		return req, r, nil
+	}
+	retry.Header.Set("Authorization", "Bearer "+key)
+	if s.checkMode(retry) != nil {
+		return req, r, nil
+	}
+	discard(r)
+	start := time.Now()
+	res, err := breakerDo(retry, s.client().Do)
+	recordMetrics(retry, res, err, start)
+
+	if _keyFallback != nil {
+		f := &KeyFallback{Method: req.Method, Path: req.URL.Path, RequestID: r.Header.Get("Request-Id"), Err: err}
+		if res != nil {
+			f.Status = res.StatusCode
+		}
+		_keyFallback(f)
+	}
+	return retry, res, err
+}
+
+// PermissionError describes the permission a restricted API key lacks, as
+// named by the Error Stripe returns for a request the key does not allow.
+type PermissionError struct {
+	// The permission that would allow the request, e.g. rak_charge_write.
+	Permission string
+
+	// The resource the permission grants access to, e.g. charge or
+	// payment_intent, and the access it grants, read or write.
+	Resource string
+	Access   string
+
+	// The restricted key, redacted as Stripe returns it, and the account the
+	// request was made on.
+	Key     string
+	Account string
+
+	// The Error Stripe returned.
+	Err *Error
+}
+
+func (e *PermissionError) Error() string {
+	return "stripe: restricted key lacks the " + e.Permission + " permission, for " + e.Access + " access to " + e.Resource
+}
+
+// Unwrap returns the Error Stripe returned.
+func (e *PermissionError) Unwrap() error {
+	return e.Err
+}
+
+// the parts of the message of a permission error, e.g. "The provided key
+// 'rk_test_***Jau' does not have the required permissions for this endpoint
+// on account 'acct_1'. Having the 'rak_charge_write' permission would allow
+// this request to continue."
+var (
+	permissionName    = regexp.MustCompile(`'(rak_[a-z0-9_]+)' permission`)
+	permissionKey     = regexp.MustCompile(`key '([^']+)'`)
+	permissionAccount = regexp.MustCompile(`account '([^']+)'`)
+)
+
+// MissingPermission returns the PermissionError naming the permission the
+// restricted key a request was made with lacks, if err is the Error Stripe
+// returned for it, or nil otherwise.
+func MissingPermission(err error) *PermissionError {
+	e, ok := err.(*Error)
+	if !ok || e.Code != http.StatusForbidden {
+		return nil
+	}
+	m := permissionName.FindStringSubmatch(e.Detail.Message)
+	if m == nil {
+		return nil
+	}
+	p := &PermissionError{Permission: m[1], Err: e}
+	p.Resource = strings.TrimPrefix(p.Permission, "rak_")
+	for _, access := range []string{"read", "write"} {
+		if strings.HasSuffix(p.Resource, "_"+access) {
+			p.Resource, p.Access = strings.TrimSuffix(p.Resource, "_"+access), access
+		}
+	}
+	if m := permissionKey.FindStringSubmatch(e.Detail.Message); m != nil {
+		p.Key = m[1]
+	}
+	if m := permissionAccount.FindStringSubmatch(e.Detail.Message); m != nil {
+		p.Account = m[1]
+	}
+	return p
+}
@@ -0,0 +1,108 @@
+package stripe
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// TestFallbackKey will test that requests rejected as unauthorized are sent
+// again with the fallback key, and that the hook is told.
+func TestFallbackKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer sk_test_new" {
+			w.Header().Set("Request-Id", "req_rejected")
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":{"type":"invalid_request_error","message":"Expired API Key provided"}}`))
+			return
+		}
+		w.Write([]byte(`{"id":"cus_1"}`))
+	}))
+	defer server.Close()
+
+	var fallbacks []*KeyFallback
+	defer SetKeyFallbackHook(nil)
+	SetKeyFallbackHook(func(f *KeyFallback) {
+		fallbacks = append(fallbacks, f)
+	})
+
+	key := _key
+	defer SetKey(key)
+	defer SetFallbackKey("")
+	SetKey("sk_test_old")
+	SetFallbackKey("sk_test_new")
+	if _, err := Customers.With(WithBaseURL(server.URL)).Get("cus_1"); err != nil {
+		t.Fatalf("Expected the fallback key to be used, got Error %s", err.Error())
+	}
+	if len(fallbacks) != 1 || fallbacks[0].RequestID != "req_rejected" || fallbacks[0].Status != 200 || fallbacks[0].Path != "/v1/customers/cus_1" {
+		t.Errorf("Expected the hook to be told of the fallback, got %+v", fallbacks)
+	}
+
+	sc := NewClient("sk_test_old", WithBaseURL(server.URL), WithFallbackKey("sk_test_new"))
+	if _, err := sc.Customers.Get("cus_1"); err != nil {
+		t.Errorf("Expected the client's fallback key to be used, got Error %s", err.Error())
+	}
+	sc = NewClient("sk_test_old", WithBaseURL(server.URL))
+	if _, err := sc.Customers.Get("cus_1"); err == nil {
+		t.Errorf("Expected a client without a fallback key not to use the default one")
+	}
+}
+
+// TestMissingPermission will test that the permission a restricted key lacks
+// is parsed from the error Stripe returns.
+func TestMissingPermission(t *testing.T) {
+	backend := BackendFunc(func(method, path string, params url.Values, v interface{}) error {
+		return parseError(http.StatusForbidden, []byte(`{"error":{"type":"invalid_request_error","message":"The provided key 'rk_test_***6Jau' does not have the required permissions for this endpoint on account 'acct_1'. Having the 'rak_payment_intent_write' permission would allow this request to continue."}}`))
+	})
+	_, err := PaymentIntents.With(WithBackend(backend)).Create(&PaymentIntentParams{Amount: 2000, Currency: USD})
+	p := MissingPermission(err)
+	if p == nil {
+		t.Fatalf("Expected a PermissionError, got %v", err)
+	}
+	if p.Permission != "rak_payment_intent_write" || p.Resource != "payment_intent" || p.Access != "write" || p.Key != "rk_test_***6Jau" || p.Account != "acct_1" {
+		t.Errorf("Expected the missing permission to be parsed, got %+v", p)
+	}
+	var stripeErr *Error
+	if !errors.As(p, &stripeErr) || stripeErr != err {
+		t.Errorf("Expected the PermissionError to wrap the Error")
+	}
+
+	if MissingPermission(&Error{Code: http.StatusForbidden}) != nil || MissingPermission(errors.New("stripe")) != nil {
+		t.Errorf("Expected no PermissionError for other errors")
+	}
+}
+
+// TestRequireTestMode will test that requests with live mode keys are refused
+// while test mode is required.
+func TestRequireTestMode(t *testing.T) {
+	for key, mode := range map[string]string{"sk_live_1": "live", "rk_live_1": "live", "pk_live_1": "live", "sk_test_1": "test", "whsec_live_1": ""} {
+		if IsLiveKey(key) != (mode == "live") || IsTestKey(key) != (mode == "test") {
+			t.Errorf("Expected %s to be a key of mode %q", key, mode)
+		}
+	}
+
+	sent := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent++
+		w.Write([]byte(`{"id":"cus_1"}`))
+	}))
+	defer server.Close()
+
+	sc := NewClient("sk_live_1", WithBaseURL(server.URL), RequireTestMode())
+	if _, err := sc.Customers.Get("cus_1"); err != LiveKeyError {
+		t.Errorf("Expected LiveKeyError, got %v", err)
+	}
+	defer SetRequireTestMode(false)
+	SetRequireTestMode(true)
+	if _, err := NewClient("sk_live_1", WithBaseURL(server.URL)).Customers.Get("cus_1"); err != LiveKeyError {
+		t.Errorf("Expected LiveKeyError, got %v", err)
+	}
+	if _, err := NewClient("sk_test_1", WithBaseURL(server.URL)).Customers.Get("cus_1"); err != nil {
+		t.Errorf("Expected a test mode key to be allowed, got Error %s", err.Error())
+	}
+	if sent != 1 {
+		t.Errorf("Expected only the test mode request to be sent, %d were", sent)
+	}
+}
@@ -0,0 +1,95 @@
+package stripe
+
+import (
+	"errors"
+	"strconv"
+)
+
+// InvalidLevel3TotalError is returned for Level 3 data whose line items and
+// shipping do not add up to the amount of the payment, which Stripe rejects.
+var InvalidLevel3TotalError = errors.New("stripe: level 3 line items and shipping must add up to the amount")
+
+// Level3 is the itemized order data sent with a payment on a corporate or
+// purchasing card, which qualifies it for lower interchange rates. It holds
+// the Level 2 data, the tax and customer reference, along with the line items
+// of Level 3.
+//
+// see https://stripe.com/docs/level3
+type Level3 struct {
+	// The merchant's reference for the order, e.g. its ID. Required.
+	MerchantReference string `form:"merchant_reference"`
+
+	// (Optional) The customer's reference for the order, e.g. their purchase
+	// order number.
+	CustomerReference string `form:"customer_reference"`
+
+	// (Optional) The postal codes the goods are shipped from and to.
+	ShippingFromZip    string `form:"shipping_from_zip"`
+	ShippingAddressZip string `form:"shipping_address_zip"`
+
+	// (Optional) The cost of shipping, in the smallest unit of the currency.
+	ShippingAmount int64 `form:"shipping_amount"`
+
+	LineItems []*Level3LineItem `form:"line_items"`
+}
+
+// Level3LineItem is an item of the order described by Level3. Amounts are in
+// the smallest unit of the currency.
+type Level3LineItem struct {
+	// The merchant's code identifying the product, of up to 12 characters.
+	ProductCode string `form:"product_code"`
+
+	// A description of the product, of up to 26 characters.
+	ProductDescription string `form:"product_description"`
+
+	UnitCost int64 `form:"unit_cost"`
+	Quantity int64 `form:"quantity"`
+
+	// (Optional) The tax charged and the discount given on the item as a
+	// whole, rather than per unit.
+	TaxAmount      int64 `form:"tax_amount"`
+	DiscountAmount int64 `form:"discount_amount"`
+}
+
+// Total returns the amount the order adds up to, which must equal the amount
+// of the payment: the cost of each item with its tax and without its
+// discount, plus shipping.
+func (l *Level3) Total() int64 {
+	total := l.ShippingAmount
+	for _, item := range l.LineItems {
+		total += item.UnitCost*item.Quantity + item.TaxAmount - item.DiscountAmount
+	}
+	return total
+}
+
+// validateLevel3 checks the Level 3 data of a payment of the given amount
+// before it is made, returning a ValidationError if Stripe will reject it.
+func validateLevel3(l *Level3, amount int64) error {
+	var errs ValidationError
+	errs.checkLevel3(l, amount)
+	return errs.err()
+}
+
+func (e *ValidationError) checkLevel3(l *Level3, amount int64) {
+	if l == nil {
+		return
+	}
+	if l.MerchantReference == "" {
+		e.add("level3[merchant_reference]", RequiredParamError)
+	}
+	for i, item := range l.LineItems {
+		field := "level3[line_items][" + strconv.Itoa(i) + "]"
+		if item.ProductCode == "" {
+			e.add(field+"[product_code]", RequiredParamError)
+		}
+		if item.ProductDescription == "" {
+			e.add(field+"[product_description]", RequiredParamError)
+		}
+		if item.Quantity < 0 {
+			e.add(field+"[quantity]", InvalidQuantityError)
+		}
+	}
+	if len(l.LineItems) > 0 && l.Total() != amount {
+		e.add("level3[line_items]", InvalidLevel3TotalError)
+	}
+}
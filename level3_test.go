@@ -0,0 +1,49 @@
+package stripe
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+)
+
+// TestLevel3 will test that Level 3 data is sent with a payment, and that
+// data whose line items do not add up to the amount fails before a request
+// is made.
+func TestLevel3(t *testing.T) {
+	var sent url.Values
+	backend := BackendFunc(func(method, path string, params url.Values, v interface{}) error {
+		sent = params
+		return json.Unmarshal([]byte(`{"id":"ch_1"}`), v)
+	})
+	level3 := &Level3{
+		MerchantReference:  "order_6735",
+		CustomerReference:  "PO-1234",
+		ShippingAddressZip: "94111",
+		ShippingAmount:     500,
+		LineItems: []*Level3LineItem{
+			{ProductCode: "SKU-1", ProductDescription: "Printer paper", UnitCost: 1000, Quantity: 3, TaxAmount: 240},
+			{ProductCode: "SKU-2", ProductDescription: "Toner", UnitCost: 5000, Quantity: 1, DiscountAmount: 500},
+		},
+	}
+	if total := level3.Total(); total != 8240 {
+		t.Fatalf("Expected the order to total 8240, got %d", total)
+	}
+
+	if _, err := Charges.With(WithBackend(backend)).Create(&ChargeParams{Amount: 8240, Currency: USD, Customer: String("cus_1"), Level3: level3}); err != nil {
+		t.Fatal(err)
+	}
+	if sent.Get("level3[merchant_reference]") != "order_6735" || sent.Get("level3[line_items][1][discount_amount]") != "500" || sent.Get("level3[shipping_amount]") != "500" {
+		t.Errorf("Expected the Level 3 data to be sent, got %v", sent)
+	}
+
+	sent = nil
+	_, err := PaymentIntents.With(WithBackend(backend)).Create(&PaymentIntentParams{Amount: 8000, Currency: USD, Level3: level3})
+	if !errors.Is(err, InvalidLevel3TotalError) || sent != nil {
+		t.Errorf("Expected InvalidLevel3TotalError before the request, got %v", err)
+	}
+	err = (&ChargeParams{Amount: 8240, Currency: USD, Customer: String("cus_1"), Level3: &Level3{ShippingAmount: 500, LineItems: level3.LineItems}}).Validate()
+	if errs, ok := err.(ValidationError); !ok || len(errs) != 1 || errs[0].Field != "level3[merchant_reference]" {
+		t.Errorf("Expected the merchant reference to be required, got %v", err)
+	}
+}
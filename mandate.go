@@ -0,0 +1,101 @@
+package stripe
+
+import "net/url"
+
+// Mandate Statuses
+const (
+	MandateActive   = "active"
+	MandateInactive = "inactive"
+	MandatePending  = "pending"
+)
+
+// Bacs Mandate Network Statuses, reported by the bank as the mandate is set
+// up and while it is in effect
+const (
+	BACSMandatePending  = "pending"
+	BACSMandateAccepted = "accepted"
+	BACSMandateRefused  = "refused"
+	BACSMandateRevoked  = "revoked"
+)
+
+// Mandate records the permission a customer gave to debit their bank
+// account. Bank debits can only be made while it is active; changes to its
+// status, such as Bacs mandates being accepted or revoked by the bank, are
+// sent as mandate.updated events.
+//
+// see https://stripe.com/docs/api/mandates/object
+type Mandate struct {
+	APIResource
+
+	ID            string `json:"id"`
+	Status        string `json:"status"`
+	Type          string `json:"type"`
+	PaymentMethod string `json:"payment_method"`
+	Livemode      bool   `json:"livemode"`
+
+	// How and when the customer accepted the mandate.
+	CustomerAcceptance struct {
+		Type       string    `json:"type"`
+		AcceptedAt *UnixTime `json:"accepted_at,omitempty"`
+		Online     *struct {
+			IPAddress string `json:"ip_address"`
+			UserAgent string `json:"user_agent"`
+		} `json:"online,omitempty"`
+	} `json:"customer_acceptance"`
+
+	PaymentMethodDetails struct {
+		Type        string            `json:"type"`
+		BACSDebit   *MandateBACSDebit `json:"bacs_debit,omitempty"`
+		AUBECSDebit *MandateDocument  `json:"au_becs_debit,omitempty"`
+		SEPADebit   *MandateSEPADebit `json:"sepa_debit,omitempty"`
+	} `json:"payment_method_details"`
+}
+
+// MandateDocument links to the mandate the customer accepted, which must be
+// shown to them on request.
+type MandateDocument struct {
+	URL string `json:"url"`
+}
+
+// MandateBACSDebit describes a Bacs Direct Debit mandate, which the bank must
+// accept before the account is debited.
+type MandateBACSDebit struct {
+	MandateDocument
+
+	// One of the Bacs Mandate Network Statuses, e.g. BACSMandateAccepted.
+	NetworkStatus string `json:"network_status"`
+
+	// The reference shown on the customer's statement and in the
+	// notification of the mandate they are sent.
+	Reference string `json:"reference"`
+
+	// Why the mandate was revoked, if it was, e.g. "account_closed".
+	RevocationReason string `json:"revocation_reason,omitempty"`
+}
+
+// MandateSEPADebit describes a SEPA Direct Debit mandate.
+type MandateSEPADebit struct {
+	MandateDocument
+
+	// The reference of the mandate, shown to the customer's bank.
+	Reference string `json:"reference"`
+}
+
+// MandateClient encapsulates operations for querying mandates using the
+// Stripe REST API.
+type MandateClient struct{ scope }
+
+// With returns a MandateClient configured with the given options.
+func (c MandateClient) With(opts ...ClientOption) MandateClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Mandate with the given ID, e.g. that of a bank debit's
+// PaymentMethodDetails.
+//
+// see https://stripe.com/docs/api/mandates/retrieve
+func (c MandateClient) Get(id string) (*Mandate, error) {
+	res := &Mandate{}
+	return res, c.query("GET", "/mandates/"+url.QueryEscape(id), nil, res)
+}
@@ -0,0 +1,209 @@
+package stripe
+
+import "net/url"
+
+// Meter Statuses
+const (
+	MeterActive   = "active"
+	MeterInactive = "inactive"
+)
+
+// Meter Aggregation Formulas, which the usage of a billing period is
+// calculated with from its meter events.
+const (
+	MeterSum   = "sum"
+	MeterCount = "count"
+	MeterLast  = "last"
+)
+
+// Meter represents a billing meter, which aggregates the meter events of each
+// customer into the usage a metered Price bills them for. Meters supersede
+// usage records on Subscription Items.
+//
+// see https://docs.stripe.com/api/billing/meter/object
+type Meter struct {
+	APIResource
+
+	ID                 string                  `json:"id"`
+	Created            UnixTime                `json:"created"`
+	DisplayName        string                  `json:"display_name"`
+	EventName          string                  `json:"event_name"`
+	EventTimeWindow    string                  `json:"event_time_window,omitempty"`
+	Status             string                  `json:"status"`
+	CustomerMapping    MeterCustomerMapping    `json:"customer_mapping"`
+	DefaultAggregation MeterDefaultAggregation `json:"default_aggregation"`
+	ValueSettings      MeterValueSettings      `json:"value_settings"`
+	StatusTransitions  MeterStatusTransitions  `json:"status_transitions"`
+	Updated            UnixTime                `json:"updated"`
+	Livemode           bool                    `json:"livemode"`
+}
+
+// MeterCustomerMapping names the key of a meter event's payload holding the
+// ID of the customer whose usage it reports.
+type MeterCustomerMapping struct {
+	EventPayloadKey string `json:"event_payload_key" form:"event_payload_key"`
+	Type            string `json:"type" form:"type"`
+}
+
+// MeterDefaultAggregation is how a Meter aggregates its events' values.
+type MeterDefaultAggregation struct {
+	Formula string `json:"formula"`
+}
+
+// MeterValueSettings names the key of a meter event's payload holding the
+// usage it reports.
+type MeterValueSettings struct {
+	EventPayloadKey string `json:"event_payload_key"`
+}
+
+// MeterStatusTransitions records when a Meter was deactivated.
+type MeterStatusTransitions struct {
+	DeactivatedAt *UnixTime `json:"deactivated_at,omitempty"`
+}
+
+// MeterEventSummary is the aggregated usage of a customer reported to a Meter
+// within a time window.
+type MeterEventSummary struct {
+	ID              string   `json:"id"`
+	AggregatedValue float64  `json:"aggregated_value"`
+	StartTime       UnixTime `json:"start_time"`
+	EndTime         UnixTime `json:"end_time"`
+	Meter           string   `json:"meter"`
+	Livemode        bool     `json:"livemode"`
+}
+
+// MeterParams encapsulates options for creating and updating Meters. Only
+// DisplayName can be updated.
+type MeterParams struct {
+	Params
+
+	// The name of the Meter shown in the Dashboard and on invoices.
+	DisplayName string `form:"display_name"`
+
+	// The name of the meter events the Meter aggregates.
+	EventName string `form:"event_name"`
+
+	// The formula the Meter aggregates its events' values with, e.g.
+	// MeterSum.
+	Formula string `form:"default_aggregation[formula]"`
+
+	// (Optional) How events are mapped to customers, by the customer ID in
+	// the event payload: its Type is always "by_id", and its EventPayloadKey
+	// defaults to "stripe_customer_id".
+	CustomerMapping *MeterCustomerMapping `form:"customer_mapping"`
+
+	// (Optional) The key of the event payload holding the usage. Defaults to
+	// "value".
+	ValuePayloadKey *string `form:"value_settings[event_payload_key]"`
+
+	// (Optional) The window, "day" or "hour", the Meter's events are
+	// aggregated in when summarized. Defaults to no window.
+	EventTimeWindow *string `form:"event_time_window"`
+}
+
+// MeterListParams encapsulates options for filtering a list of Meters.
+type MeterListParams struct {
+	ListParams
+
+	// (Optional) Only return meters with the given status, e.g. MeterActive.
+	Status string `form:"status"`
+}
+
+// MeterEventSummaryListParams encapsulates options for listing the usage a
+// customer reported to a Meter.
+type MeterEventSummaryListParams struct {
+	ListParams
+
+	// The ID of the customer whose usage is summarized.
+	Customer string `form:"customer"`
+
+	// The time range of the usage summarized, which must be aligned with the
+	// Meter's event time window, or ValueGroupingWindow if set.
+	StartTime UnixTime `form:"start_time"`
+	EndTime   UnixTime `form:"end_time"`
+
+	// (Optional) Summarize the usage of each "day" or "hour" within the range
+	// rather than of the range as a whole.
+	ValueGroupingWindow string `form:"value_grouping_window"`
+}
+
+// MeterClient encapsulates operations for creating, updating, deactivating and
+// listing billing Meters. Meter events are reported with a MeterEventClient.
+type MeterClient struct{ scope }
+
+// With returns a MeterClient configured with the given options.
+func (c MeterClient) With(opts ...ClientOption) MeterClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a Meter.
+//
+// see https://docs.stripe.com/api/billing/meter/create
+func (c MeterClient) Create(params *MeterParams) (*Meter, error) {
+	res := &Meter{}
+	return res, c.query("POST", "/billing/meters", formValues(params), res)
+}
+
+// Retrieves the Meter with the given ID.
+//
+// see https://docs.stripe.com/api/billing/meter/retrieve
+func (c MeterClient) Get(id string) (*Meter, error) {
+	res := &Meter{}
+	return res, c.query("GET", "/billing/meters/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the display name of the Meter with the given ID; the rest of a
+// Meter cannot be changed once it is created.
+//
+// see https://docs.stripe.com/api/billing/meter/update
+func (c MeterClient) Update(id string, params *MeterParams) (*Meter, error) {
+	res := &Meter{}
+	return res, c.query("POST", "/billing/meters/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Deactivates the Meter with the given ID, so that meter events sent to it
+// are rejected. Meters cannot be deleted.
+//
+// see https://docs.stripe.com/api/billing/meter/deactivate
+func (c MeterClient) Deactivate(id string) (*Meter, error) {
+	return c.action(id, "deactivate")
+}
+
+// Reactivates the deactivated Meter with the given ID.
+//
+// see https://docs.stripe.com/api/billing/meter/reactivate
+func (c MeterClient) Reactivate(id string) (*Meter, error) {
+	return c.action(id, "reactivate")
+}
+
+// Returns a list of Meters matching the params.
+//
+// see https://docs.stripe.com/api/billing/meter/list
+func (c MeterClient) List(params *MeterListParams) ([]*Meter, bool, error) {
+	res := struct {
+		ListObject
+		Data []*Meter
+	}{}
+	err := c.query("GET", "/billing/meters", formValues(params), &res)
+	return res.Data, res.More, err
+}
+
+// Returns the usage of a customer reported to the Meter with the given ID,
+// aggregated by its formula.
+//
+// see https://docs.stripe.com/api/billing/meter-event-summary/list
+func (c MeterClient) EventSummaries(id string, params *MeterEventSummaryListParams) ([]*MeterEventSummary, bool, error) {
+	res := struct {
+		ListObject
+		Data []*MeterEventSummary
+	}{}
+	path := "/billing/meters/" + url.QueryEscape(id) + "/event_summaries"
+	err := c.query("GET", path, formValues(params), &res)
+	return res.Data, res.More, err
+}
+
+func (c MeterClient) action(id, action string) (*Meter, error) {
+	res := &Meter{}
+	return res, c.query("POST", "/billing/meters/"+url.QueryEscape(id)+"/"+action, nil, res)
+}
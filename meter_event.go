@@ -0,0 +1,167 @@
+package stripe
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MeterEventSession authenticates streaming meter events to Stripe for a
+// limited time.
+//
+// see https://docs.stripe.com/api/v2/billing-meter-stream
+type MeterEventSession struct {
+	APIResource
+
+	ID                  string    `json:"id"`
+	AuthenticationToken string    `json:"authentication_token"`
+	Created             time.Time `json:"created"`
+	ExpiresAt           time.Time `json:"expires_at"`
+	Livemode            bool      `json:"livemode"`
+}
+
+// Expired reports whether the session can no longer be used to stream meter
+// events, and a new one must be created.
+func (s *MeterEventSession) Expired() bool {
+	return !_clock.Now().Before(s.ExpiresAt)
+}
+
+// MeterEvent reports usage of a billing meter.
+type MeterEvent struct {
+	// The name of the meter event, as configured on the meter.
+	EventName string `json:"event_name"`
+
+	// (Optional) A unique identifier for the event, so that sending it again
+	// has no effect. Generated by Stripe if empty.
+	Identifier string `json:"identifier,omitempty"`
+
+	// The usage, keyed by the meter's value and customer mapping keys, e.g.
+	// {"stripe_customer_id": "cus_1", "value": "25"}.
+	Payload map[string]string `json:"payload"`
+
+	// (Optional) When the usage occurred. Defaults to when it is received.
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// MeterEventClient encapsulates operations for reporting meter events to
+// Stripe, one at a time with Create, or streaming high volumes of them to
+// Stripe's meter event service, which is served from a host of its own,
+// MeterEventsService.
+type MeterEventClient struct{ scope }
+
+// With returns a MeterEventClient configured with the given options.
+func (c MeterEventClient) With(opts ...ClientOption) MeterEventClient {
+	c.apply(opts)
+	return c
+}
+
+// Create reports a meter event, returning it with the Identifier Stripe
+// generated if it had none. Meter events can no longer be retrieved once
+// created; their usage is listed by the Meter's EventSummaries.
+//
+// see https://docs.stripe.com/api/billing/meter-event/create
+func (c MeterEventClient) Create(event *MeterEvent) (*MeterEvent, error) {
+	values := url.Values{"event_name": {event.EventName}}
+	if event.Identifier != "" {
+		values.Add("identifier", event.Identifier)
+	}
+	for key, value := range event.Payload {
+		values.Add("payload["+key+"]", value)
+	}
+	if event.Timestamp != nil {
+		values.Add("timestamp", strconv.FormatInt(event.Timestamp.Unix(), 10))
+	}
+
+	// unlike the meter event service, /v1 returns timestamps as Unix seconds
+	res := struct {
+		EventName  string            `json:"event_name"`
+		Identifier string            `json:"identifier"`
+		Payload    map[string]string `json:"payload"`
+		Timestamp  UnixTime          `json:"timestamp"`
+	}{}
+	if err := c.query("POST", "/billing/meter_events", values, &res); err != nil {
+		return nil, err
+	}
+	created := &MeterEvent{EventName: res.EventName, Identifier: res.Identifier, Payload: res.Payload}
+	if !res.Timestamp.IsZero() {
+		created.Timestamp = &res.Timestamp.Time
+	}
+	return created, nil
+}
+
+// Creates a MeterEventSession, whose token authenticates streaming meter
+// events until it expires.
+//
+// see https://docs.stripe.com/api/v2/billing-meter-stream/session/create
+func (c MeterEventClient) CreateSession() (*MeterEventSession, error) {
+	res := &MeterEventSession{}
+	return res, c.queryV2("POST", "/billing/meter_event_session", nil, struct{}{}, res)
+}
+
+// Stream sends the meter events to the meter event service, authenticating
+// with the session's token rather than the API key. Events are processed
+// asynchronously, so invalid events are reported by events of their own
+// rather than an error.
+//
+// see https://docs.stripe.com/api/v2/billing-meter-stream/create
+func (c MeterEventClient) Stream(session *MeterEventSession, events []*MeterEvent) error {
+	s := c.scope
+	s.headers = s.withHeader("Authorization", "Bearer "+session.AuthenticationToken)
+	body := struct {
+		Events []*MeterEvent `json:"events"`
+	}{events}
+	res := struct{}{}
+	return s.doV2(s.serviceURL(MeterEventsService), "POST", "/billing/meter_event_stream", nil, body, &res)
+}
+
+// NewStream returns a MeterEventStream that streams meter events with the
+// client.
+func (c MeterEventClient) NewStream() *MeterEventStream {
+	return &MeterEventStream{client: c}
+}
+
+// MeterEventStream streams meter events as Stream does, creating the
+// MeterEventSession they are authenticated with when the first events are
+// sent, and a new one whenever it expires. It is safe for concurrent use.
+type MeterEventStream struct {
+	client MeterEventClient
+
+	mu      sync.Mutex
+	session *MeterEventSession
+}
+
+// Send streams the events. If the meter event service rejects the session
+// before it was due to expire, e.g. as the clocks disagree, a new session is
+// created and the events are sent once more.
+func (s *MeterEventStream) Send(events []*MeterEvent) error {
+	session, err := s.currentSession(nil)
+	if err != nil {
+		return err
+	}
+	err = s.client.Stream(session, events)
+	if e, ok := err.(*Error); ok && e.Code == http.StatusUnauthorized {
+		if session, err = s.currentSession(session); err != nil {
+			return err
+		}
+		err = s.client.Stream(session, events)
+	}
+	return err
+}
+
+// currentSession returns the stream's session, creating a new one if there is
+// none, it has expired, or it is the rejected session.
+func (s *MeterEventStream) currentSession(rejected *MeterEventSession) (*MeterEventSession, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.session != nil && s.session != rejected && !s.session.Expired() {
+		return s.session, nil
+	}
+	session, err := s.client.CreateSession()
+	if err != nil {
+		return nil, err
+	}
+	s.session = session
+	return session, nil
+}
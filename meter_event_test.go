@@ -0,0 +1,128 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestServiceURLs will test that each service's requests are sent to the base
+// URL set for it, and that meter events are streamed to their own service
+// with the session's token.
+func TestServiceURLs(t *testing.T) {
+	var hosts []string
+	handler := func(name string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hosts = append(hosts, name+" "+r.URL.Path)
+			switch r.URL.Path {
+			case "/v2/billing/meter_event_session":
+				w.Write([]byte(`{"id":"mes_1","authentication_token":"tok_session","expires_at":"2099-01-01T00:00:00Z"}`))
+			case "/v2/billing/meter_event_stream":
+				var body struct {
+					Events []*MeterEvent `json:"events"`
+				}
+				json.NewDecoder(r.Body).Decode(&body)
+				if r.Header.Get("Authorization") != "Bearer tok_session" || len(body.Events) != 1 || body.Events[0].Payload["value"] != "25" {
+					t.Errorf("Expected an event authenticated with the session, got %s and %v", r.Header.Get("Authorization"), body.Events)
+				}
+				w.Write([]byte(`{}`))
+			default:
+				w.Write([]byte(`{"id":"obj_1"}`))
+			}
+		})
+	}
+	api := httptest.NewServer(handler("api"))
+	defer api.Close()
+	files := httptest.NewServer(handler("files"))
+	defer files.Close()
+	connect := httptest.NewServer(handler("connect"))
+	defer connect.Close()
+	meters := httptest.NewServer(handler("meters"))
+	defer meters.Close()
+
+	Files.With(WithBaseURL(api.URL), WithServiceURL(FilesService, files.URL)).Create("dispute_evidence", "receipt.pdf", strings.NewReader("%PDF"))
+	Charges.With(WithBaseURL(api.URL), WithServiceURL(FilesService, files.URL)).Get("ch_1")
+	OAuth.With(WithServiceURL(ConnectService, connect.URL)).Token("ac_1")
+
+	events := MeterEvents.With(WithBaseURL(api.URL), WithServiceURL(MeterEventsService, meters.URL))
+	session, err := events.CreateSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.Expired() {
+		t.Error("Expected the session not to have expired")
+	}
+	err = events.Stream(session, []*MeterEvent{{EventName: "api_requests", Payload: map[string]string{"stripe_customer_id": "cus_1", "value": "25"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"files /v1/files", "api /v1/charges/ch_1", "connect /oauth/token", "api /v2/billing/meter_event_session", "meters /v2/billing/meter_event_stream"}
+	if strings.Join(hosts, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected requests %v, got %v", want, hosts)
+	}
+}
+
+// TestMeterEventStream will test that a stream creates its session when it
+// first sends events, and a new one once the session is rejected.
+func TestMeterEventStream(t *testing.T) {
+	var sessions, streamed int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/billing/meter_event_session":
+			sessions++
+			w.Write([]byte(`{"id":"mes_` + strconv.Itoa(sessions) + `","authentication_token":"tok_` + strconv.Itoa(sessions) + `","expires_at":"2099-01-01T00:00:00Z"}`))
+		case "/v2/billing/meter_event_stream":
+			if r.Header.Get("Authorization") != "Bearer tok_2" && streamed > 0 {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"error":{"type":"invalid_request_error","message":"Session expired"}}`))
+				return
+			}
+			streamed++
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	stream := MeterEvents.With(WithBaseURL(server.URL), WithServiceURL(MeterEventsService, server.URL)).NewStream()
+	event := []*MeterEvent{{EventName: "api_requests", Payload: map[string]string{"stripe_customer_id": "cus_1", "value": "1"}}}
+	for i := 0; i < 3; i++ {
+		if err := stream.Send(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if sessions != 2 || streamed != 3 {
+		t.Errorf("Expected 3 sends with 2 sessions, got %d sends with %d sessions", streamed, sessions)
+	}
+}
+
+// TestCreateMeterEvent will test that a single meter event is reported with
+// its payload and timestamp as form parameters.
+func TestCreateMeterEvent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.URL.Path != "/v1/billing/meter_events" || r.Form.Get("payload[stripe_customer_id]") != "cus_1" || r.Form.Get("timestamp") != "1700000000" {
+			t.Errorf("Expected a meter event for cus_1, got %s %v", r.URL.Path, r.Form)
+		}
+		w.Write([]byte(`{"object":"billing.meter_event","event_name":"api_requests","identifier":"evt_generated",
+			"payload":{"stripe_customer_id":"cus_1","value":"25"},"timestamp":1700000000}`))
+	}))
+	defer server.Close()
+
+	at := time.Unix(1700000000, 0)
+	event, err := MeterEvents.With(WithBaseURL(server.URL)).Create(&MeterEvent{
+		EventName: "api_requests",
+		Payload:   map[string]string{"stripe_customer_id": "cus_1", "value": "25"},
+		Timestamp: &at,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Identifier != "evt_generated" || event.Timestamp == nil || !event.Timestamp.Equal(at) {
+		t.Errorf("Expected the event with its generated identifier, got %+v", event)
+	}
+}
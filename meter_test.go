@@ -0,0 +1,57 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestMeters will test that a Meter is created with its customer and value
+// mappings, deactivated, and its usage summarized for a customer.
+func TestMeters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v1/billing/meters":
+			if r.Form.Get("event_name") != "api_requests" || r.Form.Get("default_aggregation[formula]") != MeterSum ||
+				r.Form.Get("customer_mapping[event_payload_key]") != "customer" || r.Form.Get("customer_mapping[type]") != "by_id" {
+				t.Errorf("Expected a meter summing api_requests by customer, got %v", r.Form)
+			}
+			w.Write([]byte(`{"id":"mtr_1","display_name":"API requests","event_name":"api_requests","status":"active",
+				"customer_mapping":{"event_payload_key":"customer","type":"by_id"},"default_aggregation":{"formula":"sum"},
+				"value_settings":{"event_payload_key":"value"}}`))
+		case "POST /v1/billing/meters/mtr_1/deactivate":
+			w.Write([]byte(`{"id":"mtr_1","status":"inactive","status_transitions":{"deactivated_at":1700000000}}`))
+		case "GET /v1/billing/meters/mtr_1/event_summaries":
+			if r.Form.Get("customer") != "cus_1" || r.Form.Get("start_time") != "1700000000" || r.Form.Get("value_grouping_window") != "day" {
+				t.Errorf("Expected the daily usage of cus_1, got %v", r.Form)
+			}
+			w.Write([]byte(`{"has_more":false,"data":[{"id":"mtrusg_1","aggregated_value":125,"meter":"mtr_1"}]}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	meters := Meters.With(WithBaseURL(server.URL))
+
+	meter, err := meters.Create(&MeterParams{DisplayName: "API requests", EventName: "api_requests", Formula: MeterSum, CustomerMapping: &MeterCustomerMapping{EventPayloadKey: "customer", Type: "by_id"}})
+	if err != nil || meter.CustomerMapping.EventPayloadKey != "customer" || meter.ValueSettings.EventPayloadKey != "value" {
+		t.Fatalf("Expected the meter to be created, got %+v, %v", meter, err)
+	}
+	meter, err = meters.Deactivate("mtr_1")
+	if err != nil || meter.Status != MeterInactive || meter.StatusTransitions.DeactivatedAt == nil {
+		t.Fatalf("Expected the meter to be deactivated, got %+v, %v", meter, err)
+	}
+
+	start := time.Unix(1700000000, 0)
+	summaries, _, err := meters.EventSummaries("mtr_1", &MeterEventSummaryListParams{
+		Customer:            "cus_1",
+		StartTime:           NewUnixTime(start),
+		EndTime:             NewUnixTime(start.Add(24 * time.Hour)),
+		ValueGroupingWindow: "day",
+	})
+	if err != nil || len(summaries) != 1 || summaries[0].AggregatedValue != 125 {
+		t.Errorf("Expected the usage of cus_1, got %+v, %v", summaries, err)
+	}
+}
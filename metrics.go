@@ -0,0 +1,79 @@
+package stripe
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MetricsRecorder receives metrics about each request made to the Stripe API,
+// so that they can be exported to a system such as Prometheus or statsd. Each
+// request is labelled with its resource, e.g. "customers.sources", its method,
+// and its status class: "2xx", "4xx", "5xx", or "error" if no response was
+// received. Implementations must be safe for concurrent use.
+type MetricsRecorder interface {
+	// IncCounter increments the named counter, stripe_requests_total.
+	IncCounter(name string, labels map[string]string)
+
+	// ObserveLatency records a duration for the named histogram,
+	// stripe_request_duration.
+	ObserveLatency(name string, d time.Duration, labels map[string]string)
+}
+
+// the MetricsRecorder that receives metrics about each request, if any
+var _metrics MetricsRecorder
+
+// SetMetricsRecorder will set the MetricsRecorder that receives metrics about
+// each request made to the Stripe API. Setting it to nil, the default, stops
+// recording metrics.
+func SetMetricsRecorder(m MetricsRecorder) {
+	_metrics = m
+}
+
+// recordMetrics reports a request that started at start, and was answered
+// with r or failed with err, to the MetricsRecorder.
+func recordMetrics(req *http.Request, r *http.Response, err error, start time.Time) {
+	if _metrics == nil {
+		return
+	}
+	status := "error"
+	if err == nil {
+		status = strconv.Itoa(r.StatusCode/100) + "xx"
+	}
+	labels := map[string]string{
+		"resource": ResourceName(req.URL.Path),
+		"method":   req.Method,
+		"status":   status,
+	}
+	_metrics.IncCounter("stripe_requests_total", labels)
+	_metrics.ObserveLatency("stripe_request_duration", time.Since(start), labels)
+}
+
+// ResourceName returns the name of the resource a request to the given path
+// acts on, leaving out the API version and object IDs:
+// "/v1/customers/cus_1/sources" is "customers.sources", and "/v1/issuing/cards"
+// is "issuing.cards".
+func ResourceName(path string) string {
+	var parts []string
+	for _, part := range strings.Split(strings.Trim(path, "/"), "/") {
+		if part == "" || isVersion(part) || isID(part) {
+			continue
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// isVersion reports whether part is an API version prefix, such as v1.
+func isVersion(part string) bool {
+	return len(part) >= 2 && part[0] == 'v' && strings.Trim(part[1:], "0123456789") == ""
+}
+
+// isID reports whether part is an object ID, such as cus_1, rather than the
+// name of a resource, which has only lower case letters and underscores. IDs
+// chosen by the caller that look like names, such as a plan named gold, are
+// kept.
+func isID(part string) bool {
+	return strings.Trim(part, "abcdefghijklmnopqrstuvwxyz_") != ""
+}
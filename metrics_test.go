@@ -0,0 +1,71 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testMetrics struct {
+	mu        sync.Mutex
+	counts    map[string]int
+	latencies []time.Duration
+}
+
+func (m *testMetrics) IncCounter(name string, labels map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counts[name+" "+labels["method"]+" "+labels["resource"]+" "+labels["status"]]++
+}
+
+func (m *testMetrics) ObserveLatency(name string, d time.Duration, labels map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.latencies = append(m.latencies, d)
+}
+
+// TestMetricsRecorder will test that each request is counted and timed,
+// labelled with its resource, method and status class.
+func TestMetricsRecorder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/customers/cus_404" {
+			w.WriteHeader(404)
+		}
+		w.Write([]byte(`{"id":"cus_1"}`))
+	}))
+	defer server.Close()
+	m := &testMetrics{counts: map[string]int{}}
+	defer SetMetricsRecorder(nil)
+	SetMetricsRecorder(m)
+
+	Customers.With(WithBaseURL(server.URL)).Get("cus_1")
+	Customers.With(WithBaseURL(server.URL)).Get("cus_2")
+	Customers.With(WithBaseURL(server.URL)).Get("cus_404")
+
+	if m.counts["stripe_requests_total GET customers 2xx"] != 2 || m.counts["stripe_requests_total GET customers 4xx"] != 1 {
+		t.Errorf("Expected 2 successful and 1 failed customer retrievals, got %v", m.counts)
+	}
+	if len(m.latencies) != 3 {
+		t.Errorf("Expected 3 latencies, got %d", len(m.latencies))
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	tests := map[string]string{
+		"/v1/charges":                       "charges",
+		"/v1/customers/cus_1/sources":       "customers.sources",
+		"/v1/issuing/cards/ic_1":            "issuing.cards",
+		"/v1/invoices/in_1/pay":             "invoices.pay",
+		"/v1/plans/gold2":                   "plans",
+		"/v1/plans/gold":                    "plans.gold",
+		"/v2/core/events":                   "core.events",
+		"/v1/payment_method_configurations": "payment_method_configurations",
+	}
+	for path, want := range tests {
+		if got := ResourceName(path); got != want {
+			t.Errorf("Expected resource %s for %s, got %s", want, path, got)
+		}
+	}
+}
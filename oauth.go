@@ -0,0 +1,119 @@
+package stripe
+
+import "net/url"
+
+// OAuth Scopes
+const (
+	ScopeReadOnly  = "read_only"
+	ScopeReadWrite = "read_write"
+)
+
+// OAuthToken holds the credentials of a Standard account that authorized your
+// platform through Connect OAuth.
+//
+// see https://stripe.com/docs/connect/oauth-reference#post-token
+type OAuthToken struct {
+	APIResource
+
+	AccessToken          string `json:"access_token"`
+	RefreshToken         string `json:"refresh_token"`
+	TokenType            string `json:"token_type"`
+	Scope                string `json:"scope"`
+	Livemode             bool   `json:"livemode"`
+	StripeUserID         string `json:"stripe_user_id"`
+	StripePublishableKey string `json:"stripe_publishable_key"`
+}
+
+// OAuthError encapsulates an error returned by the Connect OAuth endpoints,
+// which use a different format than the rest of the Stripe REST API.
+type OAuthError struct {
+	Type        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e *OAuthError) Error() string {
+	return e.Description
+}
+
+// OAuthClient encapsulates the Connect OAuth operations used to onboard
+// Standard accounts.
+type OAuthClient struct{ scope }
+
+// With returns an OAuthClient configured with the given options. Its
+// requests are sent to Connect OAuth, whose base URL is set with
+// WithServiceURL(ConnectService, url) rather than WithBaseURL.
+func (c OAuthClient) With(opts ...ClientOption) OAuthClient {
+	c.apply(opts)
+	return c
+}
+
+// AuthorizeURL returns the URL a user should be redirected to in order to
+// connect their Stripe account to your platform. The state is returned to
+// your redirect URI and should be used to prevent CSRF.
+//
+// see https://stripe.com/docs/connect/oauth-reference#get-authorize
+func (c OAuthClient) AuthorizeURL(clientID, scope, state string) string {
+	values := url.Values{
+		"response_type": {"code"},
+		"client_id":     {clientID},
+	}
+	if scope != "" {
+		values.Add("scope", scope)
+	}
+	if state != "" {
+		values.Add("state", state)
+	}
+	return c.serviceURL(ConnectService) + "/oauth/authorize?" + values.Encode()
+}
+
+// Exchanges the authorization code returned to your redirect URI for the
+// connected account's credentials.
+//
+// see https://stripe.com/docs/connect/oauth-reference#post-token
+func (c OAuthClient) Token(code string) (*OAuthToken, error) {
+	values := url.Values{
+		"grant_type": {"authorization_code"},
+		"code":       {code},
+	}
+	res := &OAuthToken{}
+	return res, c.post("/oauth/token", values, res)
+}
+
+// Uses a refresh token to obtain a new access token, optionally with a more
+// restrictive scope.
+//
+// see https://stripe.com/docs/connect/oauth-reference#post-token
+func (c OAuthClient) Refresh(refreshToken, scope string) (*OAuthToken, error) {
+	values := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	}
+	if scope != "" {
+		values.Add("scope", scope)
+	}
+	res := &OAuthToken{}
+	return res, c.post("/oauth/token", values, res)
+}
+
+// Revokes your platform's access to the connected account with the given ID.
+//
+// see https://stripe.com/docs/connect/oauth-reference#post-deauthorize
+func (c OAuthClient) Deauthorize(clientID, accountID string) error {
+	values := url.Values{
+		"client_id":      {clientID},
+		"stripe_user_id": {accountID},
+	}
+	res := struct {
+		StripeUserID string `json:"stripe_user_id"`
+	}{}
+	return c.post("/oauth/deauthorize", values, &res)
+}
+
+// post submits a request to the Connect OAuth endpoint at path, or passes it
+// to the client's Backend with the absolute path if it has one.
+func (c OAuthClient) post(path string, values url.Values, v interface{}) error {
+	if b := c.backendFor(); b != nil {
+		return b.Call("POST", path, values, v)
+	}
+	return c.do(c.serviceURL(ConnectService), "POST", path, values, v)
+}
@@ -0,0 +1,89 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestOAuthToken will test that OAuth requests are sent to the Connect host,
+// and that its error format is parsed into an OAuthError.
+func TestOAuthToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.URL.Path != "/oauth/token" {
+			t.Errorf("Expected path /oauth/token, got %s", r.URL.Path)
+		}
+		if r.Form.Get("code") != "ac_good" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant","error_description":"Authorization code does not exist"}`))
+			return
+		}
+		w.Write([]byte(`{"access_token":"sk_test_1","stripe_user_id":"acct_1","scope":"read_write"}`))
+	}))
+	defer server.Close()
+	defer SetConnectUrl(_connectUrl)
+	SetConnectUrl(server.URL)
+
+	token, err := OAuth.Token("ac_good")
+	if err != nil {
+		t.Fatalf("Expected OAuth Token, got Error %s", err.Error())
+	}
+	if token.StripeUserID != "acct_1" {
+		t.Errorf("Expected Stripe User ID acct_1, got %s", token.StripeUserID)
+	}
+
+	_, err = OAuth.Token("ac_bad")
+	oauthErr, ok := err.(*OAuthError)
+	if !ok {
+		t.Fatalf("Expected OAuthError, got %v", err)
+	}
+	if oauthErr.Type != "invalid_grant" {
+		t.Errorf("Expected OAuth Error invalid_grant, got %s", oauthErr.Type)
+	}
+}
+
+func TestOAuthAuthorizeURL(t *testing.T) {
+	u := OAuth.AuthorizeURL("ca_1", ScopeReadWrite, "csrf")
+	if !strings.HasPrefix(u, _connectUrl+"/oauth/authorize?") {
+		t.Errorf("Expected Connect authorize URL, got %s", u)
+	}
+	if !strings.Contains(u, "client_id=ca_1") || !strings.Contains(u, "state=csrf") {
+		t.Errorf("Expected client_id and state in URL, got %s", u)
+	}
+}
+
+// TestOAuthClientOptions will test that OAuth requests are sent with the
+// headers and timeout of the client, and through its Backend.
+func TestOAuthClientOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Route") != "billing" {
+			t.Errorf("Expected the routing header, got %v", r.Header)
+		}
+		if r.URL.Path == "/oauth/deauthorize" {
+			time.Sleep(100 * time.Millisecond)
+		}
+		w.Write([]byte(`{"access_token":"sk_test_1","stripe_user_id":"acct_1"}`))
+	}))
+	defer server.Close()
+	oauth := OAuth.With(WithServiceURL(ConnectService, server.URL), WithHeader("X-Route", "billing"))
+
+	if token, err := oauth.Token("ac_1"); err != nil || token.StripeUserID != "acct_1" {
+		t.Fatalf("Expected OAuth Token, got %+v, %v", token, err)
+	}
+	if err := oauth.With(WithTimeout(10*time.Millisecond)).Deauthorize("ca_1", "acct_1"); err == nil {
+		t.Error("Expected the deauthorization to time out")
+	}
+
+	var path string
+	backend := BackendFunc(func(method, p string, params url.Values, v interface{}) error {
+		path = p
+		return nil
+	})
+	if err := OAuth.With(WithBackend(backend)).Deauthorize("ca_1", "acct_1"); err != nil || path != "/oauth/deauthorize" {
+		t.Errorf("Expected the deauthorization to be passed to the Backend, got %q, %v", path, err)
+	}
+}
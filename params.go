@@ -0,0 +1,52 @@
+package stripe
+
+import "net/url"
+
+// Optional parameters that are pointers are only sent when set, which
+// distinguishes "not sent" from an explicit zero value such as a quantity of
+// 0, false, or an empty string that clears a field. The helpers below return
+// a pointer to a copy of their argument so such values can be set inline:
+//
+//	params := &SubscriptionParams{Quantity: stripe.Int64(0)}
+
+// String returns a pointer to the string value v.
+func String(v string) *string {
+	return &v
+}
+
+// Int64 returns a pointer to the int64 value v.
+func Int64(v int64) *int64 {
+	return &v
+}
+
+// Bool returns a pointer to the bool value v.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// Params holds options common to all requests. It is embedded in each of the
+// Params structs.
+type Params struct {
+	extra url.Values
+}
+
+// AddExtra adds a raw form parameter to the request, such as a beta or newly
+// launched parameter the library does not model yet. Keys use Stripe's
+// bracket notation for nested values, e.g. "payment_method_options[card][foo]".
+// Extra parameters replace any the library sends under the same key.
+func (p *Params) AddExtra(key, value string) {
+	if p.extra == nil {
+		p.extra = make(url.Values)
+	}
+	p.extra.Add(key, value)
+}
+
+// base gives the form encoder access to the Params embedded in a struct.
+func (p *Params) base() *Params { return p }
+
+// appendExtra adds the extra parameters to values.
+func (p *Params) appendExtra(values url.Values) {
+	for k, v := range p.extra {
+		values[k] = v
+	}
+}
@@ -0,0 +1,305 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// PaymentIntentStatus is the status of a PaymentIntent.
+type PaymentIntentStatus string
+
+// The statuses of a PaymentIntent.
+const (
+	PaymentIntentRequiresPaymentMethod PaymentIntentStatus = "requires_payment_method"
+	PaymentIntentRequiresConfirmation  PaymentIntentStatus = "requires_confirmation"
+	PaymentIntentRequiresAction        PaymentIntentStatus = "requires_action"
+	PaymentIntentProcessing            PaymentIntentStatus = "processing"
+	PaymentIntentRequiresCapture       PaymentIntentStatus = "requires_capture"
+	PaymentIntentCanceled              PaymentIntentStatus = "canceled"
+	PaymentIntentSucceeded             PaymentIntentStatus = "succeeded"
+)
+
+// PaymentIntent represents a payment from a customer, tracked from its
+// creation through any authentication the customer's bank requires, such as
+// 3D Secure, until it succeeds.
+//
+// see https://stripe.com/docs/api/payment_intents/object
+type PaymentIntent struct {
+	APIResource
+
+	ID                 string                   `json:"id"`
+	Amount             int64                    `json:"amount"`
+	AmountReceived     int64                    `json:"amount_received"`
+	CaptureMethod      string                   `json:"capture_method"`
+	ClientSecret       string                   `json:"client_secret"`
+	ConfirmationMethod string                   `json:"confirmation_method"`
+	Created            UnixTime                 `json:"created"`
+	Currency           Currency                 `json:"currency"`
+	Customer           string                   `json:"customer,omitempty"`
+	Description        string                   `json:"description,omitempty"`
+	LastPaymentError   *PaymentIntentError      `json:"last_payment_error,omitempty"`
+	LatestCharge       string                   `json:"latest_charge,omitempty"`
+	NextAction         *PaymentIntentNextAction `json:"next_action,omitempty"`
+	PaymentMethod      string                   `json:"payment_method,omitempty"`
+	PaymentMethodTypes []string                 `json:"payment_method_types,omitempty"`
+	ReceiptEmail       string                   `json:"receipt_email,omitempty"`
+	SetupFutureUsage   string                   `json:"setup_future_usage,omitempty"`
+	Shipping           *ShippingDetails         `json:"shipping,omitempty"`
+	Status             PaymentIntentStatus      `json:"status"`
+	TransferGroup      string                   `json:"transfer_group,omitempty"`
+	Metadata           map[string]string        `json:"metadata,omitempty"`
+	Livemode           bool                     `json:"livemode"`
+
+	// The fee the platform collects from the payment, if it is made on or to
+	// a connected account.
+	ApplicationFeeAmount int64 `json:"application_fee_amount,omitempty"`
+
+	// The connected account the payment is made on behalf of, and where its
+	// funds are transferred to, for destination charges.
+	OnBehalfOf   string        `json:"on_behalf_of,omitempty"`
+	TransferData *TransferData `json:"transfer_data,omitempty"`
+}
+
+// PaymentIntentError is the error that made the last attempt to confirm a
+// PaymentIntent fail.
+type PaymentIntentError struct {
+	Code        string `json:"code,omitempty"`
+	DeclineCode string `json:"decline_code,omitempty"`
+	Message     string `json:"message,omitempty"`
+	Type        string `json:"type"`
+}
+
+// PaymentIntentNextAction is what has to happen for a PaymentIntent that
+// requires action to proceed, usually authenticating the customer.
+type PaymentIntentNextAction struct {
+	// The type of action: redirect_to_url, or use_stripe_sdk for actions
+	// handled by Stripe.js and the mobile SDKs, or one particular to a
+	// payment method, such as alipay_handle_redirect.
+	Type string `json:"type"`
+
+	// Set for redirect_to_url actions, such as those of Klarna and Afterpay:
+	// the customer must be sent to URL, and is returned to ReturnURL once
+	// they authenticated.
+	RedirectToURL *struct {
+		ReturnURL string `json:"return_url"`
+		URL       string `json:"url"`
+	} `json:"redirect_to_url,omitempty"`
+
+	// Set for use_stripe_sdk actions, to be passed to Stripe.js as is.
+	UseStripeSDK json.RawMessage `json:"use_stripe_sdk,omitempty"`
+
+	// Set for multibanco_display_details actions: the customer pays at an
+	// ATM or through online banking with the entity and reference shown on
+	// the voucher, before it expires.
+	MultibancoDisplayDetails *struct {
+		Entity           string   `json:"entity"`
+		Reference        string   `json:"reference"`
+		ExpiresAt        UnixTime `json:"expires_at"`
+		HostedVoucherURL string   `json:"hosted_voucher_url"`
+	} `json:"multibanco_display_details,omitempty"`
+
+	// Set for alipay_handle_redirect actions: the customer must be sent to
+	// URL, or to NativeURL from within the Alipay app, to authorize the
+	// payment.
+	AlipayHandleRedirect *struct {
+		NativeData string `json:"native_data,omitempty"`
+		NativeURL  string `json:"native_url,omitempty"`
+		ReturnURL  string `json:"return_url"`
+		URL        string `json:"url"`
+	} `json:"alipay_handle_redirect,omitempty"`
+
+	// Set for wechat_pay_display_qr_code actions: the QR code the customer
+	// scans with WeChat to authorize the payment, encoded as Data, and as
+	// images to show them.
+	WeChatPayDisplayQRCode *struct {
+		Data                  string `json:"data"`
+		HostedInstructionsURL string `json:"hosted_instructions_url,omitempty"`
+		ImageDataURL          string `json:"image_data_url,omitempty"`
+		ImageURLPNG           string `json:"image_url_png,omitempty"`
+		ImageURLSVG           string `json:"image_url_svg,omitempty"`
+	} `json:"wechat_pay_display_qr_code,omitempty"`
+}
+
+// PaymentIntentParams encapsulates options for creating PaymentIntents.
+type PaymentIntentParams struct {
+	Params
+
+	// A positive integer in the smallest currency unit representing how much
+	// to collect.
+	Amount int64 `form:"amount"`
+
+	// 3-letter ISO code for currency.
+	Currency Currency `form:"currency"`
+
+	// (Optional) The ID of the Customer the payment is for, required to use
+	// their saved payment methods.
+	Customer *string `form:"customer"`
+
+	// (Optional) The ID of the payment method to collect the payment with.
+	PaymentMethod *string `form:"payment_method"`
+
+	// (Optional) The details of a new payment method to collect the payment
+	// with, instead of the ID of an existing one.
+	PaymentMethodData *PaymentMethodParams `form:"payment_method_data"`
+
+	// (Optional) The types of payment method the payment can be collected
+	// with, e.g. PaymentMethodTypeSEPADebit. Defaults to card.
+	PaymentMethodTypes []string `form:"payment_method_types"`
+
+	// (Optional) The customer's acceptance of the mandate to debit their bank
+	// account, required to confirm bank debits.
+	MandateData *MandateData `form:"mandate_data"`
+
+	// (Optional) The client WeChat Pay is paid with, required to confirm
+	// wechat_pay payments: web, ios or android.
+	WeChatPayClient *string `form:"payment_method_options[wechat_pay][client]"`
+
+	// (Optional) Confirm the PaymentIntent on creation, attempting the
+	// payment straight away.
+	Confirm *bool `form:"confirm"`
+
+	// (Optional) Whether the customer is not present to authenticate, e.g.
+	// for a payment on a saved card made from a background job. Only sent
+	// with Confirm.
+	OffSession *bool `form:"-"`
+
+	// (Optional) The URL the customer is returned to after authenticating
+	// outside of your site, when confirming.
+	ReturnURL *string `form:"return_url"`
+
+	// (Optional) Save the payment method for on_session or off_session use
+	// once the payment succeeds.
+	SetupFutureUsage *string `form:"setup_future_usage"`
+
+	// (Optional) When to capture the funds: automatic or manual.
+	CaptureMethod *string `form:"capture_method"`
+
+	// (Optional) An arbitrary string attached to the PaymentIntent.
+	Description *string `form:"description"`
+
+	// (Optional) The text shown on the customer's statement for non-card
+	// payments, replacing the account's default: 5 to 22 Latin characters
+	// including a letter, and none of < > \ ' " *.
+	StatementDescriptor *string `form:"statement_descriptor"`
+
+	// (Optional) The text added to the account's statement descriptor prefix
+	// for card payments, within the 22 characters that fit.
+	StatementDescriptorSuffix *string `form:"statement_descriptor_suffix"`
+
+	// (Optional) The email address the receipt of the payment is sent to once
+	// it succeeds.
+	ReceiptEmail *string `form:"receipt_email"`
+
+	// (Optional) A string that identifies the payment's charge as part of a
+	// group of charges and transfers, e.g. an order ID.
+	TransferGroup *string `form:"transfer_group"`
+
+	// (Optional) The fee the platform collects from the payment, made on or
+	// to a connected account, in the smallest unit of the currency.
+	ApplicationFeeAmount *int64 `form:"application_fee_amount"`
+
+	// (Optional) The ID of a connected account the payment is made on behalf
+	// of, which becomes the settlement merchant.
+	OnBehalfOf *string `form:"on_behalf_of"`
+
+	// (Optional) The connected account the funds are transferred to, for a
+	// destination charge.
+	TransferData *TransferData `form:"transfer_data"`
+
+	// (Optional) The name and address the goods paid for are shipped to,
+	// which also helps Radar assess the payment.
+	Shipping *ShippingDetails `form:"shipping"`
+
+	// (Optional) Itemized order data, for payments on corporate cards
+	// qualifying for lower interchange rates. Its line items and shipping
+	// must add up to the Amount.
+	Level3 *Level3 `form:"level3"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// PaymentIntentConfirmParams encapsulates options for confirming
+// PaymentIntents.
+type PaymentIntentConfirmParams struct {
+	Params
+
+	// (Optional) The ID of the payment method to collect the payment with,
+	// replacing the one set on the PaymentIntent.
+	PaymentMethod *string `form:"payment_method"`
+
+	// (Optional) Whether the customer is not present to authenticate.
+	OffSession *bool `form:"off_session"`
+
+	// (Optional) The URL the customer is returned to after authenticating
+	// outside of your site.
+	ReturnURL *string `form:"return_url"`
+
+	// (Optional) The customer's acceptance of the mandate to debit their bank
+	// account, as for PaymentIntentParams.
+	MandateData *MandateData `form:"mandate_data"`
+
+	// (Optional) The client WeChat Pay is paid with, as for
+	// PaymentIntentParams.
+	WeChatPayClient *string `form:"payment_method_options[wechat_pay][client]"`
+}
+
+// PaymentIntentClient encapsulates operations for creating, confirming,
+// canceling and querying PaymentIntents using the Stripe REST API.
+type PaymentIntentClient struct{ scope }
+
+// With returns a PaymentIntentClient configured with the given options.
+func (c PaymentIntentClient) With(opts ...ClientOption) PaymentIntentClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new PaymentIntent, confirming it straight away if
+// params.Confirm is set.
+//
+// see https://stripe.com/docs/api/payment_intents/create
+func (c PaymentIntentClient) Create(params *PaymentIntentParams) (*PaymentIntent, error) {
+	if err := checkDescriptors(params.StatementDescriptor, params.StatementDescriptorSuffix); err != nil {
+		return nil, err
+	}
+	if err := validateLevel3(params.Level3, params.Amount); err != nil {
+		return nil, err
+	}
+	if err := validateConnect(params.Amount, params.ApplicationFeeAmount, params.TransferData); err != nil {
+		return nil, err
+	}
+	values := make(url.Values)
+	if params.Confirm != nil && *params.Confirm && params.OffSession != nil {
+		values.Add("off_session", strconv.FormatBool(*params.OffSession))
+	}
+	appendForm(values, params)
+
+	res := &PaymentIntent{}
+	return res, c.query("POST", "/payment_intents", values, res)
+}
+
+// Retrieves the PaymentIntent with the given ID.
+//
+// see https://stripe.com/docs/api/payment_intents/retrieve
+func (c PaymentIntentClient) Get(id string) (*PaymentIntent, error) {
+	res := &PaymentIntent{}
+	return res, c.query("GET", "/payment_intents/"+url.QueryEscape(id), nil, res)
+}
+
+// Confirms the PaymentIntent with the given ID, attempting the payment.
+//
+// see https://stripe.com/docs/api/payment_intents/confirm
+func (c PaymentIntentClient) Confirm(id string, params *PaymentIntentConfirmParams) (*PaymentIntent, error) {
+	values := formValues(params)
+
+	res := &PaymentIntent{}
+	return res, c.query("POST", "/payment_intents/"+url.QueryEscape(id)+"/confirm", values, res)
+}
+
+// Cancels the PaymentIntent with the given ID.
+//
+// see https://stripe.com/docs/api/payment_intents/cancel
+func (c PaymentIntentClient) Cancel(id string) (*PaymentIntent, error) {
+	res := &PaymentIntent{}
+	return res, c.query("POST", "/payment_intents/"+url.QueryEscape(id)+"/cancel", nil, res)
+}
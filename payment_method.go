@@ -0,0 +1,263 @@
+package stripe
+
+import "net/url"
+
+// Payment Method Types, continued from those that pay by card
+const (
+	PaymentMethodTypeIdeal       = "ideal"
+	PaymentMethodTypeBancontact  = "bancontact"
+	PaymentMethodTypeSofort      = "sofort"
+	PaymentMethodTypeMultibanco  = "multibanco"
+	PaymentMethodTypeBACSDebit   = "bacs_debit"
+	PaymentMethodTypeAUBECSDebit = "au_becs_debit"
+
+	PaymentMethodTypeAlipay           = "alipay"
+	PaymentMethodTypeWeChatPay        = "wechat_pay"
+	PaymentMethodTypeKlarna           = "klarna"
+	PaymentMethodTypeAfterpayClearpay = "afterpay_clearpay"
+)
+
+// PaymentMethod represents a customer's means of paying, such as a card or
+// a bank account debited through SEPA or ACH. Of the details of each type of
+// payment method, only those of the given Type are set.
+//
+// see https://stripe.com/docs/api/payment_methods/object
+type PaymentMethod struct {
+	APIResource
+
+	ID             string            `json:"id"`
+	Type           string            `json:"type"`
+	BillingDetails *BillingDetails   `json:"billing_details,omitempty"`
+	Created        UnixTime          `json:"created"`
+	Customer       string            `json:"customer,omitempty"`
+	Metadata       map[string]string `json:"metadata,omitempty"`
+	Livemode       bool              `json:"livemode"`
+
+	Card          *PaymentMethodDetailsCard   `json:"card,omitempty"`
+	SEPADebit     *PaymentMethodSEPADebit     `json:"sepa_debit,omitempty"`
+	USBankAccount *PaymentMethodUSBankAccount `json:"us_bank_account,omitempty"`
+	Ideal         *PaymentMethodIdeal         `json:"ideal,omitempty"`
+	Bancontact    *struct{}                   `json:"bancontact,omitempty"`
+	Sofort        *PaymentMethodSofort        `json:"sofort,omitempty"`
+	Multibanco    *struct{}                   `json:"multibanco,omitempty"`
+	BACSDebit     *PaymentMethodBACSDebit     `json:"bacs_debit,omitempty"`
+	AUBECSDebit   *PaymentMethodAUBECSDebit   `json:"au_becs_debit,omitempty"`
+
+	// Wallets and buy now, pay later methods, which the customer authorizes
+	// by being redirected or scanning a QR code, and hold few details.
+	Alipay           *struct{}          `json:"alipay,omitempty"`
+	WeChatPay        *struct{}          `json:"wechat_pay,omitempty"`
+	Link             *PaymentMethodLink `json:"link,omitempty"`
+	Klarna           *struct{}          `json:"klarna,omitempty"`
+	AfterpayClearpay *struct{}          `json:"afterpay_clearpay,omitempty"`
+}
+
+// PaymentMethodLink describes the Link account of the customer.
+type PaymentMethodLink struct {
+	Email string `json:"email,omitempty"`
+}
+
+// PaymentMethodSEPADebit describes a bank account in the SEPA area.
+type PaymentMethodSEPADebit struct {
+	BankCode    string `json:"bank_code,omitempty"`
+	BranchCode  string `json:"branch_code,omitempty"`
+	Country     string `json:"country,omitempty"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+	Last4       string `json:"last4"`
+}
+
+// PaymentMethodUSBankAccount describes a US bank account debited through
+// ACH.
+type PaymentMethodUSBankAccount struct {
+	AccountHolderType string `json:"account_holder_type,omitempty"`
+	AccountType       string `json:"account_type,omitempty"`
+	BankName          string `json:"bank_name,omitempty"`
+	Fingerprint       string `json:"fingerprint,omitempty"`
+	Last4             string `json:"last4"`
+	RoutingNumber     string `json:"routing_number,omitempty"`
+}
+
+// PaymentMethodBACSDebit describes a UK bank account debited through Bacs
+// Direct Debit.
+type PaymentMethodBACSDebit struct {
+	Fingerprint string `json:"fingerprint,omitempty"`
+	Last4       string `json:"last4"`
+	SortCode    string `json:"sort_code"`
+}
+
+// PaymentMethodAUBECSDebit describes an Australian bank account debited
+// through BECS Direct Debit.
+type PaymentMethodAUBECSDebit struct {
+	BSBNumber   string `json:"bsb_number"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+	Last4       string `json:"last4"`
+}
+
+// PaymentMethodIdeal describes the Dutch bank paid through with iDEAL.
+type PaymentMethodIdeal struct {
+	Bank string `json:"bank,omitempty"`
+	BIC  string `json:"bic,omitempty"`
+}
+
+// PaymentMethodSofort describes the country of the bank paid through with
+// Sofort.
+type PaymentMethodSofort struct {
+	Country string `json:"country"`
+}
+
+// PaymentMethodParams encapsulates options for creating PaymentMethods, or
+// the payment method data a PaymentIntent creates one from. Set only the
+// details of the given Type; Bancontact, Multibanco and the wallets and buy
+// now, pay later methods need none, though Klarna requires the billing email
+// and country, and Afterpay the billing name, email and address.
+type PaymentMethodParams struct {
+	Params
+
+	// The type of payment method, e.g. PaymentMethodTypeSEPADebit.
+	Type string `form:"type"`
+
+	// (Optional) The billing details of the customer. A name and email are
+	// required by some types, such as sepa_debit and bancontact.
+	BillingDetails *BillingDetails `form:"billing_details"`
+
+	// The IBAN of the account, for sepa_debit.
+	SEPADebit *SEPADebitParams `form:"sepa_debit"`
+
+	// The account and routing number of the account, for us_bank_account.
+	USBankAccount *USBankAccountParams `form:"us_bank_account"`
+
+	// (Optional) The customer's bank, for ideal.
+	Ideal *IdealParams `form:"ideal"`
+
+	// The country of the customer's bank, for sofort.
+	Sofort *SofortParams `form:"sofort"`
+
+	// The account number and sort code of the account, for bacs_debit.
+	BACSDebit *BACSDebitParams `form:"bacs_debit"`
+
+	// The account number and BSB of the account, for au_becs_debit.
+	AUBECSDebit *AUBECSDebitParams `form:"au_becs_debit"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// SEPADebitParams holds the details of a bank account in the SEPA area.
+type SEPADebitParams struct {
+	IBAN string `form:"iban"`
+}
+
+// USBankAccountParams holds the details of a US bank account.
+type USBankAccountParams struct {
+	AccountNumber string `form:"account_number"`
+	RoutingNumber string `form:"routing_number"`
+
+	// (Optional) individual or company
+	AccountHolderType *string `form:"account_holder_type"`
+
+	// (Optional) checking or savings
+	AccountType *string `form:"account_type"`
+}
+
+// BACSDebitParams holds the details of a UK bank account.
+type BACSDebitParams struct {
+	AccountNumber string `form:"account_number"`
+	SortCode      string `form:"sort_code"`
+}
+
+// AUBECSDebitParams holds the details of an Australian bank account.
+type AUBECSDebitParams struct {
+	AccountNumber string `form:"account_number"`
+	BSBNumber     string `form:"bsb_number"`
+}
+
+// IdealParams holds the details of a payment through iDEAL.
+type IdealParams struct {
+	// e.g. "abn_amro" or "ing"
+	Bank string `form:"bank"`
+}
+
+// SofortParams holds the details of a payment through Sofort.
+type SofortParams struct {
+	// the two-letter ISO code of the bank's country, e.g. "DE"
+	Country string `form:"country"`
+}
+
+// MandateData records the customer's acceptance of a mandate, which bank
+// debits such as sepa_debit, us_bank_account and au_becs_debit require before
+// the account can be debited. Bacs mandates must be collected with Checkout
+// or Stripe's hosted form instead.
+type MandateData struct {
+	// online or offline
+	Type string `form:"customer_acceptance[type]"`
+
+	// (Optional) When the customer accepted the mandate, if not now.
+	AcceptedAt *UnixTime `form:"customer_acceptance[accepted_at]"`
+
+	// The IP address and user agent of the customer's browser, required to
+	// accept online.
+	IPAddress string `form:"customer_acceptance[online][ip_address]"`
+	UserAgent string `form:"customer_acceptance[online][user_agent]"`
+}
+
+// PaymentMethodClient encapsulates operations for creating, attaching and
+// querying payment methods using the Stripe REST API.
+type PaymentMethodClient struct{ scope }
+
+// With returns a PaymentMethodClient configured with the given options.
+func (c PaymentMethodClient) With(opts ...ClientOption) PaymentMethodClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new PaymentMethod, which can then be attached to a Customer or
+// paid with.
+//
+// see https://stripe.com/docs/api/payment_methods/create
+func (c PaymentMethodClient) Create(params *PaymentMethodParams) (*PaymentMethod, error) {
+	res := &PaymentMethod{}
+	return res, c.query("POST", "/payment_methods", formValues(params), res)
+}
+
+// Retrieves the PaymentMethod with the given ID.
+//
+// see https://stripe.com/docs/api/payment_methods/retrieve
+func (c PaymentMethodClient) Get(id string) (*PaymentMethod, error) {
+	res := &PaymentMethod{}
+	return res, c.query("GET", "/payment_methods/"+url.QueryEscape(id), nil, res)
+}
+
+// Attaches the PaymentMethod with the given ID to a Customer, so it can be
+// paid with again.
+//
+// see https://stripe.com/docs/api/payment_methods/attach
+func (c PaymentMethodClient) Attach(id, customerID string) (*PaymentMethod, error) {
+	res := &PaymentMethod{}
+	values := url.Values{"customer": {customerID}}
+	return res, c.query("POST", "/payment_methods/"+url.QueryEscape(id)+"/attach", values, res)
+}
+
+// Detaches the PaymentMethod with the given ID from its Customer, after
+// which it can no longer be used.
+//
+// see https://stripe.com/docs/api/payment_methods/detach
+func (c PaymentMethodClient) Detach(id string) (*PaymentMethod, error) {
+	res := &PaymentMethod{}
+	return res, c.query("POST", "/payment_methods/"+url.QueryEscape(id)+"/detach", nil, res)
+}
+
+// Returns a list of the PaymentMethods of the given type attached to the
+// Customer, or of all types if typ is empty.
+//
+// see https://stripe.com/docs/api/payment_methods/customer_list
+func (c PaymentMethodClient) CustomerList(customerID, typ string, limit int, before, after string) ([]*PaymentMethod, bool, error) {
+	res := struct {
+		ListObject
+		Data []*PaymentMethod
+	}{}
+	params := listParams(limit, before, after)
+	if typ != "" {
+		params.Set("type", typ)
+	}
+	err := c.query("GET", "/customers/"+url.QueryEscape(customerID)+"/payment_methods", params, &res)
+	return res.Data, res.More, err
+}
@@ -0,0 +1,164 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+// PaymentMethodConfiguration controls which payment methods are shown to
+// customers in Checkout and the Payment Element, for your account or for
+// connected accounts that inherit from it.
+//
+// see https://stripe.com/docs/api/payment_method_configurations/object
+type PaymentMethodConfiguration struct {
+	APIResource
+
+	ID          string `json:"id"`
+	Active      bool   `json:"active"`
+	Application string `json:"application,omitempty"`
+	IsDefault   bool   `json:"is_default"`
+	Name        string `json:"name"`
+	Parent      string `json:"parent,omitempty"`
+	Livemode    bool   `json:"livemode"`
+
+	// The settings of each payment method, keyed by payment method type, e.g.
+	// "card" or "apple_pay".
+	PaymentMethods map[string]*PaymentMethodSetting `json:"-"`
+}
+
+// PaymentMethodSetting describes whether a payment method is available and
+// shown under a PaymentMethodConfiguration.
+type PaymentMethodSetting struct {
+	Available         bool `json:"available"`
+	DisplayPreference struct {
+		Overridable *bool  `json:"overridable,omitempty"`
+		Preference  string `json:"preference"`
+		Value       string `json:"value"`
+	} `json:"display_preference"`
+}
+
+// UnmarshalJSON decodes the configuration, collecting the per payment method
+// settings, which Stripe returns as top-level fields, into PaymentMethods.
+func (c *PaymentMethodConfiguration) UnmarshalJSON(data []byte) error {
+	type config PaymentMethodConfiguration
+	if err := json.Unmarshal(data, (*config)(c)); err != nil {
+		return err
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	c.PaymentMethods = make(map[string]*PaymentMethodSetting)
+	for name, raw := range fields {
+		if len(raw) == 0 || raw[0] != '{' {
+			continue
+		}
+		setting := &PaymentMethodSetting{}
+		if err := json.Unmarshal(raw, setting); err == nil {
+			c.PaymentMethods[name] = setting
+		}
+	}
+	return nil
+}
+
+// MarshalJSON encodes the configuration with its per payment method settings
+// as top-level fields, as Stripe returns them.
+func (c PaymentMethodConfiguration) MarshalJSON() ([]byte, error) {
+	type config PaymentMethodConfiguration
+	data, err := json.Marshal(config(c))
+	if err != nil || len(c.PaymentMethods) == 0 {
+		return data, err
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for name, setting := range c.PaymentMethods {
+		raw, err := json.Marshal(setting)
+		if err != nil {
+			return nil, err
+		}
+		fields[name] = raw
+	}
+	return json.Marshal(fields)
+}
+
+// PaymentMethodConfigurationParams encapsulates options for creating and
+// updating Payment Method Configurations.
+type PaymentMethodConfigurationParams struct {
+	Params
+
+	// (Optional) The name of the configuration.
+	Name *string `form:"name"`
+
+	// (Optional) The ID of the configuration to inherit from, for a
+	// connected account's configuration. Can only be set on creation.
+	Parent *string `form:"parent"`
+
+	// (Optional) Whether the configuration can be used. Can only be set on
+	// update.
+	Active *bool `form:"active"`
+
+	// (Optional) The display preference of each payment method, keyed by
+	// payment method type, e.g. "card".
+	PaymentMethods map[string]*PaymentMethodPreference `form:",inline"`
+}
+
+// PaymentMethodPreference sets whether a payment method of a Payment Method
+// Configuration is shown to customers.
+type PaymentMethodPreference struct {
+	// Whether the payment method is shown, "on" or "off".
+	DisplayPreference string `form:"display_preference[preference]"`
+}
+
+// PaymentMethodConfigurationClient encapsulates operations for creating,
+// updating and querying payment method configurations using the Stripe REST
+// API.
+type PaymentMethodConfigurationClient struct{ scope }
+
+// With returns a PaymentMethodConfigurationClient configured with the given options.
+func (c PaymentMethodConfigurationClient) With(opts ...ClientOption) PaymentMethodConfigurationClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Payment Method Configuration.
+//
+// see https://stripe.com/docs/api/payment_method_configurations/create
+func (c PaymentMethodConfigurationClient) Create(params *PaymentMethodConfigurationParams) (*PaymentMethodConfiguration, error) {
+	res := &PaymentMethodConfiguration{}
+	return res, c.query("POST", "/payment_method_configurations", formValues(params), res)
+}
+
+// Retrieves the Payment Method Configuration with the given ID.
+//
+// see https://stripe.com/docs/api/payment_method_configurations/retrieve
+func (c PaymentMethodConfigurationClient) Get(id string) (*PaymentMethodConfiguration, error) {
+	res := &PaymentMethodConfiguration{}
+	return res, c.query("GET", "/payment_method_configurations/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the Payment Method Configuration with the given ID.
+//
+// see https://stripe.com/docs/api/payment_method_configurations/update
+func (c PaymentMethodConfigurationClient) Update(id string, params *PaymentMethodConfigurationParams) (*PaymentMethodConfiguration, error) {
+	res := &PaymentMethodConfiguration{}
+	return res, c.query("POST", "/payment_method_configurations/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Returns a list of Payment Method Configurations. If application is not
+// empty, only the configurations of that Connect application are returned.
+//
+// see https://stripe.com/docs/api/payment_method_configurations/list
+func (c PaymentMethodConfigurationClient) List(application string, limit int, before, after string) ([]*PaymentMethodConfiguration, bool, error) {
+	res := struct {
+		ListObject
+		Data []*PaymentMethodConfiguration
+	}{}
+	values := listParams(limit, before, after)
+	if application != "" {
+		values.Add("application", application)
+	}
+	err := c.query("GET", "/payment_method_configurations", values, &res)
+	return res.Data, res.More, err
+}
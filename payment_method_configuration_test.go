@@ -0,0 +1,27 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestPaymentMethodConfigurationDecode will test that the per payment method
+// settings returned as top-level fields are collected into PaymentMethods.
+func TestPaymentMethodConfigurationDecode(t *testing.T) {
+	body := `{"id":"pmc_1","active":true,"name":"Default","is_default":true,
+		"card":{"available":true,"display_preference":{"overridable":true,"preference":"on","value":"on"}},
+		"klarna":{"available":false,"display_preference":{"preference":"off","value":"off"}}}`
+	config := PaymentMethodConfiguration{}
+	if err := json.Unmarshal([]byte(body), &config); err != nil {
+		t.Fatalf("Expected Payment Method Configuration, got Error %s", err.Error())
+	}
+	if config.ID != "pmc_1" || !config.IsDefault {
+		t.Errorf("Expected default configuration pmc_1, got %+v", config)
+	}
+	if len(config.PaymentMethods) != 2 {
+		t.Fatalf("Expected 2 payment methods, got %d", len(config.PaymentMethods))
+	}
+	if card := config.PaymentMethods["card"]; !card.Available || card.DisplayPreference.Value != "on" {
+		t.Errorf("Expected card to be available and on, got %+v", card)
+	}
+}
@@ -0,0 +1,95 @@
+package stripe
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// PaymentMethodDomain represents a web domain registered for wallet payment
+// methods such as Apple Pay, Google Pay and Link.
+//
+// see https://stripe.com/docs/api/payment_method_domains/object
+type PaymentMethodDomain struct {
+	APIResource
+
+	ID         string        `json:"id"`
+	ApplePay   *DomainStatus `json:"apple_pay"`
+	Created    UnixTime      `json:"created"`
+	DomainName string        `json:"domain_name"`
+	Enabled    bool          `json:"enabled"`
+	GooglePay  *DomainStatus `json:"google_pay"`
+	Link       *DomainStatus `json:"link"`
+	Paypal     *DomainStatus `json:"paypal"`
+	Livemode   bool          `json:"livemode"`
+}
+
+// DomainStatus describes whether a payment method is active on a
+// PaymentMethodDomain, and why not if it is inactive.
+type DomainStatus struct {
+	Status        string `json:"status"`
+	StatusDetails *struct {
+		ErrorMessage string `json:"error_message"`
+	} `json:"status_details,omitempty"`
+}
+
+// PaymentMethodDomainClient encapsulates operations for registering,
+// validating and querying payment method domains using the Stripe REST API.
+type PaymentMethodDomainClient struct{ scope }
+
+// With returns a PaymentMethodDomainClient configured with the given options.
+func (c PaymentMethodDomainClient) With(opts ...ClientOption) PaymentMethodDomainClient {
+	c.apply(opts)
+	return c
+}
+
+// Registers the domain, e.g. "example.com", for wallet payment methods.
+//
+// see https://stripe.com/docs/api/payment_method_domains/create
+func (c PaymentMethodDomainClient) Create(domain string) (*PaymentMethodDomain, error) {
+	values := url.Values{"domain_name": {domain}}
+	res := &PaymentMethodDomain{}
+	return res, c.query("POST", "/payment_method_domains", values, res)
+}
+
+// Retrieves the Payment Method Domain with the given ID.
+//
+// see https://stripe.com/docs/api/payment_method_domains/retrieve
+func (c PaymentMethodDomainClient) Get(id string) (*PaymentMethodDomain, error) {
+	res := &PaymentMethodDomain{}
+	return res, c.query("GET", "/payment_method_domains/"+url.QueryEscape(id), nil, res)
+}
+
+// Enables or disables the Payment Method Domain with the given ID.
+//
+// see https://stripe.com/docs/api/payment_method_domains/update
+func (c PaymentMethodDomainClient) Update(id string, enabled bool) (*PaymentMethodDomain, error) {
+	values := url.Values{"enabled": {strconv.FormatBool(enabled)}}
+	res := &PaymentMethodDomain{}
+	return res, c.query("POST", "/payment_method_domains/"+url.QueryEscape(id), values, res)
+}
+
+// Validates the Payment Method Domain with the given ID again, e.g. after
+// fixing the problem reported in its status details.
+//
+// see https://stripe.com/docs/api/payment_method_domains/validate
+func (c PaymentMethodDomainClient) Validate(id string) (*PaymentMethodDomain, error) {
+	res := &PaymentMethodDomain{}
+	return res, c.query("POST", "/payment_method_domains/"+url.QueryEscape(id)+"/validate", nil, res)
+}
+
+// Returns a list of your Payment Method Domains with the specified range. If
+// domain is not empty, only the domain with that name is returned.
+//
+// see https://stripe.com/docs/api/payment_method_domains/list
+func (c PaymentMethodDomainClient) List(domain string, limit int, before, after string) ([]*PaymentMethodDomain, bool, error) {
+	res := struct {
+		ListObject
+		Data []*PaymentMethodDomain
+	}{}
+	values := listParams(limit, before, after)
+	if domain != "" {
+		values.Add("domain_name", domain)
+	}
+	err := c.query("GET", "/payment_method_domains", values, &res)
+	return res.Data, res.More, err
+}
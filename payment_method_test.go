@@ -0,0 +1,135 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+// TestBankDebitPaymentMethods will test that bank debits are created with
+// their account details, and that PaymentIntents send the payment method
+// data and mandate acceptance they require.
+func TestBankDebitPaymentMethods(t *testing.T) {
+	var sent []url.Values
+	backend := BackendFunc(func(method, path string, params url.Values, v interface{}) error {
+		sent = append(sent, params)
+		if path == "/payment_methods" {
+			return json.Unmarshal([]byte(`{"id":"pm_1","type":"sepa_debit","sepa_debit":{"bank_code":"37040044","country":"DE","last4":"3000"},
+				"billing_details":{"name":"Jenny Rosen","email":"jenny@example.com"}}`), v)
+		}
+		return json.Unmarshal([]byte(`{"id":"pi_1","status":"requires_action","payment_method_types":["multibanco"],
+			"next_action":{"type":"multibanco_display_details","multibanco_display_details":{"entity":"12345","reference":"123456789","expires_at":1700000000}}}`), v)
+	})
+
+	pm, err := PaymentMethods.With(WithBackend(backend)).Create(&PaymentMethodParams{
+		Type:           PaymentMethodTypeSEPADebit,
+		BillingDetails: &BillingDetails{Name: "Jenny Rosen", Email: "jenny@example.com"},
+		SEPADebit:      &SEPADebitParams{IBAN: "DE89370400440532013000"},
+	})
+	if err != nil || pm.SEPADebit.Last4 != "3000" || pm.BillingDetails.Name != "Jenny Rosen" {
+		t.Errorf("Expected a SEPA Debit PaymentMethod, got %+v and %v", pm, err)
+	}
+	if sent[0].Get("type") != "sepa_debit" || sent[0].Get("sepa_debit[iban]") != "DE89370400440532013000" || sent[0].Get("billing_details[email]") != "jenny@example.com" {
+		t.Errorf("Expected the account details to be sent, got %v", sent[0])
+	}
+
+	_, err = PaymentIntents.With(WithBackend(backend)).Create(&PaymentIntentParams{
+		Amount: 1000, Currency: USD, Confirm: Bool(true),
+		PaymentMethodTypes: []string{PaymentMethodTypeUSBankAccount},
+		PaymentMethodData: &PaymentMethodParams{
+			Type:           PaymentMethodTypeUSBankAccount,
+			BillingDetails: &BillingDetails{Name: "Jenny Rosen"},
+			USBankAccount:  &USBankAccountParams{AccountNumber: "000123456789", RoutingNumber: "110000000", AccountHolderType: String("individual")},
+		},
+		MandateData: &MandateData{Type: "online", IPAddress: "127.0.0.1", UserAgent: "Mozilla/5.0"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := sent[1]
+	if values.Get("payment_method_types[]") != "us_bank_account" || values.Get("payment_method_data[type]") != "us_bank_account" ||
+		values.Get("payment_method_data[us_bank_account][routing_number]") != "110000000" || values.Get("payment_method_data[billing_details][name]") != "Jenny Rosen" {
+		t.Errorf("Expected the payment method data to be sent, got %v", values)
+	}
+	if values.Get("mandate_data[customer_acceptance][type]") != "online" || values.Get("mandate_data[customer_acceptance][online][ip_address]") != "127.0.0.1" {
+		t.Errorf("Expected the mandate acceptance to be sent, got %v", values)
+	}
+
+	pi, err := PaymentIntents.With(WithBackend(backend)).Create(&PaymentIntentParams{Amount: 1000, Currency: EUR,
+		PaymentMethodTypes: []string{PaymentMethodTypeMultibanco}, PaymentMethodData: &PaymentMethodParams{Type: PaymentMethodTypeMultibanco}})
+	if err != nil || pi.NextAction.MultibancoDisplayDetails.Reference != "123456789" {
+		t.Errorf("Expected the Multibanco voucher reference, got %+v and %v", pi, err)
+	}
+}
+
+// TestDirectDebits will test that Bacs and BECS Direct Debit accounts are
+// created with their details, and that their mandates are decoded.
+func TestDirectDebits(t *testing.T) {
+	var sent url.Values
+	backend := BackendFunc(func(method, path string, params url.Values, v interface{}) error {
+		sent = params
+		if path == "/mandates/mandate_1" {
+			return json.Unmarshal([]byte(`{"id":"mandate_1","status":"active","type":"multi_use","payment_method":"pm_1",
+				"customer_acceptance":{"type":"offline","accepted_at":1700000000},
+				"payment_method_details":{"type":"bacs_debit","bacs_debit":{"network_status":"accepted","reference":"REF-1","url":"https://pay.stripe.com/bacs_mandate/1"}}}`), v)
+		}
+		return json.Unmarshal([]byte(`{"id":"pm_1","type":"au_becs_debit","au_becs_debit":{"bsb_number":"000000","last4":"3456"}}`), v)
+	})
+
+	pm, err := PaymentMethods.With(WithBackend(backend)).Create(&PaymentMethodParams{
+		Type:        PaymentMethodTypeAUBECSDebit,
+		AUBECSDebit: &AUBECSDebitParams{AccountNumber: "000123456", BSBNumber: "000000"},
+	})
+	if err != nil || pm.AUBECSDebit.BSBNumber != "000000" || pm.AUBECSDebit.Last4 != "3456" {
+		t.Errorf("Expected a BECS Direct Debit PaymentMethod, got %+v and %v", pm, err)
+	}
+	if sent.Get("au_becs_debit[account_number]") != "000123456" || sent.Get("au_becs_debit[bsb_number]") != "000000" {
+		t.Errorf("Expected the account details to be sent, got %v", sent)
+	}
+	PaymentMethods.With(WithBackend(backend)).Create(&PaymentMethodParams{
+		Type:      PaymentMethodTypeBACSDebit,
+		BACSDebit: &BACSDebitParams{AccountNumber: "00012345", SortCode: "108800"},
+	})
+	if sent.Get("bacs_debit[sort_code]") != "108800" || sent.Get("bacs_debit[account_number]") != "00012345" {
+		t.Errorf("Expected the account details to be sent, got %v", sent)
+	}
+
+	mandate, err := Mandates.With(WithBackend(backend)).Get("mandate_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bacs := mandate.PaymentMethodDetails.BACSDebit
+	if mandate.Status != MandateActive || bacs.NetworkStatus != BACSMandateAccepted || bacs.URL == "" || mandate.CustomerAcceptance.AcceptedAt == nil {
+		t.Errorf("Expected an active, accepted Bacs mandate, got %+v", mandate)
+	}
+}
+
+// TestWalletPaymentMethods will test that the actions wallets require the
+// customer to take are decoded, along with the details of their payments.
+func TestWalletPaymentMethods(t *testing.T) {
+	var sent url.Values
+	backend := BackendFunc(func(method, path string, params url.Values, v interface{}) error {
+		sent = params
+		return json.Unmarshal([]byte(`{"id":"pi_1","status":"requires_action","payment_method_types":["wechat_pay"],
+			"next_action":{"type":"wechat_pay_display_qr_code","wechat_pay_display_qr_code":{"data":"weixin://wxpay/bizpayurl?pr=1","image_url_png":"https://qr.stripe.com/1.png"}}}`), v)
+	})
+	pi, err := PaymentIntents.With(WithBackend(backend)).Create(&PaymentIntentParams{Amount: 1000, Currency: USD, Confirm: Bool(true),
+		PaymentMethodData: &PaymentMethodParams{Type: PaymentMethodTypeWeChatPay}, WeChatPayClient: String("web")})
+	if err != nil || pi.NextAction.WeChatPayDisplayQRCode.Data != "weixin://wxpay/bizpayurl?pr=1" {
+		t.Errorf("Expected a WeChat Pay QR code to show, got %+v and %v", pi, err)
+	}
+	if sent.Get("payment_method_data[type]") != "wechat_pay" || sent.Get("payment_method_options[wechat_pay][client]") != "web" {
+		t.Errorf("Expected a wechat_pay payment from the web, got %v", sent)
+	}
+
+	charge := Charge{}
+	body := `{"id":"ch_1","payment_method_details":{"type":"klarna","klarna":{"payment_method_category":"pay_later","preferred_locale":"en-US"}}}`
+	if err := json.Unmarshal([]byte(body), &charge); err != nil || charge.PaymentMethodDetails.Klarna.PaymentMethodCategory != "pay_later" {
+		t.Errorf("Expected Klarna details, got %+v and %v", charge.PaymentMethodDetails, err)
+	}
+	pi = &PaymentIntent{}
+	body = `{"id":"pi_2","next_action":{"type":"alipay_handle_redirect","alipay_handle_redirect":{"url":"https://hooks.stripe.com/alipay/1","return_url":"https://example.com/return"}}}`
+	if err := json.Unmarshal([]byte(body), pi); err != nil || pi.NextAction.AlipayHandleRedirect.URL != "https://hooks.stripe.com/alipay/1" {
+		t.Errorf("Expected an Alipay redirect, got %+v and %v", pi.NextAction, err)
+	}
+}
@@ -0,0 +1,180 @@
+package stripe
+
+import "net/url"
+
+// PayoutStatus is the status of a Payout.
+type PayoutStatus string
+
+// Payout Statuses
+const (
+	PayoutPaid      PayoutStatus = "paid"
+	PayoutPending   PayoutStatus = "pending"
+	PayoutInTransit PayoutStatus = "in_transit"
+	PayoutCanceled  PayoutStatus = "canceled"
+	PayoutFailed    PayoutStatus = "failed"
+)
+
+// Payout Methods
+const (
+	PayoutMethodStandard = "standard"
+	PayoutMethodInstant  = "instant"
+)
+
+// Payout represents funds sent from your Stripe balance to your bank account
+// or debit card.
+//
+// see https://stripe.com/docs/api#payout_object
+type Payout struct {
+	APIResource
+
+	ID                  string            `json:"id"`
+	Amount              int64             `json:"amount"`
+	ArrivalDate         UnixTime          `json:"arrival_date"`
+	Automatic           bool              `json:"automatic"`
+	BalanceTransaction  string            `json:"balance_transaction"`
+	Created             UnixTime          `json:"created"`
+	Currency            Currency          `json:"currency"`
+	Description         string            `json:"description,omitempty"`
+	Destination         string            `json:"destination"`
+	FailureCode         string            `json:"failure_code,omitempty"`
+	FailureMessage      string            `json:"failure_message,omitempty"`
+	Method              string            `json:"method"`
+	OriginalPayout      string            `json:"original_payout,omitempty"`
+	ReversedBy          string            `json:"reversed_by,omitempty"`
+	SourceType          string            `json:"source_type"`
+	StatementDescriptor string            `json:"statement_descriptor,omitempty"`
+	Status              PayoutStatus      `json:"status"`
+	Type                string            `json:"type"`
+	Metadata            map[string]string `json:"metadata,omitempty"`
+	Livemode            bool              `json:"livemode"`
+}
+
+// PayoutParams encapsulates options for creating and updating Payouts.
+type PayoutParams struct {
+	Params
+
+	// A positive integer in cents representing how much to pay out.
+	Amount int64 `form:"amount"`
+
+	// 3-letter ISO code for currency.
+	Currency Currency `form:"currency"`
+
+	// (Optional) An arbitrary string attached to the payout.
+	Description *string `form:"description"`
+
+	// (Optional) The ID of a bank account or card to send the payout to.
+	// Defaults to the default external account for the currency.
+	Destination *string `form:"destination"`
+
+	// (Optional) The balance type to pay out from: bank_account, card or fpx.
+	SourceType *string `form:"source_type"`
+
+	// (Optional) The method used to send the payout: standard (the default)
+	// or instant. Instant payouts are only supported for eligible debit cards
+	// and bank accounts, and draw from the instant_available balance.
+	Method *string `form:"method"`
+
+	// (Optional) A string displayed on the recipient's bank statement. This
+	// may be up to 22 characters.
+	StatementDescriptor *string `form:"statement_descriptor"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// PayoutListParams encapsulates options for filtering a list of Payouts.
+type PayoutListParams struct {
+	ListParams
+
+	// (Optional) Only return payouts with the given status.
+	Status PayoutStatus `form:"status"`
+
+	// (Optional) Only return payouts sent to the given external account.
+	Destination string `form:"destination"`
+
+	// (Optional) Only return payouts created within the range.
+	Created *DateRange `form:"created"`
+
+	// (Optional) Only return payouts expected to arrive within the range.
+	ArrivalDate *DateRange `form:"arrival_date"`
+
+	// (Optional) Only return payouts sent with the given method. The API does
+	// not support this filter, so it is applied to each page after it is
+	// retrieved and pages may contain fewer than Limit payouts.
+	Method string `form:"-"`
+}
+
+// PayoutClient encapsulates operations for creating, updating, canceling and
+// querying payouts using the Stripe REST API.
+type PayoutClient struct{ scope }
+
+// With returns a PayoutClient configured with the given options.
+func (c PayoutClient) With(opts ...ClientOption) PayoutClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Payout.
+//
+// see https://stripe.com/docs/api#create_payout
+func (c PayoutClient) Create(params *PayoutParams) (*Payout, error) {
+	res := &Payout{}
+	return res, c.query("POST", "/payouts", formValues(params), res)
+}
+
+// Retrieves the Payout with the given ID.
+//
+// see https://stripe.com/docs/api#retrieve_payout
+func (c PayoutClient) Get(id string) (*Payout, error) {
+	res := &Payout{}
+	return res, c.query("GET", "/payouts/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the metadata of the Payout with the given ID.
+//
+// see https://stripe.com/docs/api#update_payout
+func (c PayoutClient) Update(id string, params *PayoutParams) (*Payout, error) {
+	res := &Payout{}
+	return res, c.query("POST", "/payouts/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Cancels the Payout with the given ID, which is only possible while it is
+// still pending.
+//
+// see https://stripe.com/docs/api#cancel_payout
+func (c PayoutClient) Cancel(id string) (*Payout, error) {
+	res := &Payout{}
+	return res, c.query("POST", "/payouts/"+url.QueryEscape(id)+"/cancel", nil, res)
+}
+
+// Reverses the paid Payout with the given ID, returning the funds to your
+// balance by creating a new payout in the opposite direction.
+//
+// see https://stripe.com/docs/api#reverse_payout
+func (c PayoutClient) Reverse(id string) (*Payout, error) {
+	res := &Payout{}
+	return res, c.query("POST", "/payouts/"+url.QueryEscape(id)+"/reverse", nil, res)
+}
+
+// Returns a list of Payouts matching the given filters.
+//
+// see https://stripe.com/docs/api#list_payouts
+func (c PayoutClient) List(params *PayoutListParams) ([]*Payout, bool, error) {
+	res := struct {
+		ListObject
+		Data []*Payout
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/payouts", values, &res)
+	if err != nil || params.Method == "" {
+		return res.Data, res.More, err
+	}
+
+	payouts := make([]*Payout, 0, len(res.Data))
+	for _, p := range res.Data {
+		if p.Method == params.Method {
+			payouts = append(payouts, p)
+		}
+	}
+	return payouts, res.More, nil
+}
@@ -0,0 +1,70 @@
+package stripe
+
+import (
+	"testing"
+)
+
+// TestCreatePayout will test that we can pay out funds from the available
+// balance, and find the payout again by its status.
+func TestCreatePayout(t *testing.T) {
+	payout, err := Payouts.Create(&PayoutParams{
+		Amount:              100,
+		Currency:            USD,
+		StatementDescriptor: String("VANDELAY"),
+	})
+	if err != nil {
+		t.Errorf("Expected Payout, got Error %s", err.Error())
+		return
+	}
+	if payout.Amount != 100 {
+		t.Errorf("Expected Payout Amount 100, got %d", payout.Amount)
+	}
+	if payout.Automatic {
+		t.Errorf("Expected manual Payout")
+	}
+
+	payouts, _, err := Payouts.List(&PayoutListParams{Status: payout.Status})
+	if err != nil {
+		t.Errorf("Expected Payout List, got Error %s", err.Error())
+	}
+	if len(payouts) == 0 {
+		t.Errorf("Expected at least 1 Payout, got 0")
+	}
+}
+
+// TestCancelPayout will test that a pending Payout can be canceled.
+func TestCancelPayout(t *testing.T) {
+	resp, err := Payouts.Create(&PayoutParams{Amount: 100, Currency: USD})
+	if err != nil {
+		t.Errorf("Expected Payout, got Error %s", err.Error())
+		return
+	}
+
+	payout, err := Payouts.Cancel(resp.ID)
+	if err != nil {
+		t.Errorf("Expected Payout cancellation, got Error %s", err.Error())
+		return
+	}
+	if payout.Status != PayoutCanceled {
+		t.Errorf("Expected Payout Status %s, got %s", PayoutCanceled, payout.Status)
+	}
+}
+
+// TestListPayoutsByMethod will test that filtering by method only returns
+// payouts sent with that method.
+func TestListPayoutsByMethod(t *testing.T) {
+	if _, err := Payouts.Create(&PayoutParams{Amount: 100, Currency: USD, Method: String(PayoutMethodStandard)}); err != nil {
+		t.Errorf("Expected Payout, got Error %s", err.Error())
+		return
+	}
+
+	payouts, _, err := Payouts.List(&PayoutListParams{Method: PayoutMethodStandard})
+	if err != nil {
+		t.Errorf("Expected Payout List, got Error %s", err.Error())
+	}
+	for _, p := range payouts {
+		if p.Method != PayoutMethodStandard {
+			t.Errorf("Expected Payout Method %s, got %s", PayoutMethodStandard, p.Method)
+		}
+	}
+}
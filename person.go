@@ -0,0 +1,174 @@
+package stripe
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Person represents an owner, director, executive or representative of a
+// connected account's business, whose identity must be verified.
+//
+// see https://stripe.com/docs/api#person_object
+type Person struct {
+	APIResource
+
+	ID               string               `json:"id"`
+	Account          string               `json:"account"`
+	FirstName        string               `json:"first_name,omitempty"`
+	LastName         string               `json:"last_name,omitempty"`
+	Email            string               `json:"email,omitempty"`
+	Phone            string               `json:"phone,omitempty"`
+	DOB              *DOB                 `json:"dob,omitempty"`
+	Address          *Address             `json:"address,omitempty"`
+	Relationship     *Relationship        `json:"relationship,omitempty"`
+	Requirements     *AccountRequirements `json:"requirements,omitempty"`
+	Verification     *PersonVerification  `json:"verification,omitempty"`
+	IDNumberProvided bool                 `json:"id_number_provided"`
+	SSNLast4Provided bool                 `json:"ssn_last_4_provided"`
+	Created          UnixTime             `json:"created"`
+	Metadata         map[string]string    `json:"metadata,omitempty"`
+}
+
+// DOB is a date of birth.
+type DOB struct {
+	Day   int `json:"day" form:"day"`
+	Month int `json:"month" form:"month"`
+	Year  int `json:"year" form:"year"`
+}
+
+// Relationship describes how a Person is related to the account's business.
+type Relationship struct {
+	Director         bool    `json:"director"`
+	Executive        bool    `json:"executive"`
+	Owner            bool    `json:"owner"`
+	Representative   bool    `json:"representative"`
+	PercentOwnership float64 `json:"percent_ownership,omitempty"`
+	Title            string  `json:"title,omitempty"`
+}
+
+// PersonVerification holds the state of a Person's identity verification.
+type PersonVerification struct {
+	Status      string                `json:"status"`
+	Details     string                `json:"details,omitempty"`
+	DetailsCode string                `json:"details_code,omitempty"`
+	Document    *VerificationDocument `json:"document,omitempty"`
+}
+
+// VerificationDocument references the uploaded files of an identity document.
+type VerificationDocument struct {
+	Front string `json:"front,omitempty" form:"front"`
+	Back  string `json:"back,omitempty" form:"back"`
+}
+
+// PersonParams encapsulates options for creating and updating Persons.
+type PersonParams struct {
+	Params
+
+	// (Optional) The person's first name.
+	FirstName *string `form:"first_name"`
+
+	// (Optional) The person's last name.
+	LastName *string `form:"last_name"`
+
+	// (Optional) The person's email address.
+	Email *string `form:"email"`
+
+	// (Optional) The person's phone number.
+	Phone *string `form:"phone"`
+
+	// (Optional) The person's date of birth.
+	DOB *DOB `form:"dob"`
+
+	// (Optional) The person's home address.
+	Address *Address `form:"address"`
+
+	// (Optional) The person's government-issued ID number, such as their SSN.
+	IDNumber *string `form:"id_number"`
+
+	// (Optional) The last four digits of the person's Social Security number
+	// (U.S. only).
+	SSNLast4 *string `form:"ssn_last_4"`
+
+	// (Optional) The relationship the person has to the account's business.
+	// Boolean fields left nil are not sent.
+	Relationship *RelationshipParams `form:"relationship"`
+
+	// (Optional) IDs of uploaded files (with purpose identity_document) for
+	// the front and back of the person's identity document.
+	Document *VerificationDocument `form:"verification[document]"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// RelationshipParams encapsulates options for setting a Person's relationship
+// to the account's business.
+type RelationshipParams struct {
+	Director         *bool    `form:"director"`
+	Executive        *bool    `form:"executive"`
+	Owner            *bool    `form:"owner"`
+	Representative   *bool    `form:"representative"`
+	PercentOwnership *float64 `form:"percent_ownership"`
+	Title            string   `form:"title"`
+}
+
+// PersonClient encapsulates operations for creating, updating, deleting and
+// querying the persons of a connected account using the Stripe REST API.
+type PersonClient struct{ scope }
+
+// With returns a PersonClient configured with the given options.
+func (c PersonClient) With(opts ...ClientOption) PersonClient {
+	c.apply(opts)
+	return c
+}
+
+func (c PersonClient) path(accountID, personID string) string {
+	p := fmt.Sprintf("/accounts/%s/persons", url.QueryEscape(accountID))
+	if personID != "" {
+		p += "/" + url.QueryEscape(personID)
+	}
+	return p
+}
+
+// Creates a new Person on the given connected account.
+//
+// see https://stripe.com/docs/api#create_person
+func (c PersonClient) Create(accountID string, params *PersonParams) (*Person, error) {
+	res := &Person{}
+	return res, c.query("POST", c.path(accountID, ""), formValues(params), res)
+}
+
+// Retrieves the Person with the given ID.
+//
+// see https://stripe.com/docs/api#retrieve_person
+func (c PersonClient) Get(accountID, personID string) (*Person, error) {
+	res := &Person{}
+	return res, c.query("GET", c.path(accountID, personID), nil, res)
+}
+
+// Updates the Person with the given ID.
+//
+// see https://stripe.com/docs/api#update_person
+func (c PersonClient) Update(accountID, personID string, params *PersonParams) (*Person, error) {
+	res := &Person{}
+	return res, c.query("POST", c.path(accountID, personID), formValues(params), res)
+}
+
+// Deletes the Person with the given ID.
+//
+// see https://stripe.com/docs/api#delete_person
+func (c PersonClient) Delete(accountID, personID string) (*Deleted, error) {
+	res := &Deleted{}
+	return res, c.query("DELETE", c.path(accountID, personID), nil, res)
+}
+
+// Returns a list of the Persons associated with the given connected account.
+//
+// see https://stripe.com/docs/api#list_persons
+func (c PersonClient) List(accountID string, limit int, before, after string) ([]*Person, bool, error) {
+	res := struct {
+		ListObject
+		Data []*Person
+	}{}
+	err := c.query("GET", c.path(accountID, ""), listParams(limit, before, after), &res)
+	return res.Data, res.More, err
+}
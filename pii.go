@@ -0,0 +1,78 @@
+package stripe
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// the key email addresses are hashed with when resources are printed, if any
+var _emailHashKey []byte
+
+// SetEmailHashKey will print the email addresses of Customers and Persons
+// formatted with their String methods as an HMAC-SHA256 of the address,
+// keyed with key, rather than redacting them, so log lines about the same
+// customer can be correlated without the address being logged. The hash is
+// of the address in lower case. Setting it to nil, the default, redacts them.
+func SetEmailHashKey(key []byte) {
+	_emailHashKey = key
+}
+
+// String formats the Card for logs with the cardholder's name and address
+// left out, identifying the card by its brand and last 4 digits only.
+func (c Card) String() string {
+	var exp string
+	if c.ExpYear != 0 {
+		exp = fmt.Sprintf("%02d/%d", c.ExpMonth, c.ExpYear)
+	}
+	return piiString("Card", "ID", c.ID, "Brand", c.Brand, "Last4", c.Last4, "Exp", exp, "Customer", c.Customer)
+}
+
+// String formats the Customer for logs with their email address masked, as
+// set with SetEmailHashKey, and their phone number, address and description
+// left out.
+func (c Customer) String() string {
+	return piiString("Customer", "ID", c.ID, "Email", maskEmail(c.Email), "Currency", string(c.Currency))
+}
+
+// String formats the BankAccount for logs with the holder's name and the
+// routing number left out, identifying the account by its last 4 digits only.
+func (b BankAccount) String() string {
+	return piiString("BankAccount", "ID", b.ID, "BankName", b.BankName, "Country", b.Country,
+		"Currency", string(b.Currency), "Last4", b.Last4)
+}
+
+// String formats the Person for logs with their email address masked, as set
+// with SetEmailHashKey, and their name, phone number, date of birth and
+// address left out.
+func (p Person) String() string {
+	return piiString("Person", "ID", p.ID, "Account", p.Account, "Email", maskEmail(p.Email))
+}
+
+// piiString formats the named resource with the given pairs of field names
+// and values, leaving out empty values.
+func piiString(name string, fields ...string) string {
+	var pairs []string
+	for i := 0; i+1 < len(fields); i += 2 {
+		if fields[i+1] != "" {
+			pairs = append(pairs, fields[i]+":"+fields[i+1])
+		}
+	}
+	return name + "{" + strings.Join(pairs, " ") + "}"
+}
+
+// maskEmail returns the email address redacted, or hashed with the key set
+// with SetEmailHashKey.
+func maskEmail(email string) string {
+	if email == "" {
+		return ""
+	}
+	if _emailHashKey == nil {
+		return redacted
+	}
+	mac := hmac.New(sha256.New, _emailHashKey)
+	mac.Write([]byte(strings.ToLower(email)))
+	return "hmac:" + hex.EncodeToString(mac.Sum(nil))[:16]
+}
@@ -0,0 +1,37 @@
+package stripe
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestStringRedactsPII will test that resources print without the personal
+// details of the customer, and with their email address redacted or hashed.
+func TestStringRedactsPII(t *testing.T) {
+	card := &Card{ID: "card_1", Name: "Jane Doe", Brand: "Visa", Last4: "4242", ExpMonth: 4, ExpYear: 2030, Address1: "1 Main St", Fingerprint: "fp_1"}
+	if got, want := fmt.Sprintf("%v", card), "Card{ID:card_1 Brand:Visa Last4:4242 Exp:04/2030}"; got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+	account := BankAccount{ID: "ba_1", AccountHolderName: "Jane Doe", BankName: "STRIPE TEST BANK", Country: "US", Currency: USD, Last4: "6789", RoutingNumber: "110000000"}
+	if got := fmt.Sprint(account); strings.Contains(got, "Jane") || strings.Contains(got, "110000000") || !strings.Contains(got, "Last4:6789") {
+		t.Errorf("Expected the holder and routing number to be left out, got %s", got)
+	}
+
+	customer := &Customer{ID: "cus_1", Email: "Jane@example.com", Phone: "+15555550100"}
+	person := Person{ID: "person_1", FirstName: "Jane", Email: "jane@example.com"}
+	if got, want := customer.String(), "Customer{ID:cus_1 Email:REDACTED}"; got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+
+	SetEmailHashKey([]byte("secret"))
+	defer SetEmailHashKey(nil)
+	got := fmt.Sprintf("%s %s", customer, person)
+	if strings.Contains(got, "example.com") || strings.Contains(got, "Jane") || strings.Contains(got, "+1555") {
+		t.Errorf("Expected no personal details, got %s", got)
+	}
+	hash := strings.TrimSuffix(strings.SplitN(customer.String(), "Email:", 2)[1], "}")
+	if !strings.HasPrefix(hash, "hmac:") || !strings.Contains(person.String(), hash) {
+		t.Errorf("Expected the same address to hash alike regardless of case, got %s", got)
+	}
+}
@@ -0,0 +1,80 @@
+package stripe
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"net/http"
+)
+
+var (
+	// InvalidPinError is returned by SetPinnedCertificates for a pin that is
+	// not a base64-encoded SHA-256 digest.
+	InvalidPinError = errors.New("stripe: certificate pin must be a base64-encoded SHA-256 digest")
+
+	// PinMismatchError is returned for requests to a server whose certificate
+	// chain holds none of the keys pinned with SetPinnedCertificates.
+	PinMismatchError = errors.New("stripe: server certificate matches no pinned key")
+)
+
+// CertificatePin returns the pin of the certificate's public key for
+// SetPinnedCertificates: the base64-encoded SHA-256 digest of its
+// SubjectPublicKeyInfo, as printed by
+//
+//	openssl x509 -pubkey -noout | openssl pkey -pubin -outform der | openssl dgst -sha256 -binary | base64
+func CertificatePin(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// SetPinnedCertificates will restrict connections to the Stripe API to
+// servers whose verified certificate chain holds one of the given public
+// keys, as returned by CertificatePin. Pinning an intermediate or root CA
+// rather than the server's own certificate survives Stripe renewing it.
+// Calling it without pins removes them.
+//
+// Pins are checked in addition to the usual verification, so to also trust
+// only certain CAs, call SetTLSConfig with their RootCAs first: SetTLSConfig
+// replaces the configuration pins are added to.
+func SetPinnedCertificates(pins ...string) error {
+	pinned := make(map[string]bool, len(pins))
+	for _, pin := range pins {
+		if b, err := base64.StdEncoding.DecodeString(pin); err != nil || len(b) != sha256.Size {
+			return InvalidPinError
+		}
+		pinned[pin] = true
+	}
+	return setTransport(func(t *http.Transport) {
+		if t.TLSClientConfig != nil {
+			t.TLSClientConfig = t.TLSClientConfig.Clone()
+		} else {
+			t.TLSClientConfig = &tls.Config{}
+		}
+		if len(pinned) == 0 {
+			t.TLSClientConfig.VerifyConnection = nil
+			return
+		}
+		t.TLSClientConfig.VerifyConnection = func(cs tls.ConnectionState) error {
+			return verifyPins(cs, pinned)
+		}
+	})
+}
+
+// verifyPins checks that a certificate of the verified chains of cs, or of
+// the chain the server presented if verification is skipped, is pinned.
+func verifyPins(cs tls.ConnectionState, pinned map[string]bool) error {
+	chains := cs.VerifiedChains
+	if len(chains) == 0 {
+		chains = [][]*x509.Certificate{cs.PeerCertificates}
+	}
+	for _, chain := range chains {
+		for _, cert := range chain {
+			if pinned[CertificatePin(cert)] {
+				return nil
+			}
+		}
+	}
+	return PinMismatchError
+}
@@ -0,0 +1,55 @@
+package stripe
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestPinnedCertificates will test that connections are only made to servers
+// whose certificate chain holds a pinned key.
+func TestPinnedCertificates(t *testing.T) {
+	defer SetHTTPClient(nil)
+	SetHTTPClient(&http.Client{Timeout: time.Minute})
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"ch_1"}`))
+	}))
+	defer server.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	if err := SetTLSConfig(&tls.Config{RootCAs: pool}); err != nil {
+		t.Fatal(err)
+	}
+
+	other := strings.Repeat("A", 43) + "="
+	if err := SetPinnedCertificates(other); err != nil {
+		t.Fatalf("Expected certificates to be pinned, got Error %s", err.Error())
+	}
+	if _, err := Charges.With(WithBaseURL(server.URL)).Get("ch_1"); !errors.Is(err, PinMismatchError) {
+		t.Errorf("Expected PinMismatchError for an unpinned server, got %v", err)
+	}
+
+	if err := SetPinnedCertificates(other, CertificatePin(server.Certificate())); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Charges.With(WithBaseURL(server.URL)).Get("ch_1"); err != nil {
+		t.Errorf("Expected the pinned server to be trusted, got %v", err)
+	}
+	if tr := _httpClient.Transport.(*http.Transport); tr.TLSClientConfig.RootCAs != pool {
+		t.Errorf("Expected the TLS config's root CAs to be kept")
+	}
+
+	if err := SetPinnedCertificates("not a pin"); err != InvalidPinError {
+		t.Errorf("Expected InvalidPinError, got %v", err)
+	}
+	if err := SetPinnedCertificates(); err != nil || _httpClient.Transport.(*http.Transport).TLSClientConfig.VerifyConnection != nil {
+		t.Errorf("Expected pins to be removed, got %v", err)
+	}
+}
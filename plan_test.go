@@ -31,7 +31,7 @@ var (
 		Amount:          9,
 		Currency:        USD,
 		Interval:        IntervalMonth,
-		TrialPeriodDays: 365,
+		TrialPeriodDays: Int64(365),
 	}
 )
 
@@ -107,9 +107,9 @@ func TestRetrievePlan(t *testing.T) {
 	if plan.Currency != p2.Currency {
 		t.Errorf("Expected Plan Currency %v, got %v", p2.Currency, plan.Currency)
 	}
-	if plan.TrialPeriodDays != p2.TrialPeriodDays {
+	if plan.TrialPeriodDays != int(*p2.TrialPeriodDays) {
 		t.Errorf("Expected Plan Trial Period %v, got %v",
-			p2.TrialPeriodDays, plan.TrialPeriodDays)
+			*p2.TrialPeriodDays, plan.TrialPeriodDays)
 	}
 }
 
@@ -136,11 +136,11 @@ func TestDeletePlan(t *testing.T) {
 	Plans.Create(&p1)
 
 	// let's try to delete the plan
-	ok, err := Plans.Delete(p1.ID)
+	deleted, err := Plans.Delete(p1.ID)
 	if err != nil {
 		t.Errorf("Expected Plan deletion, got Error %s", err.Error())
 	}
-	if !ok {
+	if !deleted.Deleted {
 		t.Errorf("Expected Plan deletion true, got false")
 	}
 }
@@ -167,3 +167,16 @@ func TestListPlan(t *testing.T) {
 		t.Errorf("Expected 2 Plans, got %d", len(plans))
 	}
 }
+
+// TestPlanTrialPeriodDaysZero will test that an explicit trial period of 0
+// days is sent, while an unset trial period is not.
+func TestPlanTrialPeriodDaysZero(t *testing.T) {
+	values := formValues(&PlanParams{ID: "plan1", TrialPeriodDays: Int64(0)})
+	if got, ok := values["trial_period_days"]; !ok || got[0] != "0" {
+		t.Errorf("Expected trial_period_days 0, got %v", got)
+	}
+	values = formValues(&PlanParams{ID: "plan1"})
+	if _, ok := values["trial_period_days"]; ok {
+		t.Errorf("Expected trial_period_days not to be sent")
+	}
+}
@@ -0,0 +1,49 @@
+package stripe
+
+// Price represents how much and how often to charge for a product. Prices
+// replace Plans in newer integrations, and appear on Quote line items.
+//
+// see https://stripe.com/docs/api/prices/object
+type Price struct {
+	ID         string            `json:"id"`
+	Active     bool              `json:"active"`
+	Created    UnixTime          `json:"created"`
+	Currency   Currency          `json:"currency"`
+	Nickname   string            `json:"nickname,omitempty"`
+	Product    string            `json:"product"`
+	Recurring  *PriceRecurring   `json:"recurring,omitempty"`
+	Type       string            `json:"type"`
+	UnitAmount int64             `json:"unit_amount"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+	Livemode   bool              `json:"livemode"`
+
+	// The amounts to charge in currencies other than Currency, for customers
+	// paying in them. Only returned when expanded with "currency_options".
+	CurrencyOptions map[Currency]*PriceCurrencyOption `json:"currency_options,omitempty"`
+}
+
+// PriceCurrencyOption is how much a Price charges in one of its additional
+// currencies.
+type PriceCurrencyOption struct {
+	TaxBehavior string `json:"tax_behavior,omitempty"`
+	UnitAmount  int64  `json:"unit_amount"`
+}
+
+// UnitAmountFor returns the unit amount to charge a customer paying in
+// currency, and the currency it is in: the amount of the Price's currency
+// option for currency if it has one, and otherwise its default UnitAmount
+// and Currency.
+func (p *Price) UnitAmountFor(currency Currency) (int64, Currency) {
+	if currency != p.Currency {
+		if o, ok := p.CurrencyOptions[currency]; ok && o != nil {
+			return o.UnitAmount, currency
+		}
+	}
+	return p.UnitAmount, p.Currency
+}
+
+// PriceRecurring describes the billing interval of a recurring Price.
+type PriceRecurring struct {
+	Interval      string `json:"interval"`
+	IntervalCount int    `json:"interval_count"`
+}
@@ -0,0 +1,29 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPriceUnitAmountFor(t *testing.T) {
+	price := &Price{}
+	data := `{"id":"price_1","currency":"usd","unit_amount":1000,
+		"currency_options":{"eur":{"unit_amount":900,"tax_behavior":"inclusive"},"usd":{"unit_amount":1000}}}`
+	if err := json.Unmarshal([]byte(data), price); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		currency Currency
+		amount   int64
+		in       Currency
+	}{
+		{EUR, 900, EUR},
+		{USD, 1000, USD},
+		{GBP, 1000, USD},
+	}
+	for _, test := range tests {
+		if amount, in := price.UnitAmountFor(test.currency); amount != test.amount || in != test.in {
+			t.Errorf("Expected %d %s for %s, got %d %s", test.amount, test.in, test.currency, amount, in)
+		}
+	}
+}
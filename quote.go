@@ -0,0 +1,215 @@
+package stripe
+
+import (
+	"io"
+	"net/url"
+)
+
+// Quote Statuses
+const (
+	QuoteDraft    = "draft"
+	QuoteOpen     = "open"
+	QuoteAccepted = "accepted"
+	QuoteCanceled = "canceled"
+)
+
+// Quote represents a priced offer sent to a customer which, once accepted,
+// creates an Invoice or Subscription.
+//
+// see https://stripe.com/docs/api/quotes/object
+type Quote struct {
+	APIResource
+
+	ID                string                  `json:"id"`
+	AmountSubtotal    int64                   `json:"amount_subtotal"`
+	AmountTotal       int64                   `json:"amount_total"`
+	CollectionMethod  string                  `json:"collection_method"`
+	Created           UnixTime                `json:"created"`
+	Currency          Currency                `json:"currency"`
+	Customer          string                  `json:"customer"`
+	Description       string                  `json:"description,omitempty"`
+	ExpiresAt         UnixTime                `json:"expires_at"`
+	Footer            string                  `json:"footer,omitempty"`
+	Header            string                  `json:"header,omitempty"`
+	Invoice           string                  `json:"invoice,omitempty"`
+	Number            string                  `json:"number,omitempty"`
+	Status            string                  `json:"status"`
+	StatusTransitions *QuoteStatusTransitions `json:"status_transitions"`
+	Subscription      string                  `json:"subscription,omitempty"`
+	TotalDetails      *QuoteTotalDetails      `json:"total_details"`
+	Metadata          map[string]string       `json:"metadata,omitempty"`
+	Livemode          bool                    `json:"livemode"`
+}
+
+// QuoteStatusTransitions records when a Quote changed status.
+type QuoteStatusTransitions struct {
+	AcceptedAt  *UnixTime `json:"accepted_at,omitempty"`
+	CanceledAt  *UnixTime `json:"canceled_at,omitempty"`
+	FinalizedAt *UnixTime `json:"finalized_at,omitempty"`
+}
+
+// QuoteTotalDetails breaks down the difference between a Quote's subtotal and
+// total.
+type QuoteTotalDetails struct {
+	AmountDiscount int64 `json:"amount_discount"`
+	AmountShipping int64 `json:"amount_shipping"`
+	AmountTax      int64 `json:"amount_tax"`
+}
+
+// QuoteLineItem is a single item priced on a Quote.
+type QuoteLineItem struct {
+	APIResource
+
+	ID             string   `json:"id"`
+	AmountSubtotal int64    `json:"amount_subtotal"`
+	AmountTotal    int64    `json:"amount_total"`
+	Currency       Currency `json:"currency"`
+	Description    string   `json:"description"`
+	Price          *Price   `json:"price"`
+	Quantity       int      `json:"quantity"`
+}
+
+// QuoteLineItemParams describes an item to add to a Quote.
+type QuoteLineItemParams struct {
+	// The ID of the Price to charge.
+	Price string `form:"price"`
+
+	// (Optional) The quantity. Defaults to 1.
+	Quantity *int64 `form:"quantity"`
+}
+
+// QuoteParams encapsulates options for creating and updating Quotes.
+type QuoteParams struct {
+	Params
+
+	// The ID of the Customer the quote is for.
+	Customer string `form:"customer"`
+
+	// (Optional) The items priced on the quote. Items passed on update
+	// replace the existing ones.
+	LineItems []QuoteLineItemParams `form:"line_items"`
+
+	// (Optional) Whether the resulting invoice is charged automatically
+	// (charge_automatically) or emailed to the customer (send_invoice).
+	CollectionMethod *string `form:"collection_method"`
+
+	// (Optional) Text shown on the quote PDF.
+	Description *string `form:"description"`
+	Header      *string `form:"header"`
+	Footer      *string `form:"footer"`
+
+	// (Optional) When the quote expires. Defaults to 30 days after creation.
+	ExpiresAt *UnixTime `form:"expires_at"`
+
+	// (Optional) Number of trial days for a resulting subscription.
+	TrialPeriodDays *int64 `form:"subscription_data[trial_period_days]"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// QuoteListParams encapsulates options for filtering a list of Quotes.
+type QuoteListParams struct {
+	ListParams
+
+	// (Optional) Only return quotes for the Customer with the given ID.
+	Customer string `form:"customer"`
+
+	// (Optional) Only return quotes with the given status.
+	Status string `form:"status"`
+}
+
+// QuoteClient encapsulates operations for creating, updating and querying
+// quotes using the Stripe REST API.
+type QuoteClient struct{ scope }
+
+// With returns a QuoteClient configured with the given options.
+func (c QuoteClient) With(opts ...ClientOption) QuoteClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new draft Quote.
+//
+// see https://stripe.com/docs/api/quotes/create
+func (c QuoteClient) Create(params *QuoteParams) (*Quote, error) {
+	res := &Quote{}
+	return res, c.query("POST", "/quotes", formValues(params), res)
+}
+
+// Retrieves the Quote with the given ID.
+//
+// see https://stripe.com/docs/api/quotes/retrieve
+func (c QuoteClient) Get(id string) (*Quote, error) {
+	res := &Quote{}
+	return res, c.query("GET", "/quotes/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the draft Quote with the given ID.
+//
+// see https://stripe.com/docs/api/quotes/update
+func (c QuoteClient) Update(id string, params *QuoteParams) (*Quote, error) {
+	res := &Quote{}
+	return res, c.query("POST", "/quotes/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Finalizes the draft Quote with the given ID so it can be sent to the
+// customer and accepted.
+//
+// see https://stripe.com/docs/api/quotes/finalize
+func (c QuoteClient) Finalize(id string) (*Quote, error) {
+	return c.action(id, "finalize")
+}
+
+// Accepts the open Quote with the given ID, creating its Invoice or
+// Subscription.
+//
+// see https://stripe.com/docs/api/quotes/accept
+func (c QuoteClient) Accept(id string) (*Quote, error) {
+	return c.action(id, "accept")
+}
+
+// Cancels the Quote with the given ID.
+//
+// see https://stripe.com/docs/api/quotes/cancel
+func (c QuoteClient) Cancel(id string) (*Quote, error) {
+	return c.action(id, "cancel")
+}
+
+// Returns a list of Quotes matching the params.
+//
+// see https://stripe.com/docs/api/quotes/list
+func (c QuoteClient) List(params *QuoteListParams) ([]*Quote, bool, error) {
+	res := struct {
+		ListObject
+		Data []*Quote
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/quotes", values, &res)
+	return res.Data, res.More, err
+}
+
+// Returns the line items of the Quote with the given ID.
+//
+// see https://stripe.com/docs/api/quotes/line_items
+func (c QuoteClient) LineItems(id string, limit int, before, after string) ([]*QuoteLineItem, bool, error) {
+	res := struct {
+		ListObject
+		Data []*QuoteLineItem
+	}{}
+	path := "/quotes/" + url.QueryEscape(id) + "/line_items"
+	err := c.query("GET", path, listParams(limit, before, after), &res)
+	return res.Data, res.More, err
+}
+
+// PDF writes the PDF of the finalized Quote with the given ID to w.
+//
+// see https://stripe.com/docs/api/quotes/pdf
+func (c QuoteClient) PDF(id string, w io.Writer) error {
+	return c.download(c.filesURL()+"/v1/quotes/"+url.QueryEscape(id)+"/pdf", w)
+}
+
+func (c QuoteClient) action(id, action string) (*Quote, error) {
+	res := &Quote{}
+	return res, c.query("POST", "/quotes/"+url.QueryEscape(id)+"/"+action, nil, res)
+}
@@ -0,0 +1,314 @@
+package stripe
+
+import "net/url"
+
+// EarlyFraudWarning represents an early fraud warning reported by a card
+// issuer for a Charge, which indicates it is likely to become a dispute.
+//
+// see https://stripe.com/docs/api/radar/early_fraud_warnings/object
+type EarlyFraudWarning struct {
+	APIResource
+
+	ID            string   `json:"id"`
+	Actionable    bool     `json:"actionable"`
+	Charge        string   `json:"charge"`
+	Created       UnixTime `json:"created"`
+	FraudType     string   `json:"fraud_type"`
+	PaymentIntent string   `json:"payment_intent,omitempty"`
+	Livemode      bool     `json:"livemode"`
+}
+
+// EarlyFraudWarningListParams encapsulates options for filtering a list of
+// Early Fraud Warnings.
+type EarlyFraudWarningListParams struct {
+	ListParams
+
+	// (Optional) Only return warnings for the Charge with the given ID.
+	Charge string `form:"charge"`
+
+	// (Optional) Only return warnings for the PaymentIntent with the given ID.
+	PaymentIntent string `form:"payment_intent"`
+
+	// (Optional) Only return warnings created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// EarlyFraudWarningClient encapsulates operations for querying early fraud
+// warnings using the Stripe REST API.
+type EarlyFraudWarningClient struct{ scope }
+
+// With returns an EarlyFraudWarningClient configured with the given options.
+func (c EarlyFraudWarningClient) With(opts ...ClientOption) EarlyFraudWarningClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Early Fraud Warning with the given ID.
+//
+// see https://stripe.com/docs/api/radar/early_fraud_warnings/retrieve
+func (c EarlyFraudWarningClient) Get(id string) (*EarlyFraudWarning, error) {
+	res := &EarlyFraudWarning{}
+	return res, c.query("GET", "/radar/early_fraud_warnings/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of Early Fraud Warnings matching the params.
+//
+// see https://stripe.com/docs/api/radar/early_fraud_warnings/list
+func (c EarlyFraudWarningClient) List(params *EarlyFraudWarningListParams) ([]*EarlyFraudWarning, bool, error) {
+	res := struct {
+		ListObject
+		Data []*EarlyFraudWarning
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/radar/early_fraud_warnings", values, &res)
+	return res.Data, res.More, err
+}
+
+// Returns a list of the Early Fraud Warnings for the Charge with the given ID.
+func (c EarlyFraudWarningClient) ChargeList(id string, limit int, before, after string) ([]*EarlyFraudWarning, bool, error) {
+	params := EarlyFraudWarningListParams{Charge: id}
+	params.Limit, params.Before, params.After = limit, before, after
+	return c.List(&params)
+}
+
+// Value List Item Types
+const (
+	ValueListCardBin             = "card_bin"
+	ValueListCardFingerprint     = "card_fingerprint"
+	ValueListCaseSensitiveString = "case_sensitive_string"
+	ValueListCountry             = "country"
+	ValueListCustomerID          = "customer_id"
+	ValueListEmail               = "email"
+	ValueListIPAddress           = "ip_address"
+	ValueListString              = "string"
+)
+
+// ValueList represents a Radar list of values, such as emails or card
+// fingerprints, that can be referenced in rules to block or allow payments.
+//
+// see https://stripe.com/docs/api/radar/value_lists/object
+type ValueList struct {
+	APIResource
+
+	ID        string             `json:"id"`
+	Alias     string             `json:"alias"`
+	Created   UnixTime           `json:"created"`
+	CreatedBy string             `json:"created_by"`
+	ItemType  string             `json:"item_type"`
+	ListItems *ValueListItemList `json:"list_items,omitempty"`
+	Name      string             `json:"name"`
+	Metadata  map[string]string  `json:"metadata,omitempty"`
+	Livemode  bool               `json:"livemode"`
+}
+
+// ValueListItem represents a single value in a Radar Value List.
+//
+// see https://stripe.com/docs/api/radar/value_list_items/object
+type ValueListItem struct {
+	APIResource
+
+	ID        string   `json:"id"`
+	Created   UnixTime `json:"created"`
+	CreatedBy string   `json:"created_by"`
+	Value     string   `json:"value"`
+	ValueList string   `json:"value_list"`
+	Livemode  bool     `json:"livemode"`
+}
+
+// ValueListItemList represents a list of Value List Items.
+type ValueListItemList struct {
+	ListObject
+	Data []*ValueListItem `json:"data"`
+}
+
+// ValueListParams encapsulates options for creating and updating Value Lists.
+type ValueListParams struct {
+	// The name used to reference the list in rules, e.g. "custom_blocklist".
+	Alias string `form:"alias"`
+
+	// A human-readable name for the list.
+	Name string `form:"name"`
+
+	// (Optional) Type of the items in the list, which can only be set on
+	// creation. Defaults to string.
+	ItemType string `form:"item_type"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// ValueListListParams encapsulates options for filtering a list of Value
+// Lists.
+type ValueListListParams struct {
+	ListParams
+
+	// (Optional) Only return the list with the given alias.
+	Alias string `form:"alias"`
+
+	// (Optional) Only return lists that contain the given value.
+	Contains string `form:"contains"`
+
+	// (Optional) Only return lists created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// ValueListClient encapsulates operations for creating, updating, deleting
+// and querying Radar value lists using the Stripe REST API.
+type ValueListClient struct{ scope }
+
+// With returns a ValueListClient configured with the given options.
+func (c ValueListClient) With(opts ...ClientOption) ValueListClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Value List.
+//
+// see https://stripe.com/docs/api/radar/value_lists/create
+func (c ValueListClient) Create(params *ValueListParams) (*ValueList, error) {
+	res := &ValueList{}
+	return res, c.query("POST", "/radar/value_lists", formValues(params), res)
+}
+
+// Retrieves the Value List with the given ID.
+//
+// see https://stripe.com/docs/api/radar/value_lists/retrieve
+func (c ValueListClient) Get(id string) (*ValueList, error) {
+	res := &ValueList{}
+	return res, c.query("GET", "/radar/value_lists/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the alias, name or metadata of the Value List with the given ID.
+//
+// see https://stripe.com/docs/api/radar/value_lists/update
+func (c ValueListClient) Update(id string, params *ValueListParams) (*ValueList, error) {
+	res := &ValueList{}
+	return res, c.query("POST", "/radar/value_lists/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Deletes the Value List with the given ID. Lists that are referenced in
+// rules cannot be deleted.
+//
+// see https://stripe.com/docs/api/radar/value_lists/delete
+func (c ValueListClient) Delete(id string) (*Deleted, error) {
+	res := &Deleted{}
+	return res, c.query("DELETE", "/radar/value_lists/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of Value Lists matching the params.
+//
+// see https://stripe.com/docs/api/radar/value_lists/list
+func (c ValueListClient) List(params *ValueListListParams) ([]*ValueList, bool, error) {
+	res := struct {
+		ListObject
+		Data []*ValueList
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/radar/value_lists", values, &res)
+	return res.Data, res.More, err
+}
+
+// ValueListItemListParams encapsulates options for filtering the items of a
+// Value List.
+type ValueListItemListParams struct {
+	ListParams
+
+	// (Optional) Only return items with the given value.
+	Value string `form:"value"`
+
+	// (Optional) Only return items created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// ValueListItemClient encapsulates operations for adding, removing and
+// querying the items of Radar value lists using the Stripe REST API.
+type ValueListItemClient struct{ scope }
+
+// With returns a ValueListItemClient configured with the given options.
+func (c ValueListItemClient) With(opts ...ClientOption) ValueListItemClient {
+	c.apply(opts)
+	return c
+}
+
+// Adds the value to the Value List with the given ID.
+//
+// see https://stripe.com/docs/api/radar/value_list_items/create
+func (c ValueListItemClient) Create(list, value string) (*ValueListItem, error) {
+	values := url.Values{"value_list": {list}, "value": {value}}
+	res := &ValueListItem{}
+	return res, c.query("POST", "/radar/value_list_items", values, res)
+}
+
+// Retrieves the Value List Item with the given ID.
+//
+// see https://stripe.com/docs/api/radar/value_list_items/retrieve
+func (c ValueListItemClient) Get(id string) (*ValueListItem, error) {
+	res := &ValueListItem{}
+	return res, c.query("GET", "/radar/value_list_items/"+url.QueryEscape(id), nil, res)
+}
+
+// Removes the Value List Item with the given ID from its list.
+//
+// see https://stripe.com/docs/api/radar/value_list_items/delete
+func (c ValueListItemClient) Delete(id string) (*Deleted, error) {
+	res := &Deleted{}
+	return res, c.query("DELETE", "/radar/value_list_items/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns the items of the Value List with the given ID matching the params.
+//
+// see https://stripe.com/docs/api/radar/value_list_items/list
+func (c ValueListItemClient) List(list string, params *ValueListItemListParams) ([]*ValueListItem, bool, error) {
+	res := struct {
+		ListObject
+		Data []*ValueListItem
+	}{}
+	values := formValues(params)
+	values.Add("value_list", list)
+
+	err := c.query("GET", "/radar/value_list_items", values, &res)
+	return res.Data, res.More, err
+}
+
+// RadarSession represents the device and browser signals gathered by
+// Stripe.js or the mobile SDKs, which can be attached to payments created
+// server-side so they benefit from Radar's client fraud signals.
+//
+// see https://stripe.com/docs/radar/radar-session
+type RadarSession struct {
+	APIResource
+
+	ID       string `json:"id"`
+	Object   string `json:"object"`
+	Livemode bool   `json:"livemode"`
+}
+
+// RadarOptions encapsulates the Radar settings sent with a new payment.
+type RadarOptions struct {
+	// (Optional) The ID of a Radar Session collected on the client.
+	Session string `form:"session"`
+
+	// (Optional) The IDs of Radar rules that should not be applied to the
+	// payment.
+	SkipRules []string `form:"skip_rules"`
+}
+
+// RadarSessionClient encapsulates operations for creating Radar sessions
+// using the Stripe REST API.
+type RadarSessionClient struct{ scope }
+
+// With returns a RadarSessionClient configured with the given options.
+func (c RadarSessionClient) With(opts ...ClientOption) RadarSessionClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Radar Session. Sessions are normally created on the client
+// with a publishable key; this is mainly useful in test mode.
+//
+// see https://stripe.com/docs/radar/radar-session
+func (c RadarSessionClient) Create() (*RadarSession, error) {
+	res := &RadarSession{}
+	return res, c.query("POST", "/radar/sessions", nil, res)
+}
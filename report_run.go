@@ -0,0 +1,173 @@
+package stripe
+
+import (
+	"errors"
+	"io"
+	"net/url"
+	"time"
+)
+
+// Report Run Statuses
+const (
+	ReportRunPending   = "pending"
+	ReportRunSucceeded = "succeeded"
+	ReportRunFailed    = "failed"
+)
+
+// ReportRun represents a single run of a financial report, whose result is a
+// CSV File once it has succeeded.
+//
+// see https://stripe.com/docs/api/reporting/report_run/object
+type ReportRun struct {
+	APIResource
+
+	ID          string               `json:"id"`
+	Created     UnixTime             `json:"created"`
+	Error       string               `json:"error,omitempty"`
+	Parameters  *ReportRunParameters `json:"parameters"`
+	ReportType  string               `json:"report_type"`
+	Result      *File                `json:"result,omitempty"`
+	Status      string               `json:"status"`
+	SucceededAt *UnixTime            `json:"succeeded_at,omitempty"`
+	Livemode    bool                 `json:"livemode"`
+}
+
+// ReportRunParameters holds the parameters a report is run with.
+type ReportRunParameters struct {
+	Columns           []string  `json:"columns,omitempty" form:"columns"`
+	ConnectedAccount  string    `json:"connected_account,omitempty" form:"connected_account"`
+	Currency          Currency  `json:"currency,omitempty" form:"currency"`
+	IntervalStart     *UnixTime `json:"interval_start,omitempty" form:"interval_start"`
+	IntervalEnd       *UnixTime `json:"interval_end,omitempty" form:"interval_end"`
+	Payout            string    `json:"payout,omitempty" form:"payout"`
+	ReportingCategory string    `json:"reporting_category,omitempty" form:"reporting_category"`
+	Timezone          string    `json:"timezone,omitempty" form:"timezone"`
+}
+
+// ReportRunParams encapsulates options for creating a new Report Run.
+type ReportRunParams struct {
+	Params
+
+	// The ID of the report type to run, e.g. "balance.summary.1".
+	ReportType string `form:"report_type"`
+
+	// (Optional) Parameters specifying how the report should be run. Which
+	// parameters are supported depends on the report type.
+	Parameters *ReportRunParameters `form:"parameters"`
+}
+
+// ReportType describes a kind of report that can be run, including the range
+// of data currently available for it.
+//
+// see https://stripe.com/docs/api/reporting/report_type/object
+type ReportType struct {
+	APIResource
+
+	ID                 string   `json:"id"`
+	Name               string   `json:"name"`
+	DataAvailableStart UnixTime `json:"data_available_start"`
+	DataAvailableEnd   UnixTime `json:"data_available_end"`
+	DefaultColumns     []string `json:"default_columns,omitempty"`
+	Updated            UnixTime `json:"updated"`
+	Version            int      `json:"version"`
+	Livemode           bool     `json:"livemode"`
+}
+
+// ReportRunClient encapsulates operations for creating and querying report
+// runs using the Stripe REST API.
+type ReportRunClient struct{ scope }
+
+// With returns a ReportRunClient configured with the given options.
+func (c ReportRunClient) With(opts ...ClientOption) ReportRunClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Report Run, which is processed asynchronously.
+//
+// see https://stripe.com/docs/api/reporting/report_run/create
+func (c ReportRunClient) Create(params *ReportRunParams) (*ReportRun, error) {
+	res := &ReportRun{}
+	return res, c.query("POST", "/reporting/report_runs", formValues(params), res)
+}
+
+// Retrieves the Report Run with the given ID.
+//
+// see https://stripe.com/docs/api/reporting/report_run/retrieve
+func (c ReportRunClient) Get(id string) (*ReportRun, error) {
+	res := &ReportRun{}
+	return res, c.query("GET", "/reporting/report_runs/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of your Report Runs with the specified range.
+//
+// see https://stripe.com/docs/api/reporting/report_run/list
+func (c ReportRunClient) List(limit int, before, after string) ([]*ReportRun, bool, error) {
+	res := struct {
+		ListObject
+		Data []*ReportRun
+	}{}
+	err := c.query("GET", "/reporting/report_runs", listParams(limit, before, after), &res)
+	return res.Data, res.More, err
+}
+
+// Wait polls the Report Run with the given ID every interval until it is no
+// longer pending, returning an error if the run failed.
+func (c ReportRunClient) Wait(id string, interval time.Duration) (*ReportRun, error) {
+	for {
+		run, err := c.Get(id)
+		if err != nil {
+			return run, err
+		}
+		switch run.Status {
+		case ReportRunSucceeded:
+			return run, nil
+		case ReportRunFailed:
+			return run, errors.New("stripe: report run failed: " + run.Error)
+		}
+		<-_clock.After(interval)
+	}
+}
+
+// Run creates a Report Run, waits for it to succeed, and writes the resulting
+// CSV to w.
+func (c ReportRunClient) Run(params *ReportRunParams, interval time.Duration, w io.Writer) (*ReportRun, error) {
+	run, err := c.Create(params)
+	if err != nil {
+		return run, err
+	}
+	if run, err = c.Wait(run.ID, interval); err != nil {
+		return run, err
+	}
+	return run, c.download(run.Result.URL, w)
+}
+
+// ReportTypeClient encapsulates operations for querying report types using the
+// Stripe REST API.
+type ReportTypeClient struct{ scope }
+
+// With returns a ReportTypeClient configured with the given options.
+func (c ReportTypeClient) With(opts ...ClientOption) ReportTypeClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Report Type with the given ID.
+//
+// see https://stripe.com/docs/api/reporting/report_type/retrieve
+func (c ReportTypeClient) Get(id string) (*ReportType, error) {
+	res := &ReportType{}
+	return res, c.query("GET", "/reporting/report_types/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of all available Report Types.
+//
+// see https://stripe.com/docs/api/reporting/report_type/list
+func (c ReportTypeClient) List() ([]*ReportType, error) {
+	res := struct {
+		ListObject
+		Data []*ReportType
+	}{}
+	err := c.query("GET", "/reporting/report_types", nil, &res)
+	return res.Data, err
+}
@@ -0,0 +1,80 @@
+package stripe
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// APIResource is embedded in each API resource and keeps the JSON the
+// resource was decoded from, so fields the library does not model yet are
+// still accessible and the object can be stored without losing data.
+type APIResource struct {
+	raw       json.RawMessage
+	requestID string
+}
+
+// RawJSON returns the JSON the resource was decoded from, exactly as Stripe
+// returned it. It is set on objects returned by the API and on each object
+// in a list, but not on objects nested inside them.
+func (r *APIResource) RawJSON() json.RawMessage {
+	return r.raw
+}
+
+// RequestID returns the ID Stripe assigned the request that returned the
+// resource, which identifies the call in the Dashboard's request logs and to
+// Stripe support. It is not set on objects in a list.
+func (r *APIResource) RequestID() string {
+	return r.requestID
+}
+
+func (r *APIResource) setRequestID(id string) {
+	r.requestID = id
+}
+
+type requestIDSetter interface {
+	setRequestID(string)
+}
+
+// setRawJSON keeps data, which must not be modified afterwards.
+func (r *APIResource) setRawJSON(data []byte) {
+	r.raw = data
+}
+
+type rawJSONSetter interface {
+	setRawJSON([]byte)
+}
+
+// keepRawJSON sets the raw JSON of v, which was decoded from body, to a copy
+// of body. If v is a page of a list, the raw JSON of each of its objects is
+// set instead.
+func keepRawJSON(v interface{}, body []byte) {
+	if r, ok := v.(rawJSONSetter); ok {
+		r.setRawJSON(append(json.RawMessage(nil), body...))
+		return
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return
+	}
+	data := rv.Elem().FieldByName("Data")
+	if !data.IsValid() || data.Kind() != reflect.Slice || data.Len() == 0 {
+		return
+	}
+	page := struct {
+		Data []json.RawMessage `json:"data"`
+	}{}
+	if json.Unmarshal(body, &page) != nil || len(page.Data) != data.Len() {
+		return
+	}
+	// each json.RawMessage is already a copy of the object's JSON
+	for i, raw := range page.Data {
+		item := data.Index(i)
+		if item.Kind() != reflect.Ptr {
+			item = item.Addr()
+		}
+		if r, ok := item.Interface().(rawJSONSetter); ok && !item.IsNil() {
+			r.setRawJSON(raw)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package stripe
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	mrand "math/rand"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// the number of times a failed request is retried
+var _maxRetries int
+
+// the delays before the first retry and the longest between any two
+// attempts
+const (
+	minRetryDelay = 500 * time.Millisecond
+	maxRetryDelay = 8 * time.Second
+)
+
+// SetMaxRetries will set how many times a request that failed because of a
+// network error, a lock conflict, rate limiting or a 5xx error from Stripe is
+// retried, with an exponential backoff between attempts. The default of 0
+// disables retries.
+//
+// Once retries are enabled, every POST is sent with an Idempotency-Key, unless
+// one was set with the WithIdempotencyKey option, and every attempt reuses
+// it so that a retried request never creates a second charge.
+func SetMaxRetries(n int) {
+	_maxRetries = n
+}
+
+// retries returns how many times failed requests made with the scope are
+// retried.
+func (s scope) retries() int {
+	if s.maxRetries != nil {
+		return *s.maxRetries
+	}
+	return _maxRetries
+}
+
+// shouldRetry reports whether a request that got the response r or failed
+// with err should be sent again.
+func shouldRetry(req *http.Request, r *http.Response, err error) bool {
+	if err != nil {
+		// only errors from the http.Client itself, e.g. a dropped connection,
+		// and not once the request's context is done
+		_, ok := err.(*url.Error)
+		return ok && req.Context().Err() == nil
+	}
+	switch r.Header.Get("Stripe-Should-Retry") {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	return r.StatusCode == http.StatusConflict || r.StatusCode == http.StatusTooManyRequests || r.StatusCode >= 500
+}
+
+// retryDelay returns how long to wait before retrying after the given number
+// of failed attempts, doubling each time up to maxRetryDelay, with jitter.
+func retryDelay(attempts int) time.Duration {
+	d := time.Duration(float64(minRetryDelay) * math.Pow(2, float64(attempts-1)))
+	if d > maxRetryDelay || d <= 0 {
+		d = maxRetryDelay
+	}
+	// wait between half and all of the delay, so that clients that failed
+	// together do not retry together
+	return d/2 + time.Duration(mrand.Int63n(int64(d/2)+1))
+}
+
+// waitRetry waits for the delay before the next attempt of req, returning an
+// error if its context is done first.
+func waitRetry(req *http.Request, d time.Duration) error {
+	select {
+	case <-_clock.After(d):
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
+
+// retryRequest returns a copy of req to send again, with a fresh body.
+func retryRequest(req *http.Request) (*http.Request, error) {
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
+	}
+	return retry, nil
+}
+
+// discard reads and closes the body of a response that will not be used, so
+// that its connection can be reused.
+func discard(r *http.Response) {
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+}
+
+// newIdempotencyKey returns a random version 4 UUID.
+func newIdempotencyKey() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic("stripe: cannot generate idempotency key: " + err.Error())
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -0,0 +1,66 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+)
+
+// instantClock fires every After immediately, recording the delays asked
+// for.
+type instantClock struct{ delays []time.Duration }
+
+func (c *instantClock) Now() time.Time { return time.Now() }
+
+func (c *instantClock) After(d time.Duration) <-chan time.Time {
+	c.delays = append(c.delays, d)
+	ch := make(chan time.Time, 1)
+	ch <- time.Now()
+	return ch
+}
+
+// TestRetry will test that a failed POST is retried with the same generated
+// Idempotency-Key, and that declines are not retried.
+func TestRetry(t *testing.T) {
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		r.ParseForm()
+		switch {
+		case r.Form.Get("amount") == "402":
+			w.WriteHeader(402)
+			w.Write([]byte(`{"error":{"type":"card_error","message":"Your card was declined."}}`))
+		case len(keys) == 1:
+			w.WriteHeader(503)
+			w.Write([]byte(`{"error":{"type":"api_error"}}`))
+		default:
+			w.Write([]byte(`{"id":"ch_1","amount":` + r.Form.Get("amount") + `}`))
+		}
+	}))
+	defer server.Close()
+	clock := &instantClock{}
+	defer SetClock(nil)
+	SetClock(clock)
+	defer SetMaxRetries(0)
+	SetMaxRetries(1)
+
+	charge, err := Charges.With(WithBaseURL(server.URL)).Create(&ChargeParams{Amount: 400, Currency: USD, Customer: String("cus_1")})
+	if err != nil || charge.Amount != 400 {
+		t.Fatalf("Expected Charge after a retry, got %v and %v", charge, err)
+	}
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	if len(keys) != 2 || keys[0] != keys[1] || !uuid.MatchString(keys[0]) {
+		t.Errorf("Expected 2 attempts with the same generated key, got %q", keys)
+	}
+	if len(clock.delays) != 1 || clock.delays[0] < minRetryDelay/2 || clock.delays[0] > minRetryDelay {
+		t.Errorf("Expected one backoff of up to %s, got %v", minRetryDelay, clock.delays)
+	}
+
+	keys = nil
+	_, err = Charges.With(WithBaseURL(server.URL), WithIdempotencyKey("order_6735")).Create(&ChargeParams{Amount: 402, Currency: USD, Customer: String("cus_1")})
+	if _, ok := err.(*Error); !ok || len(keys) != 1 || keys[0] != "order_6735" {
+		t.Errorf("Expected a single declined attempt with the given key, got %q and %v", keys, err)
+	}
+}
@@ -0,0 +1,94 @@
+package stripe
+
+import "net/url"
+
+// Review Reasons
+const (
+	ReviewRule            = "rule"
+	ReviewManual          = "manual"
+	ReviewApproved        = "approved"
+	ReviewRefunded        = "refunded"
+	ReviewRefundedAsFraud = "refunded_as_fraud"
+	ReviewDisputed        = "disputed"
+	ReviewRedacted        = "redacted"
+)
+
+// Review represents a payment that was placed in manual review by a Radar
+// rule, or opened manually from the Dashboard.
+//
+// see https://stripe.com/docs/api/radar/reviews/object
+type Review struct {
+	APIResource
+
+	ID                string             `json:"id"`
+	BillingZip        string             `json:"billing_zip,omitempty"`
+	Charge            string             `json:"charge,omitempty"`
+	ClosedReason      string             `json:"closed_reason,omitempty"`
+	Created           UnixTime           `json:"created"`
+	IPAddress         string             `json:"ip_address,omitempty"`
+	IPAddressLocation *IPAddressLocation `json:"ip_address_location,omitempty"`
+	Open              bool               `json:"open"`
+	OpenedReason      string             `json:"opened_reason"`
+	PaymentIntent     string             `json:"payment_intent,omitempty"`
+	Reason            string             `json:"reason"`
+	Livemode          bool               `json:"livemode"`
+}
+
+// IPAddressLocation holds the approximate location of the IP address a
+// payment was made from.
+type IPAddressLocation struct {
+	City      string  `json:"city,omitempty"`
+	Country   string  `json:"country,omitempty"`
+	Latitude  float64 `json:"latitude,omitempty"`
+	Longitude float64 `json:"longitude,omitempty"`
+	Region    string  `json:"region,omitempty"`
+}
+
+// ReviewListParams encapsulates options for filtering a list of open Reviews.
+type ReviewListParams struct {
+	ListParams
+
+	// (Optional) Only return reviews created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// ReviewClient encapsulates operations for querying and approving reviews
+// using the Stripe REST API.
+type ReviewClient struct{ scope }
+
+// With returns a ReviewClient configured with the given options.
+func (c ReviewClient) With(opts ...ClientOption) ReviewClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Review with the given ID.
+//
+// see https://stripe.com/docs/api/radar/reviews/retrieve
+func (c ReviewClient) Get(id string) (*Review, error) {
+	res := &Review{}
+	return res, c.query("GET", "/reviews/"+url.QueryEscape(id), nil, res)
+}
+
+// Approves the Review with the given ID, closing it and removing it from the
+// list of open reviews.
+//
+// see https://stripe.com/docs/api/radar/reviews/approve
+func (c ReviewClient) Approve(id string) (*Review, error) {
+	res := &Review{}
+	return res, c.query("POST", "/reviews/"+url.QueryEscape(id)+"/approve", nil, res)
+}
+
+// Returns a list of the Reviews that are still open.
+//
+// see https://stripe.com/docs/api/radar/reviews/list
+func (c ReviewClient) List(params *ReviewListParams) ([]*Review, bool, error) {
+	res := struct {
+		ListObject
+		Data []*Review
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/reviews", values, &res)
+	return res.Data, res.More, err
+}
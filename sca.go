@@ -0,0 +1,92 @@
+package stripe
+
+// PaymentOutcome classifies the state of a PaymentIntent by what has to
+// happen next for the payment to complete.
+type PaymentOutcome string
+
+// PaymentOutcome Values
+const (
+	// The payment succeeded, or was authorized and is waiting to be captured.
+	PaymentSucceeded PaymentOutcome = "succeeded"
+
+	// The payment is being processed, and its result is sent by webhook.
+	PaymentProcessing PaymentOutcome = "processing"
+
+	// The customer has to authenticate the payment, e.g. with 3D Secure, by
+	// handling the PaymentIntent's NextAction with Stripe.js or by following
+	// its RedirectURL.
+	PaymentRequiresAction PaymentOutcome = "requires_action"
+
+	// The PaymentIntent has a payment method and has to be confirmed.
+	PaymentRequiresConfirmation PaymentOutcome = "requires_confirmation"
+
+	// The customer's bank required authentication for a payment made while
+	// they were not present. The payment can be retried by bringing the
+	// customer back, e.g. with an email, to confirm the PaymentIntent
+	// on-session with its ClientSecret.
+	PaymentRequiresAuthentication PaymentOutcome = "requires_authentication"
+
+	// The payment failed, or no payment method was given yet, and another
+	// payment method is needed.
+	PaymentRequiresPaymentMethod PaymentOutcome = "requires_payment_method"
+
+	// The PaymentIntent was canceled.
+	PaymentCanceled PaymentOutcome = "canceled"
+)
+
+// Outcome returns what the PaymentIntent's status and last error mean for
+// the payment.
+func (pi *PaymentIntent) Outcome() PaymentOutcome {
+	switch pi.Status {
+	case PaymentIntentSucceeded, PaymentIntentRequiresCapture:
+		return PaymentSucceeded
+	case PaymentIntentProcessing:
+		return PaymentProcessing
+	case PaymentIntentRequiresAction, "requires_source_action":
+		return PaymentRequiresAction
+	case PaymentIntentRequiresConfirmation:
+		return PaymentRequiresConfirmation
+	case PaymentIntentCanceled:
+		return PaymentCanceled
+	}
+	if e := pi.LastPaymentError; e != nil && e.Code == "authentication_required" {
+		return PaymentRequiresAuthentication
+	}
+	return PaymentRequiresPaymentMethod
+}
+
+// RedirectURL returns the URL to send the customer to for authenticating the
+// payment, if the PaymentIntent's next action is a redirect.
+func (pi *PaymentIntent) RedirectURL() string {
+	if a := pi.NextAction; a != nil && a.RedirectToURL != nil {
+		return a.RedirectToURL.URL
+	}
+	return ""
+}
+
+// IsAuthenticationRequired reports whether err is the card error Stripe
+// returns when an off-session payment needs the customer to authenticate it.
+func IsAuthenticationRequired(err error) bool {
+	e, ok := err.(*Error)
+	return ok && (e.Detail.Code == "authentication_required" || e.Detail.DeclineCode == "authentication_required")
+}
+
+// ConfirmOffSession creates and confirms a PaymentIntent charging a
+// Customer's saved payment method while they are not present, e.g. for a
+// renewal made from a background job. params must set the Customer and
+// PaymentMethod.
+//
+// If the bank requires the customer to authenticate, Stripe fails the
+// request; the PaymentIntent is then still returned alongside the error, with
+// the Outcome PaymentRequiresAuthentication, so that the customer can be
+// brought back to confirm it. Other declines are returned the same way, with
+// the Outcome PaymentRequiresPaymentMethod.
+func (c PaymentIntentClient) ConfirmOffSession(params *PaymentIntentParams) (*PaymentIntent, error) {
+	p := *params
+	p.Confirm, p.OffSession = Bool(true), Bool(true)
+	pi, err := c.Create(&p)
+	if e, ok := err.(*Error); ok && e.Detail.PaymentIntent != nil {
+		pi = e.Detail.PaymentIntent
+	}
+	return pi, err
+}
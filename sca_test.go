@@ -0,0 +1,68 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPaymentIntentOutcome(t *testing.T) {
+	tests := []struct {
+		pi   PaymentIntent
+		want PaymentOutcome
+	}{
+		{PaymentIntent{Status: PaymentIntentSucceeded}, PaymentSucceeded},
+		{PaymentIntent{Status: PaymentIntentRequiresCapture}, PaymentSucceeded},
+		{PaymentIntent{Status: PaymentIntentProcessing}, PaymentProcessing},
+		{PaymentIntent{Status: PaymentIntentRequiresAction}, PaymentRequiresAction},
+		{PaymentIntent{Status: PaymentIntentRequiresConfirmation}, PaymentRequiresConfirmation},
+		{PaymentIntent{Status: PaymentIntentCanceled}, PaymentCanceled},
+		{PaymentIntent{Status: PaymentIntentRequiresPaymentMethod}, PaymentRequiresPaymentMethod},
+		{PaymentIntent{
+			Status:           PaymentIntentRequiresPaymentMethod,
+			LastPaymentError: &PaymentIntentError{Code: "authentication_required", Type: "card_error"},
+		}, PaymentRequiresAuthentication},
+	}
+	for _, test := range tests {
+		if got := test.pi.Outcome(); got != test.want {
+			t.Errorf("Expected %s for status %s, got %s", test.want, test.pi.Status, got)
+		}
+	}
+}
+
+// TestConfirmOffSession will test that an off-session payment the bank wants
+// authenticated returns the PaymentIntent to bring the customer back to.
+func TestConfirmOffSession(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.Form.Get("confirm") != "true" || r.Form.Get("off_session") != "true" {
+			t.Errorf("Expected an off-session confirmation, got %v", r.Form)
+		}
+		if r.Form.Get("payment_method") == "pm_3ds" {
+			w.WriteHeader(402)
+			w.Write([]byte(`{"error":{"type":"card_error","code":"authentication_required","message":"This payment requires authentication.",
+				"payment_intent":{"id":"pi_1","status":"requires_payment_method","client_secret":"pi_1_secret",
+				"last_payment_error":{"type":"card_error","code":"authentication_required"}}}}`))
+			return
+		}
+		w.Write([]byte(`{"id":"pi_2","status":"succeeded"}`))
+	}))
+	defer server.Close()
+
+	pi, err := PaymentIntents.With(WithBaseURL(server.URL)).ConfirmOffSession(&PaymentIntentParams{
+		Amount: 1000, Currency: USD, Customer: String("cus_1"), PaymentMethod: String("pm_3ds"),
+	})
+	if !IsAuthenticationRequired(err) {
+		t.Errorf("Expected authentication_required error, got %v", err)
+	}
+	if pi == nil || pi.ID != "pi_1" || pi.Outcome() != PaymentRequiresAuthentication || pi.ClientSecret != "pi_1_secret" {
+		t.Errorf("Expected pi_1 to require authentication, got %+v", pi)
+	}
+
+	pi, err = PaymentIntents.With(WithBaseURL(server.URL)).ConfirmOffSession(&PaymentIntentParams{
+		Amount: 1000, Currency: USD, Customer: String("cus_1"), PaymentMethod: String("pm_card"),
+	})
+	if err != nil || pi.Outcome() != PaymentSucceeded {
+		t.Errorf("Expected the payment to succeed, got %v, %v", pi, err)
+	}
+}
@@ -0,0 +1,68 @@
+package stripe
+
+import (
+	"errors"
+	"io"
+	"net/url"
+)
+
+var QueryResultMissingError = errors.New("stripe: scheduled query run has no result file")
+
+// ScheduledQueryRun represents a single run of a scheduled Sigma query, whose
+// results are available as a CSV File once it has completed.
+//
+// see https://stripe.com/docs/api/sigma/scheduled_queries/object
+type ScheduledQueryRun struct {
+	APIResource
+
+	ID           string   `json:"id"`
+	Created      UnixTime `json:"created"`
+	DataLoadTime UnixTime `json:"data_load_time"`
+	Error        *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+	File                 *File    `json:"file,omitempty"`
+	ResultAvailableUntil UnixTime `json:"result_available_until"`
+	SQL                  string   `json:"sql"`
+	Status               string   `json:"status"`
+	Title                string   `json:"title"`
+	Livemode             bool     `json:"livemode"`
+}
+
+// ScheduledQueryRunClient encapsulates operations for querying scheduled
+// Sigma query runs using the Stripe REST API.
+type ScheduledQueryRunClient struct{ scope }
+
+// With returns a ScheduledQueryRunClient configured with the given options.
+func (c ScheduledQueryRunClient) With(opts ...ClientOption) ScheduledQueryRunClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Scheduled Query Run with the given ID.
+//
+// see https://stripe.com/docs/api/sigma/scheduled_queries/retrieve
+func (c ScheduledQueryRunClient) Get(id string) (*ScheduledQueryRun, error) {
+	res := &ScheduledQueryRun{}
+	return res, c.query("GET", "/sigma/scheduled_query_runs/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of your Scheduled Query Runs with the specified range.
+//
+// see https://stripe.com/docs/api/sigma/scheduled_queries/list
+func (c ScheduledQueryRunClient) List(limit int, before, after string) ([]*ScheduledQueryRun, bool, error) {
+	res := struct {
+		ListObject
+		Data []*ScheduledQueryRun
+	}{}
+	err := c.query("GET", "/sigma/scheduled_query_runs", listParams(limit, before, after), &res)
+	return res.Data, res.More, err
+}
+
+// Download writes the CSV results of the Scheduled Query Run to w.
+func (c ScheduledQueryRunClient) Download(run *ScheduledQueryRun, w io.Writer) error {
+	if run.File == nil || run.File.URL == "" {
+		return QueryResultMissingError
+	}
+	return c.download(run.File.URL, w)
+}
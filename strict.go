@@ -0,0 +1,199 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// UnknownFields describes fields Stripe returned that the type they were
+// decoded into does not model, such as data added by a newer API version.
+type UnknownFields struct {
+	// The name of the type the response was decoded into, e.g. "Customer".
+	Type string
+
+	// The JSON paths of the unknown fields, e.g. "invoice_settings" or
+	// "cards.data[].wallet".
+	Fields []string
+
+	// The ID Stripe assigned the request that returned the fields.
+	RequestID string
+}
+
+// the function that receives the UnknownFields of responses, if any
+var _unknownFields func(*UnknownFields)
+
+// SetUnknownFieldHook will set a function that is called when a response
+// holds fields the type it is decoded into does not model, which helps to
+// discover data Stripe has added that the library does not expose yet. The
+// raw JSON of the object remains available from RawJSON. Each unknown field
+// of a type is only reported once, and with logging enabled, it is also
+// printed. Setting it to nil, the default, disables the check, which costs a
+// second pass over each response.
+//
+// Objects nested in fields that decode themselves, such as expandable IDs,
+// are not checked, nor are objects listed with Each.
+func SetUnknownFieldHook(f func(*UnknownFields)) {
+	_unknownFields = f
+}
+
+// the unknown fields already reported, by type and path
+var reportedFields sync.Map
+
+// reportUnknownFields reports the fields of body unknown to v, which body was
+// decoded into. If v is a page of a list, the unknown fields of each of its
+// objects are reported instead.
+func reportUnknownFields(v interface{}, body []byte, requestID string) {
+	var data interface{}
+	if json.Unmarshal(body, &data) != nil {
+		return
+	}
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return
+	}
+	if field, ok := t.FieldByName("Data"); ok && field.Type.Kind() == reflect.Slice {
+		obj, _ := data.(map[string]interface{})
+		items, _ := obj["data"].([]interface{})
+		elem := field.Type.Elem()
+		for elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+		var fields []string
+		for _, item := range items {
+			fields = appendUnknownFields(fields, elem, item, "")
+		}
+		notifyUnknownFields(elem, fields, requestID)
+		return
+	}
+	notifyUnknownFields(t, appendUnknownFields(nil, t, data, ""), requestID)
+}
+
+// notifyUnknownFields calls the hook with the fields of t not reported
+// before.
+func notifyUnknownFields(t reflect.Type, fields []string, requestID string) {
+	var fresh []string
+	for _, f := range fields {
+		if _, seen := reportedFields.LoadOrStore(t.String()+"|"+f, true); !seen {
+			fresh = append(fresh, f)
+		}
+	}
+	if len(fresh) == 0 {
+		return
+	}
+	sort.Strings(fresh)
+	if _log {
+		fmt.Println("UNKNOWN FIELDS: ", t.Name(), strings.Join(fresh, ", "))
+	}
+	if f := _unknownFields; f != nil {
+		f(&UnknownFields{Type: t.Name(), Fields: fresh, RequestID: requestID})
+	}
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// appendUnknownFields appends the paths of the fields in data, found at path,
+// that t has no field for.
+func appendUnknownFields(fields []string, t reflect.Type, data interface{}, path string) []string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch data := data.(type) {
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Map:
+			for k, v := range data {
+				fields = appendUnknownFields(fields, t.Elem(), v, joinPath(path, k))
+			}
+		case reflect.Struct:
+			known, ok := knownFields(t)
+			if !ok {
+				return fields
+			}
+			for k, v := range data {
+				// every object names its type, which is implied by the struct
+				if k == "object" {
+					continue
+				}
+				ft, ok := known[strings.ToLower(k)]
+				if !ok {
+					fields = append(fields, joinPath(path, k))
+					continue
+				}
+				fields = appendUnknownFields(fields, ft, v, joinPath(path, k))
+			}
+		}
+	case []interface{}:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for _, v := range data {
+				fields = appendUnknownFields(fields, t.Elem(), v, path+"[]")
+			}
+		}
+	}
+	return fields
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+// the JSON fields of each struct type, by lower case name
+var structFields sync.Map
+
+// knownFields returns the types of the JSON fields of the struct type t, by
+// lower case name as encoding/json matches them, including the fields it
+// decodes with decodeAliases. It reports false for types that otherwise
+// decode themselves, whose fields cannot be known.
+func knownFields(t reflect.Type) (map[string]reflect.Type, bool) {
+	if v, ok := structFields.Load(t); ok {
+		known := v.(map[string]reflect.Type)
+		return known, known != nil
+	}
+	var known map[string]reflect.Type
+	a, isAliaser := reflect.New(t).Interface().(aliaser)
+	if !reflect.PtrTo(t).Implements(unmarshalerType) || isAliaser {
+		known = make(map[string]reflect.Type)
+		addStructFields(known, t)
+		if isAliaser {
+			for name, dst := range a.aliases() {
+				known[name] = reflect.TypeOf(dst).Elem()
+			}
+		}
+	}
+	structFields.Store(t, known)
+	return known, known != nil
+}
+
+func addStructFields(known map[string]reflect.Type, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		ft := f.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if name == "" && f.Anonymous && ft.Kind() == reflect.Struct {
+			addStructFields(known, ft)
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		known[strings.ToLower(name)] = f.Type
+	}
+}
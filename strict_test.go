@@ -0,0 +1,45 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestUnknownFields will test that fields missing from the decoded types are
+// reported once, including those of nested objects and listed objects, while
+// renamed fields decoded as aliases are not.
+func TestUnknownFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Request-Id", "req_1")
+		switch r.URL.Path {
+		case "/v1/customers/cus_1":
+			w.Write([]byte(`{"id":"cus_1","object":"customer","balance":0,"tax_ids":[],"metadata":{"plan":"gold"},
+				"sources":{"object":"list","data":[{"id":"card_1","object":"card","wallet":null}]}}`))
+		case "/v1/charges":
+			w.Write([]byte(`{"object":"list","url":"/v1/charges","has_more":false,"data":[{"id":"ch_1","calculated_statement_descriptor":"SHOP"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	var reports []*UnknownFields
+	SetUnknownFieldHook(func(u *UnknownFields) { reports = append(reports, u) })
+	defer SetUnknownFieldHook(nil)
+
+	Customers.With(WithBaseURL(server.URL)).Get("cus_1")
+	Customers.With(WithBaseURL(server.URL)).Get("cus_1")
+	Charges.With(WithBaseURL(server.URL)).List(10, "", "")
+
+	if len(reports) != 2 {
+		t.Fatalf("Expected each unknown field to be reported once, got %d reports", len(reports))
+	}
+	want := &UnknownFields{Type: "Customer", Fields: []string{"sources.data[].wallet", "tax_ids"}, RequestID: "req_1"}
+	if !reflect.DeepEqual(reports[0], want) {
+		t.Errorf("Expected %+v, got %+v", want, reports[0])
+	}
+	want = &UnknownFields{Type: "Charge", Fields: []string{"calculated_statement_descriptor"}, RequestID: "req_1"}
+	if !reflect.DeepEqual(reports[1], want) {
+		t.Errorf("Expected %+v, got %+v", want, reports[1])
+	}
+}
@@ -0,0 +1,652 @@
+package stripe
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func init() {
+	// To run the tests against a local stripe-mock server instead of the
+	// Stripe API, set STRIPE_MOCK_URL=http://localhost:12111 along with any
+	// test key, e.g. STRIPE_API_KEY=sk_test_123
+	if u := os.Getenv("STRIPE_MOCK_URL"); u != "" {
+		SetUrl(u)
+		SetFilesUrl(u)
+	}
+}
+
+// TestForAccount will test that the Stripe-Account header is sent for calls
+// made through a client scoped to a connected account, and that the default
+// set with SetAccount is used otherwise.
+func TestForAccount(t *testing.T) {
+	var account string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		account = r.Header.Get("Stripe-Account")
+		w.Write([]byte(`{"id":"ch_1"}`))
+	}))
+	defer server.Close()
+	defer SetUrl(_url)
+	SetUrl(server.URL)
+
+	Charges.With(WithAccount("acct_1")).Get("ch_1")
+	if account != "acct_1" {
+		t.Errorf("Expected Stripe-Account acct_1, got %q", account)
+	}
+
+	SetAccount("acct_2")
+	defer SetAccount("")
+	Charges.Get("ch_1")
+	if account != "acct_2" {
+		t.Errorf("Expected Stripe-Account acct_2, got %q", account)
+	}
+	Charges.With(WithAccount("acct_1")).Get("ch_1")
+	if account != "acct_1" {
+		t.Errorf("Expected Stripe-Account acct_1, got %q", account)
+	}
+}
+
+// TestReportRun will test that a Report Run is polled until it succeeds and
+// that its result file is downloaded.
+func TestReportRun(t *testing.T) {
+	polls := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/reporting/report_runs":
+			w.Write([]byte(`{"id":"frr_1","status":"pending"}`))
+		case "/v1/reporting/report_runs/frr_1":
+			if polls++; polls < 2 {
+				w.Write([]byte(`{"id":"frr_1","status":"pending"}`))
+				return
+			}
+			w.Write([]byte(`{"id":"frr_1","status":"succeeded","result":{"id":"file_1","url":"` + server.URL + `/v1/files/file_1/contents"}}`))
+		case "/v1/files/file_1/contents":
+			w.Write([]byte("category,net\ncharge,100\n"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	defer SetUrl(_url)
+	SetUrl(server.URL)
+
+	var csv strings.Builder
+	run, err := ReportRuns.Run(&ReportRunParams{ReportType: "balance.summary.1"}, time.Millisecond, &csv)
+	if err != nil {
+		t.Fatalf("Expected Report Run, got Error %s", err.Error())
+	}
+	if run.Status != ReportRunSucceeded {
+		t.Errorf("Expected Report Run Status %s, got %s", ReportRunSucceeded, run.Status)
+	}
+	if csv.String() != "category,net\ncharge,100\n" {
+		t.Errorf("Expected Report CSV, got %q", csv.String())
+	}
+}
+
+// TestFileCreate will test that a file is uploaded to the files host as
+// multipart form data.
+func TestFileCreate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/files" || r.Method != "POST" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		f, h, err := r.FormFile("file")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		defer f.Close()
+		data, _ := ioutil.ReadAll(f)
+		fmt.Fprintf(w, `{"id":"file_1","purpose":%q,"filename":%q,"size":%d}`, r.FormValue("purpose"), h.Filename, len(data))
+	}))
+	defer server.Close()
+	defer SetFilesUrl(_filesUrl)
+	SetFilesUrl(server.URL)
+
+	file, err := Files.Create(FilePurposeIdentityDocument, "passport.png", strings.NewReader("png-bytes"))
+	if err != nil {
+		t.Fatalf("Expected File, got Error %s", err.Error())
+	}
+	if file.Purpose != FilePurposeIdentityDocument || file.Filename != "passport.png" || file.Size != 9 {
+		t.Errorf("Expected identity_document passport.png of 9 bytes, got %+v", file)
+	}
+}
+
+// TestEphemeralKeyVersion will test that Ephemeral Keys are created with the
+// requested Stripe-Version, and that other requests keep the default.
+func TestEphemeralKeyVersion(t *testing.T) {
+	var version string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		version = r.Header.Get("Stripe-Version")
+		w.Write([]byte(`{"id":"ephkey_1","secret":"ek_test_1","associated_objects":[{"id":"cus_1","type":"customer"}]}`))
+	}))
+	defer server.Close()
+	defer SetUrl(_url)
+	SetUrl(server.URL)
+
+	key, err := EphemeralKeys.Create(&EphemeralKeyParams{Customer: "cus_1", APIVersion: "2020-08-27"})
+	if err != nil {
+		t.Fatalf("Expected Ephemeral Key, got Error %s", err.Error())
+	}
+	if version != "2020-08-27" {
+		t.Errorf("Expected Stripe-Version 2020-08-27, got %s", version)
+	}
+	if !strings.Contains(string(key.RawJSON), `"secret":"ek_test_1"`) {
+		t.Errorf("Expected raw key JSON, got %s", key.RawJSON)
+	}
+
+	EphemeralKeys.Delete(key.ID)
+	if version != apiVersion {
+		t.Errorf("Expected Stripe-Version %s, got %s", apiVersion, version)
+	}
+}
+
+// TestAddExtra will test that extra parameters are sent alongside the ones
+// the library encodes itself.
+func TestAddExtra(t *testing.T) {
+	var form url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		form = r.PostForm
+		w.Write([]byte(`{"id":"cus_1"}`))
+	}))
+	defer server.Close()
+	defer SetUrl(_url)
+	SetUrl(server.URL)
+
+	params := &CustomerParams{Email: String("gopher@example.com")}
+	params.AddExtra("preferred_locales[]", "en")
+	params.AddExtra("preferred_locales[]", "fr")
+	if _, err := Customers.Create(params); err != nil {
+		t.Fatalf("Expected Customer, got Error %s", err.Error())
+	}
+	if form.Get("email") != "gopher@example.com" {
+		t.Errorf("Expected email gopher@example.com, got %q", form.Get("email"))
+	}
+	if got := form["preferred_locales[]"]; len(got) != 2 || got[0] != "en" || got[1] != "fr" {
+		t.Errorf("Expected preferred_locales en and fr, got %v", got)
+	}
+}
+
+// TestRawJSON will test that decoded objects, including each object in a
+// list, keep the JSON they were decoded from.
+func TestRawJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/topups/tu_1":
+			w.Write([]byte(`{"id":"tu_1","amount":100,"new_field":"x"}`))
+		case "/v1/topups":
+			w.Write([]byte(`{"object":"list","has_more":false,"data":[{"id":"tu_1"},{"id":"tu_2","new_field":"y"}]}`))
+		}
+	}))
+	defer server.Close()
+	defer SetUrl(_url)
+	SetUrl(server.URL)
+
+	topup, err := Topups.Get("tu_1")
+	if err != nil {
+		t.Fatalf("Expected Topup, got Error %s", err.Error())
+	}
+	if got := string(topup.RawJSON()); got != `{"id":"tu_1","amount":100,"new_field":"x"}` {
+		t.Errorf("Expected raw Topup JSON, got %s", got)
+	}
+
+	topups, _, err := Topups.List(&TopupListParams{})
+	if err != nil {
+		t.Fatalf("Expected Topups, got Error %s", err.Error())
+	}
+	if len(topups) != 2 || string(topups[1].RawJSON()) != `{"id":"tu_2","new_field":"y"}` {
+		t.Errorf("Expected raw JSON for each Topup, got %+v", topups)
+	}
+}
+
+// TestDeleted will test that Delete calls return the deleted object's ID and
+// type along with the confirmation.
+func TestDeleted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" || r.URL.Path != "/v1/coupons/25OFF" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"id":"25OFF","object":"coupon","deleted":true}`))
+	}))
+	defer server.Close()
+	defer SetUrl(_url)
+	SetUrl(server.URL)
+
+	deleted, err := Coupons.Delete("25OFF")
+	if err != nil {
+		t.Fatalf("Expected Coupon deletion, got Error %s", err.Error())
+	}
+	if deleted.ID != "25OFF" || deleted.Object != "coupon" || !deleted.Deleted {
+		t.Errorf("Expected deleted coupon 25OFF, got %+v", deleted)
+	}
+}
+
+// TestBackend will test that requests are sent through the Backend set with
+// SetBackend or WithBackend instead of the Stripe API.
+func TestBackend(t *testing.T) {
+	var method, path string
+	var params url.Values
+	mock := BackendFunc(func(m, p string, values url.Values, v interface{}) error {
+		method, path, params = m, p, values
+		return json.Unmarshal([]byte(`{"id":"ch_1","paid":true}`), v)
+	})
+	SetBackend(mock)
+	defer SetBackend(nil)
+
+	charge, err := Charges.With(WithAccount("acct_1")).Get("ch_1")
+	if err != nil {
+		t.Fatalf("Expected Charge, got Error %s", err.Error())
+	}
+	if method != "GET" || path != "/charges/ch_1" || !charge.Paid {
+		t.Errorf("Expected GET /charges/ch_1 to return a paid Charge, got %s %s %+v", method, path, charge)
+	}
+
+	// a client's own Backend takes precedence over the default
+	failing := BackendFunc(func(string, string, url.Values, interface{}) error {
+		return errors.New("unavailable")
+	})
+	if _, err := Charges.With(WithBackend(failing)).Get("ch_1"); err == nil || err.Error() != "unavailable" {
+		t.Errorf("Expected Error from client Backend, got %v", err)
+	}
+
+	if _, err := Charges.RefundAmount(charge.ID, 100); err != nil {
+		t.Fatalf("Expected Refund, got Error %s", err.Error())
+	}
+	if params.Get("amount") != "100" {
+		t.Errorf("Expected amount 100, got %v", params)
+	}
+}
+
+// TestWithURL will test that a client scoped to a base URL sends its requests
+// there, authenticated with a bearer token as stripe-mock expects.
+func TestWithURL(t *testing.T) {
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		w.Write([]byte(`{"id":"cus_1"}`))
+	}))
+	defer server.Close()
+	defer SetKey(_key)
+	SetKey("sk_test_123")
+
+	cust, err := Customers.With(WithBaseURL(server.URL)).Get("cus_1")
+	if err != nil {
+		t.Fatalf("Expected Customer, got Error %s", err.Error())
+	}
+	if cust.ID != "cus_1" || auth != "Bearer sk_test_123" {
+		t.Errorf("Expected cus_1 with bearer auth, got %s and %q", cust.ID, auth)
+	}
+}
+
+// TestConnectionPool will test that the connection pool settings are applied
+// to a copy of the transport, and refused for a custom http.RoundTripper.
+func TestConnectionPool(t *testing.T) {
+	defer SetHTTPClient(nil)
+	SetHTTPClient(&http.Client{Timeout: time.Minute})
+
+	err := SetConnectionPool(ConnectionPool{MaxIdleConns: 500, MaxIdleConnsPerHost: 100, IdleConnTimeout: time.Minute})
+	if err != nil {
+		t.Fatalf("Expected connection pool to be set, got Error %s", err.Error())
+	}
+	tr, ok := _httpClient.Transport.(*http.Transport)
+	if !ok || tr == http.DefaultTransport {
+		t.Fatalf("Expected a copy of the default transport, got %v", _httpClient.Transport)
+	}
+	if tr.MaxIdleConns != 500 || tr.MaxIdleConnsPerHost != 100 || tr.IdleConnTimeout != time.Minute {
+		t.Errorf("Expected pool settings 500, 100 and 1m, got %d, %d and %s", tr.MaxIdleConns, tr.MaxIdleConnsPerHost, tr.IdleConnTimeout)
+	}
+	if _httpClient.Timeout != time.Minute {
+		t.Errorf("Expected client Timeout to be kept, got %s", _httpClient.Timeout)
+	}
+	if http.DefaultTransport.(*http.Transport).MaxIdleConnsPerHost == 100 {
+		t.Errorf("Expected http.DefaultTransport to be unchanged")
+	}
+
+	if !tr.ForceAttemptHTTP2 || tr.TLSNextProto != nil {
+		t.Errorf("Expected HTTP/2 to be negotiated by default")
+	}
+	if err := SetConnectionPool(ConnectionPool{DisableHTTP2: true}); err != nil {
+		t.Fatalf("Expected HTTP/2 to be disabled, got Error %s", err.Error())
+	}
+	tr = _httpClient.Transport.(*http.Transport)
+	if tr.ForceAttemptHTTP2 || tr.TLSNextProto == nil || len(tr.TLSNextProto) != 0 || tr.MaxIdleConns != 500 {
+		t.Errorf("Expected HTTP/1.1 only, keeping the pool settings")
+	}
+
+	SetHTTPClient(&http.Client{Transport: benchmarkTransport(nil)})
+	if err := SetConnectionPool(ConnectionPool{MaxIdleConns: 500}); err != TransportError {
+		t.Errorf("Expected TransportError for a custom transport, got %v", err)
+	}
+}
+
+// TestProxyAndTLSConfig will test that requests are sent through the proxy
+// set, and are made with the TLS configuration set.
+func TestProxyAndTLSConfig(t *testing.T) {
+	defer SetHTTPClient(nil)
+	SetHTTPClient(&http.Client{Timeout: time.Minute})
+
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.Write([]byte(`{"id":"ch_1"}`))
+	}))
+	defer proxy.Close()
+
+	if err := SetProxy(proxy.URL); err != nil {
+		t.Fatalf("Expected proxy to be set, got Error %s", err.Error())
+	}
+	if _, err := Charges.With(WithBaseURL("http://api.stripe.invalid")).Get("ch_1"); err != nil {
+		t.Fatal(err)
+	}
+	if proxied != "http://api.stripe.invalid/v1/charges/ch_1" {
+		t.Errorf("Expected the request to be sent through the proxy, got %q", proxied)
+	}
+	if err := SetProxy("proxy.internal:3128"); err != InvalidProxyError {
+		t.Errorf("Expected InvalidProxyError for a URL without a scheme, got %v", err)
+	}
+	if err := SetProxy(""); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"ch_1"}`))
+	}))
+	defer server.Close()
+
+	if _, err := Charges.With(WithBaseURL(server.URL)).Get("ch_1"); err == nil {
+		t.Errorf("Expected the test server's certificate to be distrusted by default")
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	config := &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: pool}
+	if err := SetTLSConfig(config); err != nil {
+		t.Fatalf("Expected TLS config to be set, got Error %s", err.Error())
+	}
+	config.RootCAs = nil
+	if _, err := Charges.With(WithBaseURL(server.URL)).Get("ch_1"); err != nil {
+		t.Errorf("Expected the test server's certificate to be trusted, got %v", err)
+	}
+	if tr := _httpClient.Transport.(*http.Transport); tr.TLSClientConfig.MinVersion != tls.VersionTLS12 || _httpClient.Timeout != time.Minute {
+		t.Errorf("Expected a copy of the TLS config, keeping the client Timeout")
+	}
+
+	SetHTTPClient(&http.Client{Transport: benchmarkTransport(nil)})
+	if err := SetTLSConfig(config); err != TransportError {
+		t.Errorf("Expected TransportError for a custom transport, got %v", err)
+	}
+}
+
+// TestDialContext will test that connections are opened with the dial
+// function set.
+func TestDialContext(t *testing.T) {
+	defer SetHTTPClient(nil)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"ch_1"}`))
+	}))
+	defer server.Close()
+
+	var dialed []string
+	err := SetDialContext(func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dialed = append(dialed, addr)
+		var d net.Dialer
+		return d.DialContext(ctx, network, server.Listener.Addr().String())
+	})
+	if err != nil {
+		t.Fatalf("Expected dial function to be set, got Error %s", err.Error())
+	}
+	if _, err := Charges.With(WithBaseURL("http://api.stripe.invalid")).Get("ch_1"); err != nil {
+		t.Fatal(err)
+	}
+	if len(dialed) != 1 || dialed[0] != "api.stripe.invalid:80" {
+		t.Errorf("Expected a connection dialed to api.stripe.invalid:80, got %v", dialed)
+	}
+
+	if err := SetDialContext(nil); err != nil || _httpClient.Transport.(*http.Transport).DialContext == nil {
+		t.Errorf("Expected the default dialer to be restored, got %v", err)
+	}
+}
+
+// TestGzip will test that responses are requested compressed, and are
+// decompressed before they are decoded.
+func TestGzip(t *testing.T) {
+	var encoding string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encoding = r.Header.Get("Accept-Encoding")
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		gz.Write([]byte(`{"id":"cus_1","email":"jenny.rosen@example.com"}`))
+		gz.Close()
+	}))
+	defer server.Close()
+
+	cust, err := Customers.With(WithBaseURL(server.URL)).Get("cus_1")
+	if err != nil {
+		t.Fatalf("Expected Customer, got Error %s", err.Error())
+	}
+	if encoding != "gzip" {
+		t.Errorf("Expected Accept-Encoding gzip, got %q", encoding)
+	}
+	if cust.ID != "cus_1" || cust.Email != "jenny.rosen@example.com" {
+		t.Errorf("Expected decompressed Customer cus_1, got %s %s", cust.ID, cust.Email)
+	}
+}
+
+// TestEach will test that the objects of a list are decoded one at a time,
+// keeping their raw JSON, and that an error from the callback stops the list.
+func TestEach(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"object":"list","url":"/v1/charges","data":[{"id":"ch_1","amount":400},{"id":"ch_2","amount":500}],"has_more":true}`))
+	}))
+	defer server.Close()
+
+	var ids []string
+	more, err := Charges.With(WithBaseURL(server.URL)).Each(2, "", "", func(ch *Charge) error {
+		ids = append(ids, ch.ID)
+		if string(ch.RawJSON()) != fmt.Sprintf(`{"id":"%s","amount":%d}`, ch.ID, ch.Amount) {
+			t.Errorf("Expected raw JSON of %s, got %s", ch.ID, ch.RawJSON())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Expected Charges, got Error %s", err.Error())
+	}
+	if !more || strings.Join(ids, ",") != "ch_1,ch_2" {
+		t.Errorf("Expected ch_1,ch_2 with more, got %v and %v", ids, more)
+	}
+
+	stop := errors.New("stop")
+	ids = nil
+	_, err = Charges.With(WithBaseURL(server.URL)).Each(2, "", "", func(ch *Charge) error {
+		ids = append(ids, ch.ID)
+		return stop
+	})
+	if err != stop || len(ids) != 1 {
+		t.Errorf("Expected the callback error after ch_1, got %v after %v", err, ids)
+	}
+
+	backend := BackendFunc(func(method, path string, params url.Values, v interface{}) error {
+		return json.Unmarshal([]byte(`{"data":[{"id":"cus_1"}],"has_more":false}`), v)
+	})
+	ids = nil
+	more, err = Customers.With(WithBackend(backend)).Each(1, "", "", func(cust *Customer) error {
+		ids = append(ids, cust.ID)
+		return nil
+	})
+	if err != nil || more || len(ids) != 1 || ids[0] != "cus_1" {
+		t.Errorf("Expected cus_1 from the Backend, got %v, %v and %v", ids, more, err)
+	}
+}
+
+// TestTimeout will test that a client's timeout cancels a request taking
+// longer, without affecting other clients.
+func TestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		w.Write([]byte(`{"id":"ch_1"}`))
+	}))
+	defer server.Close()
+
+	if _, err := Charges.With(WithBaseURL(server.URL), WithTimeout(10*time.Millisecond)).Get("ch_1"); err == nil {
+		t.Errorf("Expected the request to time out")
+	}
+	if _, err := Charges.With(WithBaseURL(server.URL), WithTimeout(time.Second)).Get("ch_1"); err != nil {
+		t.Errorf("Expected Charge within the timeout, got Error %s", err.Error())
+	}
+}
+
+// TestWithHeader will test that headers set on a client are sent with its
+// requests, replacing those set by the library, without affecting the client
+// they were derived from.
+func TestWithHeader(t *testing.T) {
+	var got []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header)
+		w.Write([]byte(`{"id":"ch_1"}`))
+	}))
+	defer server.Close()
+
+	charges := Charges.With(WithBaseURL(server.URL), WithHeader("X-Route", "eu-1"))
+	charges.With(WithHeader("Stripe-Version", apiVersion+"; feature_beta=v1")).Get("ch_1")
+	charges.Get("ch_1")
+
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(got))
+	}
+	if got[0].Get("X-Route") != "eu-1" || got[0].Get("Stripe-Version") != apiVersion+"; feature_beta=v1" {
+		t.Errorf("Expected the routing and beta version headers, got %v", got[0])
+	}
+	if got[1].Get("X-Route") != "eu-1" || got[1].Get("Stripe-Version") != apiVersion {
+		t.Errorf("Expected the routing header and default version, got %v", got[1])
+	}
+}
+
+// TestRequestID will test that the Stripe request ID is kept on resources
+// and errors, and logged with the caller's correlation ID.
+func TestRequestID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Request-Id", "req_"+strings.TrimPrefix(r.URL.Path, "/v1/charges/"))
+		if r.URL.Path == "/v1/charges/ch_missing" {
+			w.WriteHeader(404)
+			w.Write([]byte(`{"error":{"type":"invalid_request_error","message":"No such charge"}}`))
+			return
+		}
+		w.Write([]byte(`{"id":"ch_1"}`))
+	}))
+	defer server.Close()
+
+	stdout := os.Stdout
+	out, logs, _ := os.Pipe()
+	os.Stdout = logs
+	defer func() { _log = false }()
+	_log = true
+
+	ctx := WithCorrelationID(context.Background(), "order_6735")
+	charge, err := Charges.With(WithBaseURL(server.URL), WithContext(ctx)).Get("ch_1")
+	_, missing := Charges.With(WithBaseURL(server.URL)).Get("ch_missing")
+	os.Stdout = stdout
+	logs.Close()
+	logged, _ := ioutil.ReadAll(out)
+
+	if err != nil || charge.RequestID() != "req_ch_1" {
+		t.Errorf("Expected Charge from request req_ch_1, got %v and %v", charge, err)
+	}
+	if e, ok := missing.(*Error); !ok || e.RequestID != "req_ch_missing" {
+		t.Errorf("Expected Error from request req_ch_missing, got %v", missing)
+	}
+	if !strings.Contains(string(logged), "RESPONSE:  200 req_ch_1 correlation_id=order_6735\n") {
+		t.Errorf("Expected request and correlation IDs to be logged, got %s", logged)
+	}
+}
+
+// benchmarkTransport answers every request with body without a network round
+// trip, so benchmarks measure the client alone.
+type benchmarkTransport []byte
+
+func (t benchmarkTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		ioutil.ReadAll(req.Body)
+		req.Body.Close()
+	}
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(bytes.NewReader(t)),
+		Request:    req,
+	}, nil
+}
+
+// benchmarkServer sends all requests to a benchmarkTransport answering with
+// body, until the returned function is called.
+func benchmarkServer(body []byte) func() {
+	SetHTTPClient(&http.Client{Transport: benchmarkTransport(body)})
+	return func() { SetHTTPClient(nil) }
+}
+
+var benchmarkCharge = []byte(`{"id":"ch_1","object":"charge","amount":400,"currency":"usd","paid":true,"created":1700000000,"customer":"cus_1","description":"Calzone","card":{"id":"card_1","object":"card","type":"Visa","last4":"4242","exp_month":12,"exp_year":2030},"metadata":{"order_id":"6735"},"livemode":false}`)
+
+func BenchmarkQuery(b *testing.B) {
+	defer benchmarkServer(benchmarkCharge)()
+	params := &ChargeParams{
+		Amount:      400,
+		Currency:    USD,
+		Customer:    String("cus_1"),
+		Description: String("Calzone"),
+		Metadata:    map[string]string{"order_id": "6735"},
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Charges.Create(params); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkList(b *testing.B) {
+	items := make([]string, 100)
+	for i := range items {
+		items[i] = string(benchmarkCharge)
+	}
+	defer benchmarkServer([]byte(`{"object":"list","has_more":true,"data":[` + strings.Join(items, ",") + `]}`))()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := Charges.List(100, "", ""); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEach(b *testing.B) {
+	items := make([]string, 100)
+	for i := range items {
+		items[i] = string(benchmarkCharge)
+	}
+	defer benchmarkServer([]byte(`{"object":"list","has_more":true,"data":[` + strings.Join(items, ",") + `]}`))()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Charges.Each(100, "", "", func(*Charge) error { return nil }); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Package stripeotel traces the requests made to the Stripe API with
+// OpenTelemetry, so that their latency shows up in distributed traces.
+//
+// Install its Transport in the http.Client used by the stripe package, and
+// pass the context of the calling request to each client:
+//
+//	stripe.SetHTTPClient(&http.Client{Transport: stripeotel.NewTransport(nil)})
+//
+//	charge, err := stripe.Charges.With(stripe.WithContext(ctx)).Create(&params)
+//
+// Each request is recorded as a client span named after its method and the
+// resource it acts on, e.g. "stripe POST charges", with the HTTP status and
+// the Stripe request ID as attributes.
+package stripeotel
+
+import (
+	"net/http"
+
+	"github.com/cupcake/stripe"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/cupcake/stripe/stripeotel"
+
+// Transport is an http.RoundTripper that records a span for each request it
+// sends to the Stripe API.
+type Transport struct {
+	base   http.RoundTripper
+	tracer trace.Tracer
+}
+
+// Option configures a Transport.
+type Option func(*Transport)
+
+// WithTracerProvider returns an Option that creates spans with the given
+// TracerProvider, instead of the global one.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(t *Transport) {
+		t.tracer = tp.Tracer(tracerName)
+	}
+}
+
+// NewTransport returns a Transport that sends requests with base, or with
+// http.DefaultTransport if base is nil.
+func NewTransport(base http.RoundTripper, opts ...Option) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	t := &Transport{base: base, tracer: otel.Tracer(tracerName)}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resource := stripe.ResourceName(req.URL.Path)
+	ctx, span := t.tracer.Start(req.Context(), "stripe "+req.Method+" "+resource,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("url.path", req.URL.Path),
+			attribute.String("stripe.resource", resource),
+		),
+	)
+	defer span.End()
+	if account := req.Header.Get("Stripe-Account"); account != "" {
+		span.SetAttributes(attribute.String("stripe.account", account))
+	}
+
+	res, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("http.response.status_code", res.StatusCode))
+	if id := res.Header.Get("Request-Id"); id != "" {
+		span.SetAttributes(attribute.String("stripe.request_id", id))
+	}
+	if res.StatusCode >= 400 {
+		span.SetStatus(codes.Error, http.StatusText(res.StatusCode))
+	}
+	return res, nil
+}
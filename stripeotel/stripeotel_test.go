@@ -0,0 +1,56 @@
+package stripeotel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cupcake/stripe"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Request-Id", "req_1")
+		if r.URL.Path == "/v1/charges/ch_missing" {
+			w.WriteHeader(404)
+			w.Write([]byte(`{"error":{"type":"invalid_request_error","message":"No such charge"}}`))
+			return
+		}
+		w.Write([]byte(`{"id":"ch_1"}`))
+	}))
+	defer server.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+	stripe.SetHTTPClient(&http.Client{Transport: NewTransport(nil, WithTracerProvider(tp))})
+	defer stripe.SetHTTPClient(nil)
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "checkout")
+	stripe.Charges.With(stripe.WithBaseURL(server.URL), stripe.WithContext(ctx)).Get("ch_1")
+	stripe.Charges.With(stripe.WithBaseURL(server.URL), stripe.WithContext(ctx)).Get("ch_missing")
+	parent.End()
+
+	ended := spans.Ended()
+	if len(ended) != 3 {
+		t.Fatalf("Expected 2 Stripe spans and the parent, got %d spans", len(ended))
+	}
+	ok, missing := ended[0], ended[1]
+	if ok.Name() != "stripe GET charges" || ok.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("Expected span stripe GET charges in the checkout trace, got %s", ok.Name())
+	}
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range ok.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	if attrs["http.response.status_code"].AsInt64() != 200 || attrs["stripe.request_id"].AsString() != "req_1" {
+		t.Errorf("Expected status and request ID attributes, got %v", attrs)
+	}
+	if missing.Status().Code != codes.Error {
+		t.Errorf("Expected the 404 span to be an error, got %v", missing.Status())
+	}
+}
@@ -0,0 +1,232 @@
+package stripetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/cupcake/stripe"
+)
+
+// The New functions below return realistic, fully populated resources for use
+// in tests, as a paid $20.00 monthly subscription to a plan named gold would
+// produce them. Each call generates new IDs. Any field can be changed by
+// passing functions that modify the resource before it is returned:
+//
+//	invoice := stripetest.NewInvoice(func(in *stripe.Invoice) {
+//		in.Paid = false
+//		in.AttemptCount = 3
+//	})
+
+var ids int64
+
+// newID returns a new unique test mode ID with the given prefix.
+func newID(prefix string) string {
+	return fmt.Sprintf("%s_test%d", prefix, atomic.AddInt64(&ids, 1))
+}
+
+// period returns the start and end of the current monthly billing period.
+func period() (stripe.UnixTime, stripe.UnixTime) {
+	start := time.Now().Truncate(24*time.Hour).AddDate(0, 0, -1)
+	return stripe.NewUnixTime(start), stripe.NewUnixTime(start.AddDate(0, 1, 0))
+}
+
+// NewCustomer returns a Customer with a default Visa card.
+func NewCustomer(opts ...func(*stripe.Customer)) *stripe.Customer {
+	id := newID("cus")
+	card := NewCard(func(c *stripe.Card) { c.Customer = id })
+	cus := &stripe.Customer{
+		ID:          id,
+		Email:       "jenny.rosen@example.com",
+		Description: "Jenny Rosen",
+		Created:     stripe.NewUnixTime(time.Now().AddDate(0, -1, 0)),
+		Currency:    stripe.USD,
+		Cards: &stripe.CardList{
+			ListObject: stripe.ListObject{Count: 1},
+			Data:       []*stripe.Card{card},
+		},
+		Subscriptions: &stripe.SubscriptionList{Data: []*stripe.Subscription{}},
+		DefaultCard:   card.ID,
+		Metadata:      map[string]string{},
+	}
+	for _, opt := range opts {
+		opt(cus)
+	}
+	return cus
+}
+
+// NewCard returns a Visa card ending in 4242 that passed its CVC and address
+// checks.
+func NewCard(opts ...func(*stripe.Card)) *stripe.Card {
+	card := &stripe.Card{
+		ID:                newID("card"),
+		Name:              "Jenny Rosen",
+		Type:              stripe.Visa,
+		ExpMonth:          12,
+		ExpYear:           time.Now().Year() + 2,
+		Last4:             "4242",
+		Fingerprint:       "Xt5EWLLDS7FJjR1c",
+		Country:           "US",
+		Address1:          "510 Townsend St",
+		AddressCountry:    "US",
+		AddressState:      "CA",
+		AddressZip:        "94103",
+		AddressLine1Check: "pass",
+		AddressZipCheck:   "pass",
+		CVCCheck:          "pass",
+		Metadata:          map[string]string{},
+	}
+	for _, opt := range opts {
+		opt(card)
+	}
+	return card
+}
+
+// NewPlan returns the $20.00 monthly gold plan.
+func NewPlan(opts ...func(*stripe.Plan)) *stripe.Plan {
+	plan := &stripe.Plan{
+		ID:            "gold",
+		Name:          "Gold",
+		Amount:        2000,
+		Interval:      stripe.IntervalMonth,
+		IntervalCount: 1,
+		Currency:      stripe.USD,
+		Created:       stripe.NewUnixTime(time.Now().AddDate(-1, 0, 0)),
+		Metadata:      map[string]string{},
+	}
+	for _, opt := range opts {
+		opt(plan)
+	}
+	return plan
+}
+
+// NewCharge returns a paid $20.00 Charge on a Visa card, authorized with a
+// normal Radar risk level.
+func NewCharge(opts ...func(*stripe.Charge)) *stripe.Charge {
+	charge := &stripe.Charge{
+		ID:                 newID("ch"),
+		Description:        "Gold subscription",
+		Amount:             2000,
+		Card:               NewCard(),
+		Currency:           stripe.USD,
+		Created:            stripe.NewUnixTime(time.Now()),
+		Customer:           newID("cus"),
+		Invoice:            newID("in"),
+		Paid:               true,
+		BalanceTransaction: newID("txn"),
+		Outcome: &stripe.ChargeOutcome{
+			NetworkStatus: "approved_by_network",
+			RiskLevel:     stripe.RiskNormal,
+			RiskScore:     12,
+			SellerMessage: "Payment complete.",
+			Type:          "authorized",
+		},
+		Metadata: map[string]string{},
+	}
+	charge.Card.Customer = charge.Customer
+	for _, opt := range opts {
+		opt(charge)
+	}
+	return charge
+}
+
+// NewSubscription returns an active Subscription to the gold plan, in the
+// middle of its current billing period.
+func NewSubscription(opts ...func(*stripe.Subscription)) *stripe.Subscription {
+	start, end := period()
+	sub := &stripe.Subscription{
+		ID:                 newID("sub"),
+		Customer:           newID("cus"),
+		Status:             stripe.SubscriptionActive,
+		Plan:               NewPlan(),
+		Start:              stripe.NewUnixTime(start.AddDate(0, -2, 0)),
+		CurrentPeriodStart: start,
+		CurrentPeriodEnd:   end,
+		Quantity:           1,
+		Metadata:           map[string]string{},
+	}
+	for _, opt := range opts {
+		opt(sub)
+	}
+	return sub
+}
+
+// NewInvoice returns a paid $20.00 Invoice for a period of the gold plan
+// subscription, with a single line item.
+func NewInvoice(opts ...func(*stripe.Invoice)) *stripe.Invoice {
+	start, end := period()
+	plan := NewPlan()
+	inv := &stripe.Invoice{
+		ID:           newID("in"),
+		AmountDue:    plan.Amount,
+		AttemptCount: 1,
+		Attempted:    true,
+		Closed:       true,
+		Paid:         true,
+		PeriodStart:  start,
+		PeriodEnd:    end,
+		Subtotal:     plan.Amount,
+		Total:        plan.Amount,
+		Currency:     stripe.USD,
+		Date:         start,
+		Lines: &stripe.InvoiceLines{
+			ListObject: stripe.ListObject{Count: 1},
+			Data: []*stripe.InvoiceLineItem{{
+				ID:       newID("sub"),
+				Amount:   plan.Amount,
+				Currency: stripe.USD,
+				Period:   stripe.Period{Start: start, End: end},
+				Type:     "subscription",
+				Metadata: map[string]string{},
+				Plan:     plan,
+				Quantity: 1,
+			}},
+		},
+		Metadata: map[string]string{},
+	}
+	InvoiceCustomer(newID("cus"))(inv)
+	InvoiceCharge(newID("ch"))(inv)
+	for _, opt := range opts {
+		opt(inv)
+	}
+	return inv
+}
+
+// InvoiceCustomer returns an option for NewInvoice that sets the ID of the
+// Customer being invoiced.
+func InvoiceCustomer(id string) func(*stripe.Invoice) {
+	return func(inv *stripe.Invoice) {
+		json.Unmarshal([]byte(strconv.Quote(id)), &inv.Customer)
+	}
+}
+
+// InvoiceCharge returns an option for NewInvoice that sets the ID of the
+// Charge that paid the Invoice.
+func InvoiceCharge(id string) func(*stripe.Invoice) {
+	return func(inv *stripe.Invoice) {
+		json.Unmarshal([]byte(strconv.Quote(id)), &inv.Charge)
+	}
+}
+
+// NewEvent returns an Event of the given type, e.g. "invoice.paid", about the
+// given object, which is encoded as the event's data.
+func NewEvent(typ string, object interface{}, opts ...func(*stripe.Event)) *stripe.Event {
+	data, err := json.Marshal(object)
+	if err != nil {
+		panic("stripetest: cannot encode event object: " + err.Error())
+	}
+	event := &stripe.Event{
+		ID:              newID("evt"),
+		Type:            typ,
+		Created:         stripe.NewUnixTime(time.Now()),
+		PendingWebhooks: 1,
+		Request:         newID("req"),
+		Data:            stripe.EventData{Object: data},
+	}
+	for _, opt := range opts {
+		opt(event)
+	}
+	return event
+}
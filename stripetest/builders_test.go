@@ -0,0 +1,38 @@
+package stripetest
+
+import (
+	"testing"
+
+	"github.com/cupcake/stripe"
+)
+
+func TestNewInvoice(t *testing.T) {
+	invoice := NewInvoice(InvoiceCustomer("cus_1"), func(in *stripe.Invoice) {
+		in.Paid = false
+	})
+	if invoice.Customer.ID() != "cus_1" {
+		t.Errorf("Expected Customer cus_1, got %s", invoice.Customer.ID())
+	}
+	if invoice.Paid {
+		t.Errorf("Expected option to mark Invoice unpaid")
+	}
+	if invoice.Charge.ID() == "" || invoice.Total != 2000 || len(invoice.Lines.Data) != 1 {
+		t.Errorf("Expected populated Invoice, got %+v", invoice)
+	}
+	if other := NewInvoice(); other.ID == invoice.ID {
+		t.Errorf("Expected unique IDs, got %s twice", invoice.ID)
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+	charge := NewCharge(func(c *stripe.Charge) { c.Amount = 500 })
+	event := NewEvent("charge.succeeded", charge)
+
+	decoded := stripe.Charge{}
+	if err := event.Decode(&decoded); err != nil {
+		t.Fatalf("Expected Event to decode, got Error %s", err.Error())
+	}
+	if decoded.ID != charge.ID || decoded.Amount != 500 || decoded.Card.Last4 != "4242" {
+		t.Errorf("Expected the Charge to round-trip, got %+v", decoded)
+	}
+}
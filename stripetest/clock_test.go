@@ -0,0 +1,53 @@
+package stripetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cupcake/stripe"
+	"github.com/cupcake/stripe/webhooktest"
+)
+
+var _ stripe.Clock = (*Clock)(nil)
+
+func TestClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewClock(start)
+	short, long := clock.After(time.Second), clock.After(time.Minute)
+
+	clock.Advance(30 * time.Second)
+	select {
+	case now := <-short:
+		if !now.Equal(start.Add(30 * time.Second)) {
+			t.Errorf("Expected the advanced time, got %s", now)
+		}
+	default:
+		t.Errorf("Expected After(1s) to fire after advancing 30s")
+	}
+	select {
+	case <-long:
+		t.Errorf("Expected After(1m) not to fire yet")
+	default:
+	}
+	if clock.Waiters() != 1 {
+		t.Errorf("Expected 1 waiter, got %d", clock.Waiters())
+	}
+}
+
+// TestClockSignature will test that webhook signatures expire by the
+// Clock's time rather than the system's.
+func TestClockSignature(t *testing.T) {
+	clock := NewClock(time.Now())
+	stripe.SetClock(clock)
+	defer stripe.SetClock(nil)
+
+	payload := []byte(`{"id":"evt_1"}`)
+	header := webhooktest.GenerateHeaderAt(payload, "whsec_test", time.Now())
+	if err := stripe.VerifySignature(payload, header, "whsec_test", stripe.DefaultTolerance); err != nil {
+		t.Fatalf("Expected a valid signature, got %v", err)
+	}
+	clock.Advance(time.Hour)
+	if err := stripe.VerifySignature(payload, header, "whsec_test", stripe.DefaultTolerance); err != stripe.SignatureExpiredError {
+		t.Errorf("Expected SignatureExpiredError an hour later, got %v", err)
+	}
+}
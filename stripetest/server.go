@@ -0,0 +1,660 @@
+package stripetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cupcake/stripe"
+)
+
+// Server is an in-memory fake of the Stripe API, serving a subset of the
+// customer, card, subscription, charge and invoice endpoints over
+// httptest. Objects created through it can be retrieved, updated, listed and
+// deleted as they would be against Stripe, so integration tests can run
+// without a mock per call:
+//
+//	srv := stripetest.NewServer()
+//	defer srv.Close()
+//	stripe.SetUrl(srv.URL)
+//
+// Charges made with the card number 4000000000000002 are declined, like the
+// equivalent Stripe test card; every other card is charged successfully.
+// Requests to other endpoints fail with a 404 invalid_request_error.
+type Server struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	seq     int
+	objects map[string]map[string]object
+}
+
+// object is a resource as it is encoded to JSON.
+type object map[string]interface{}
+
+// an error response in the format the Stripe API uses.
+type apiError struct {
+	status int
+	typ    string
+	param  string
+	msg    string
+}
+
+// NewServer starts and returns a new Server. The caller should call Close
+// when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{objects: make(map[string]map[string]object)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		s.writeError(w, &apiError{http.StatusBadRequest, "invalid_request_error", "", err.Error()})
+		return
+	}
+
+	s.mu.Lock()
+	res, e := s.route(r.Method, strings.Split(strings.Trim(r.URL.Path, "/"), "/"), r.Form)
+	res = public(res)
+	s.mu.Unlock()
+
+	if e != nil {
+		s.writeError(w, e)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+func (s *Server) writeError(w http.ResponseWriter, e *apiError) {
+	body := map[string]object{"error": {"type": e.typ, "message": e.msg}}
+	if e.param != "" {
+		body["error"]["param"] = e.param
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(e.status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// route dispatches the request for the given path segments, e.g.
+// ["v1", "customers", "cus_1", "cards"].
+func (s *Server) route(method string, path []string, form url.Values) (interface{}, *apiError) {
+	unknown := &apiError{http.StatusNotFound, "invalid_request_error", "",
+		fmt.Sprintf("Unrecognized request URL (%s: /%s).", method, strings.Join(path, "/"))}
+	if len(path) < 2 || path[0] != "v1" {
+		return nil, unknown
+	}
+	path = path[1:]
+	route := method + " " + path[0]
+	switch len(path) {
+	case 1:
+	case 2:
+		route += " :id"
+	case 3:
+		route += " :id " + path[2]
+	case 4:
+		route += " :id " + path[2] + " :id"
+	default:
+		return nil, unknown
+	}
+
+	switch route {
+	case "POST customers":
+		return s.createCustomer(form)
+	case "GET customers":
+		return s.list("customers", form, nil), nil
+	case "GET customers :id":
+		return s.customer(path[1])
+	case "POST customers :id":
+		return s.updateCustomer(path[1], form)
+	case "DELETE customers :id":
+		return s.delete("customers", "customer", path[1])
+
+	case "POST customers :id cards":
+		if _, e := s.get("customers", "customer", path[1]); e != nil {
+			return nil, e
+		}
+		return s.createCard(path[1], form, "")
+	case "GET customers :id cards":
+		return s.list("cards", form, match("customer", path[1])), nil
+	case "GET customers :id cards :id":
+		return s.child("cards", "card", path[1], path[3])
+	case "POST customers :id cards :id":
+		card, e := s.child("cards", "card", path[1], path[3])
+		if e != nil {
+			return nil, e
+		}
+		update(card, form, "name", "exp_month", "exp_year", "address_line1", "address_line2",
+			"address_zip", "address_state", "address_country")
+		return card, nil
+	case "DELETE customers :id cards :id":
+		if _, e := s.child("cards", "card", path[1], path[3]); e != nil {
+			return nil, e
+		}
+		if cus := s.objects["customers"][path[1]]; cus["default_card"] == path[3] {
+			cus["default_card"] = s.defaultCard(path[1], path[3])
+		}
+		return s.delete("cards", "card", path[3])
+
+	case "POST customers :id subscriptions":
+		if _, e := s.get("customers", "customer", path[1]); e != nil {
+			return nil, e
+		}
+		return s.createSubscription(path[1], form)
+	case "GET customers :id subscriptions":
+		return s.list("subscriptions", form, match("customer", path[1])), nil
+	case "GET customers :id subscriptions :id":
+		return s.child("subscriptions", "subscription", path[1], path[3])
+	case "POST customers :id subscriptions :id":
+		sub, e := s.child("subscriptions", "subscription", path[1], path[3])
+		if e != nil {
+			return nil, e
+		}
+		return s.updateSubscription(sub, form)
+	case "DELETE customers :id subscriptions :id":
+		sub, e := s.child("subscriptions", "subscription", path[1], path[3])
+		if e != nil {
+			return nil, e
+		}
+		if form.Get("at_period_end") == "true" {
+			sub["cancel_at_period_end"] = true
+		} else {
+			sub["status"] = stripe.SubscriptionCanceled
+			sub["ended_at"] = now()
+		}
+		sub["canceled_at"] = now()
+		return sub, nil
+
+	case "POST charges":
+		return s.createCharge(form)
+	case "GET charges":
+		return s.list("charges", form, match("customer", form.Get("customer"))), nil
+	case "GET charges :id":
+		return s.get("charges", "charge", path[1])
+	case "POST charges :id":
+		charge, e := s.get("charges", "charge", path[1])
+		if e != nil {
+			return nil, e
+		}
+		update(charge, form, "description")
+		if report := form.Get("fraud_details[user_report]"); report != "" {
+			charge["fraud_details"] = object{"user_report": report}
+		}
+		return charge, nil
+	case "POST charges :id refund":
+		charge, e := s.get("charges", "charge", path[1])
+		if e != nil {
+			return nil, e
+		}
+		return s.refund(charge, form)
+
+	case "POST invoices":
+		return s.createInvoice(form)
+	case "GET invoices":
+		return s.list("invoices", form, match("customer", form.Get("customer"))), nil
+	case "GET invoices :id":
+		return s.get("invoices", "invoice", path[1])
+	case "POST invoices :id":
+		inv, e := s.get("invoices", "invoice", path[1])
+		if e != nil {
+			return nil, e
+		}
+		update(inv, form, "description", "closed")
+		return inv, nil
+	case "POST invoices :id pay":
+		inv, e := s.get("invoices", "invoice", path[1])
+		if e != nil {
+			return nil, e
+		}
+		if inv["paid"] == true {
+			return nil, &apiError{http.StatusBadRequest, "invalid_request_error", "", "Invoice is already paid"}
+		}
+		inv["paid"], inv["closed"], inv["attempted"] = true, true, true
+		inv["attempt_count"] = inv["attempt_count"].(int) + 1
+		return inv, nil
+	}
+	return nil, unknown
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Resources
+
+func (s *Server) createCustomer(form url.Values) (interface{}, *apiError) {
+	cus := s.create("customers", "cus", object{
+		"object":          "customer",
+		"account_balance": 0,
+		"currency":        nil,
+		"default_card":    nil,
+		"delinquent":      false,
+		"metadata":        object{},
+	})
+	update(cus, form, "email", "description", "phone", "account_balance", "test_clock")
+	id := cus["id"].(string)
+	if form.Get("card") != "" || form.Get("card[number]") != "" {
+		if _, e := s.createCard(id, form, "card"); e != nil {
+			s.delete("customers", "customer", id)
+			return nil, e
+		}
+	}
+	if form.Get("plan") != "" {
+		s.createSubscription(id, form)
+	}
+	return s.customer(id)
+}
+
+func (s *Server) updateCustomer(id string, form url.Values) (interface{}, *apiError) {
+	cus, e := s.get("customers", "customer", id)
+	if e != nil {
+		return nil, e
+	}
+	if _, ok := form["default_card"]; ok {
+		if _, e := s.child("cards", "card", id, form.Get("default_card")); e != nil {
+			e.param = "default_card"
+			return nil, e
+		}
+	}
+	update(cus, form, "email", "description", "phone", "account_balance", "default_card")
+	if form.Get("card") != "" || form.Get("card[number]") != "" {
+		card, e := s.createCard(id, form, "card")
+		if e != nil {
+			return nil, e
+		}
+		cus["default_card"] = card["id"]
+	}
+	return s.customer(id)
+}
+
+// customer returns the customer with its card and subscription lists filled
+// in, as Stripe returns them.
+func (s *Server) customer(id string) (interface{}, *apiError) {
+	cus, e := s.get("customers", "customer", id)
+	if e != nil {
+		return nil, e
+	}
+	res := object{}
+	for k, v := range cus {
+		res[k] = v
+	}
+	res["cards"] = s.list("cards", nil, match("customer", id))
+	res["subscriptions"] = s.list("subscriptions", nil, match("customer", id))
+	return res, nil
+}
+
+// createCard attaches a new card to the customer from the card params nested
+// under prefix, or at the top level if prefix is empty. A token in place of
+// the params creates a Visa card ending in 4242.
+func (s *Server) createCard(customerID string, form url.Values, prefix string) (object, *apiError) {
+	key := func(k string) string {
+		if prefix == "" {
+			return k
+		}
+		return prefix + "[" + k + "]"
+	}
+	number := form.Get(key("number"))
+	if token := form.Get("card"); token != "" && number == "" {
+		number = stripe.TestCardVisa
+		form = url.Values{}
+	} else if number == "" {
+		return nil, &apiError{http.StatusBadRequest, "invalid_request_error", key("number"), "Missing required param: " + key("number") + "."}
+	}
+	if len(number) < 12 {
+		return nil, &apiError{http.StatusPaymentRequired, "card_error", key("number"), "Your card number is incorrect."}
+	}
+
+	card := s.create("cards", "card", cardObject(number))
+	card["customer"] = customerID
+	card["metadata"] = object{}
+	for _, k := range []string{"name", "exp_month", "exp_year", "address_line1", "address_line2",
+		"address_zip", "address_state", "address_country"} {
+		if v, ok := form[key(k)]; ok {
+			card[k] = convert(k, v[0])
+		}
+	}
+	updateMetadata(card, form, key("metadata"))
+
+	if cus := s.objects["customers"][customerID]; cus["default_card"] == nil {
+		cus["default_card"] = card["id"]
+	}
+	return card, nil
+}
+
+// defaultCard returns the ID of a card of the customer other than the one
+// being removed, or nil if there is none.
+func (s *Server) defaultCard(customerID, removed string) interface{} {
+	for _, card := range s.list("cards", nil, match("customer", customerID))["data"].([]object) {
+		if card["id"] != removed {
+			return card["id"]
+		}
+	}
+	return nil
+}
+
+func (s *Server) createSubscription(customerID string, form url.Values) (interface{}, *apiError) {
+	if form.Get("plan") == "" {
+		return nil, &apiError{http.StatusBadRequest, "invalid_request_error", "plan", "Missing required param: plan."}
+	}
+	start := time.Now()
+	sub := s.create("subscriptions", "sub", object{
+		"object":               "subscription",
+		"customer":             customerID,
+		"status":               stripe.SubscriptionActive,
+		"start":                start.Unix(),
+		"current_period_start": start.Unix(),
+		"current_period_end":   start.AddDate(0, 1, 0).Unix(),
+		"cancel_at_period_end": false,
+		"quantity":             1,
+		"metadata":             object{},
+	})
+	return s.updateSubscription(sub, form)
+}
+
+func (s *Server) updateSubscription(sub object, form url.Values) (interface{}, *apiError) {
+	if plan := form.Get("plan"); plan != "" {
+		sub["plan"] = object{"id": plan, "object": "plan"}
+	}
+	update(sub, form, "quantity")
+	if end := form.Get("trial_end"); end != "" {
+		t, _ := strconv.ParseInt(end, 10, 64)
+		sub["trial_start"], sub["trial_end"] = now(), t
+		if t > now() {
+			sub["status"] = stripe.SubscriptionTrialing
+		}
+	}
+	if form.Get("card") != "" || form.Get("card[number]") != "" {
+		if _, e := s.createCard(sub["customer"].(string), form, "card"); e != nil {
+			return nil, e
+		}
+	}
+	return sub, nil
+}
+
+func (s *Server) createCharge(form url.Values) (interface{}, *apiError) {
+	amount, err := strconv.ParseInt(form.Get("amount"), 10, 64)
+	if err != nil || amount <= 0 {
+		return nil, &apiError{http.StatusBadRequest, "invalid_request_error", "amount", "Missing required param: amount."}
+	}
+	if form.Get("currency") == "" {
+		return nil, &apiError{http.StatusBadRequest, "invalid_request_error", "currency", "Missing required param: currency."}
+	}
+
+	var card object
+	customerID := form.Get("customer")
+	switch {
+	case form.Get("card[number]") != "" || form.Get("card") != "":
+		number := form.Get("card[number]")
+		if number == "" {
+			number = stripe.TestCardVisa
+		} else if len(number) < 12 {
+			return nil, &apiError{http.StatusPaymentRequired, "card_error", "card[number]", "Your card number is incorrect."}
+		}
+		card = cardObject(number)
+	case customerID != "":
+		cus, e := s.get("customers", "customer", customerID)
+		if e != nil {
+			e.param = "customer"
+			return nil, e
+		}
+		if id, ok := cus["default_card"].(string); ok {
+			card = s.objects["cards"][id]
+		}
+		if card == nil {
+			return nil, &apiError{http.StatusPaymentRequired, "card_error", "card", "Cannot charge a customer that has no active card"}
+		}
+	default:
+		return nil, &apiError{http.StatusBadRequest, "invalid_request_error", "card", "Must provide source or customer."}
+	}
+	if card["_number"] == stripe.TestCardChargeDeclined {
+		return nil, &apiError{http.StatusPaymentRequired, "card_error", "", "Your card was declined."}
+	}
+
+	charge := s.create("charges", "ch", object{
+		"object":          "charge",
+		"amount":          amount,
+		"amount_refunded": int64(0),
+		"currency":        form.Get("currency"),
+		"card":            card,
+		"paid":            true,
+		"refunded":        false,
+		"metadata":        object{},
+	})
+	if customerID != "" {
+		charge["customer"] = customerID
+	}
+	update(charge, form, "description")
+	return charge, nil
+}
+
+func (s *Server) refund(charge object, form url.Values) (interface{}, *apiError) {
+	remaining := charge["amount"].(int64) - charge["amount_refunded"].(int64)
+	amount := remaining
+	if v := form.Get("amount"); v != "" {
+		amount, _ = strconv.ParseInt(v, 10, 64)
+	}
+	if amount <= 0 || amount > remaining {
+		return nil, &apiError{http.StatusBadRequest, "invalid_request_error", "amount",
+			fmt.Sprintf("Refund amount (%d) is greater than unrefunded amount on charge (%d)", amount, remaining)}
+	}
+	charge["amount_refunded"] = charge["amount_refunded"].(int64) + amount
+	charge["refunded"] = amount == remaining
+	return charge, nil
+}
+
+func (s *Server) createInvoice(form url.Values) (interface{}, *apiError) {
+	customerID := form.Get("customer")
+	if _, e := s.get("customers", "customer", customerID); e != nil {
+		e.param = "customer"
+		return nil, e
+	}
+	inv := s.create("invoices", "in", object{
+		"object":        "invoice",
+		"customer":      customerID,
+		"amount_due":    0,
+		"subtotal":      0,
+		"total":         0,
+		"currency":      "usd",
+		"attempt_count": 0,
+		"attempted":     false,
+		"closed":        false,
+		"paid":          false,
+		"charge":        nil,
+		"lines":         object{"object": "list", "data": []object{}, "has_more": false, "total_count": 0},
+		"metadata":      object{},
+	})
+	inv["date"] = inv["created"]
+	update(inv, form, "description", "subscription", "closed")
+	return inv, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Storage
+
+// create stores a new object of the given kind with a generated ID.
+func (s *Server) create(kind, prefix string, obj object) object {
+	s.seq++
+	obj["id"] = fmt.Sprintf("%s_test%d", prefix, s.seq)
+	obj["created"] = now()
+	obj["livemode"] = false
+	obj["_seq"] = s.seq
+	if s.objects[kind] == nil {
+		s.objects[kind] = make(map[string]object)
+	}
+	s.objects[kind][obj["id"].(string)] = obj
+	return obj
+}
+
+// get returns the object with the given ID, or the error Stripe returns for a
+// missing object of the named type.
+func (s *Server) get(kind, name, id string) (object, *apiError) {
+	if obj, ok := s.objects[kind][id]; ok {
+		return obj, nil
+	}
+	return nil, &apiError{http.StatusNotFound, "invalid_request_error", "id", fmt.Sprintf("No such %s: %s", name, id)}
+}
+
+// child returns the object with the given ID if it belongs to the customer.
+func (s *Server) child(kind, name, customerID, id string) (object, *apiError) {
+	if _, e := s.get("customers", "customer", customerID); e != nil {
+		return nil, e
+	}
+	obj, e := s.get(kind, name, id)
+	if e != nil || obj["customer"] != customerID {
+		return nil, &apiError{http.StatusNotFound, "invalid_request_error", "id",
+			fmt.Sprintf("Customer %s does not have %s with ID %s", customerID, name, id)}
+	}
+	return obj, nil
+}
+
+func (s *Server) delete(kind, name, id string) (interface{}, *apiError) {
+	if _, e := s.get(kind, name, id); e != nil {
+		return nil, e
+	}
+	delete(s.objects[kind], id)
+	if kind == "customers" {
+		for _, child := range []string{"cards", "subscriptions"} {
+			for cid, obj := range s.objects[child] {
+				if obj["customer"] == id {
+					delete(s.objects[child], cid)
+				}
+			}
+		}
+	}
+	return object{"id": id, "object": name, "deleted": true}, nil
+}
+
+// list returns a page of the objects of the given kind that satisfy the
+// filter, newest first, paginated with the limit, starting_after and
+// ending_before params.
+func (s *Server) list(kind string, form url.Values, filter func(object) bool) object {
+	all := []object{}
+	for _, obj := range s.objects[kind] {
+		if filter == nil || filter(obj) {
+			all = append(all, obj)
+		}
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i]["_seq"].(int) > all[j]["_seq"].(int) })
+
+	start, end := 0, len(all)
+	for i, obj := range all {
+		if obj["id"] == form.Get("starting_after") {
+			start = i + 1
+		}
+		if obj["id"] == form.Get("ending_before") {
+			end = i
+		}
+	}
+	limit := 10
+	if n, err := strconv.Atoi(form.Get("limit")); err == nil && n > 0 {
+		limit = n
+	}
+	more := false
+	if end < start {
+		end = start
+	}
+	if end-start > limit {
+		if form.Get("ending_before") != "" {
+			start = end - limit
+		} else {
+			end = start + limit
+		}
+		more = true
+	}
+	return object{"object": "list", "data": all[start:end], "has_more": more, "total_count": len(all)}
+}
+
+// cardObject returns a new card with the given number, which is kept to
+// decide whether charges on it are declined but never returned.
+func cardObject(number string) object {
+	return object{
+		"object":    "card",
+		"type":      stripe.GetCardType(number),
+		"last4":     number[len(number)-4:],
+		"exp_month": 12,
+		"exp_year":  time.Now().Year() + 1,
+		"_number":   number,
+	}
+}
+
+// public returns a copy of v without the fields the Server keeps for its own
+// bookkeeping, whose names start with an underscore.
+func public(v interface{}) interface{} {
+	switch v := v.(type) {
+	case object:
+		res := object{}
+		for k, field := range v {
+			if !strings.HasPrefix(k, "_") {
+				res[k] = public(field)
+			}
+		}
+		return res
+	case []object:
+		res := make([]interface{}, len(v))
+		for i, obj := range v {
+			res[i] = public(obj)
+		}
+		return res
+	}
+	return v
+}
+
+// match returns a list filter for objects whose field equals value, or nil
+// to match all objects if value is empty.
+func match(field, value string) func(object) bool {
+	if value == "" {
+		return nil
+	}
+	return func(obj object) bool { return obj[field] == value }
+}
+
+// update sets the given fields of obj from the params that are present.
+func update(obj object, form url.Values, fields ...string) {
+	for _, k := range fields {
+		if v, ok := form[k]; ok {
+			obj[k] = convert(k, v[0])
+		}
+	}
+	updateMetadata(obj, form, "metadata")
+}
+
+// updateMetadata merges the metadata params nested under key into the
+// object's metadata, removing keys set to an empty value.
+func updateMetadata(obj object, form url.Values, key string) {
+	meta, _ := obj["metadata"].(object)
+	if meta == nil {
+		return
+	}
+	for k, v := range form {
+		if !strings.HasPrefix(k, key+"[") || !strings.HasSuffix(k, "]") {
+			continue
+		}
+		name := k[len(key)+1 : len(k)-1]
+		if v[0] == "" {
+			delete(meta, name)
+		} else {
+			meta[name] = v[0]
+		}
+	}
+}
+
+// convert decodes a param value into the JSON type of the field.
+func convert(field, value string) interface{} {
+	switch field {
+	case "exp_month", "exp_year", "quantity", "account_balance":
+		n, _ := strconv.ParseInt(value, 10, 64)
+		return n
+	case "closed":
+		return value == "true"
+	}
+	return value
+}
+
+func now() int64 {
+	return time.Now().Unix()
+}
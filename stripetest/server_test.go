@@ -0,0 +1,122 @@
+package stripetest
+
+import (
+	"testing"
+
+	"github.com/cupcake/stripe"
+)
+
+func TestServer(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	stripe.SetUrl(srv.URL)
+	defer stripe.SetUrl("https://api.stripe.com")
+
+	customer, err := stripe.Customers.Create(&stripe.CustomerParams{
+		Email:    stripe.String("a@example.com"),
+		Card:     &stripe.CardParams{Number: "4242424242424242", ExpMonth: 12, ExpYear: 2030},
+		Metadata: map[string]string{"plan": "gold"},
+	})
+	if err != nil {
+		t.Fatalf("Expected Customer, got Error %s", err.Error())
+	}
+	customer, err = stripe.Customers.Get(customer.ID)
+	if err != nil {
+		t.Fatalf("Expected Customer, got Error %s", err.Error())
+	}
+	if customer.Email != "a@example.com" || customer.Metadata["plan"] != "gold" {
+		t.Errorf("Expected stored Email and Metadata, got %s and %v", customer.Email, customer.Metadata)
+	}
+	if customer.Cards == nil || len(customer.Cards.Data) != 1 || customer.Cards.Data[0].Last4 != "4242" {
+		t.Fatalf("Expected one card ending in 4242, got %+v", customer.Cards)
+	}
+	if customer.DefaultCard != customer.Cards.Data[0].ID {
+		t.Errorf("Expected DefaultCard %s, got %s", customer.Cards.Data[0].ID, customer.DefaultCard)
+	}
+
+	charge, err := stripe.Charges.Create(&stripe.ChargeParams{Amount: 400, Currency: stripe.USD, Customer: stripe.String(customer.ID)})
+	if err != nil {
+		t.Fatalf("Expected Charge, got Error %s", err.Error())
+	}
+	if !charge.Paid || charge.Card == nil || charge.Card.Last4 != "4242" {
+		t.Errorf("Expected paid Charge on the default card, got %+v", charge)
+	}
+	if charge, err = stripe.Charges.RefundAmount(charge.ID, 100); err != nil {
+		t.Fatalf("Expected refunded Charge, got Error %s", err.Error())
+	}
+	if charge.AmountRefunded != 100 || charge.Refunded {
+		t.Errorf("Expected partial refund of 100, got %d", charge.AmountRefunded)
+	}
+	charges, _, err := stripe.Charges.CustomerList(customer.ID, 10, "", "")
+	if err != nil || len(charges) != 1 || charges[0].AmountRefunded != 100 {
+		t.Errorf("Expected the refunded Charge in the list, got %v, %v", charges, err)
+	}
+
+	sub, err := stripe.Subscriptions.Create(customer.ID, &stripe.SubscriptionParams{Plan: "gold"})
+	if err != nil {
+		t.Fatalf("Expected Subscription, got Error %s", err.Error())
+	}
+	if _, err := stripe.Subscriptions.Cancel(customer.ID, sub.ID, false); err != nil {
+		t.Fatalf("Expected canceled Subscription, got Error %s", err.Error())
+	}
+	subs, _, err := stripe.Subscriptions.List(customer.ID, 10, "", "")
+	if err != nil || len(subs) != 1 || subs[0].Status != stripe.SubscriptionCanceled {
+		t.Errorf("Expected one canceled Subscription, got %v, %v", subs, err)
+	}
+
+	invoice, err := stripe.Invoices.Create(&stripe.InvoiceParams{Customer: customer.ID})
+	if err != nil {
+		t.Fatalf("Expected Invoice, got Error %s", err.Error())
+	}
+	if invoice, err = stripe.Invoices.Pay(invoice.ID); err != nil || !invoice.Paid {
+		t.Errorf("Expected paid Invoice, got %v", err)
+	}
+	if invoice.Customer.ID() != customer.ID {
+		t.Errorf("Expected Invoice Customer %s, got %s", customer.ID, invoice.Customer.ID())
+	}
+
+	if _, err := stripe.Customers.Delete(customer.ID); err != nil {
+		t.Fatalf("Expected deleted Customer, got Error %s", err.Error())
+	}
+	_, err = stripe.Customers.Get(customer.ID)
+	if e, ok := err.(*stripe.Error); !ok || e.Code != 404 {
+		t.Errorf("Expected 404 for deleted Customer, got %v", err)
+	}
+}
+
+func TestServerDecline(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	stripe.SetUrl(srv.URL)
+	defer stripe.SetUrl("https://api.stripe.com")
+
+	_, err := stripe.Charges.Create(&stripe.ChargeParams{
+		Amount:   400,
+		Currency: stripe.USD,
+		Card:     &stripe.CardParams{Number: "4000000000000002", ExpMonth: 12, ExpYear: 2030},
+	})
+	if e, ok := err.(*stripe.Error); !ok || e.Detail.Type != "card_error" {
+		t.Errorf("Expected card_error, got %v", err)
+	}
+}
+
+func TestServerList(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	stripe.SetUrl(srv.URL)
+	defer stripe.SetUrl("https://api.stripe.com")
+
+	for i := 0; i < 3; i++ {
+		if _, err := stripe.Customers.Create(&stripe.CustomerParams{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	page, more, err := stripe.Customers.List(2, "", "")
+	if err != nil || len(page) != 2 || !more {
+		t.Fatalf("Expected a first page of 2 with more, got %d, %v, %v", len(page), more, err)
+	}
+	rest, more, err := stripe.Customers.List(2, "", page[1].ID)
+	if err != nil || len(rest) != 1 || more {
+		t.Errorf("Expected a last page of 1, got %d, %v, %v", len(rest), more, err)
+	}
+}
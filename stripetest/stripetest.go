@@ -0,0 +1,208 @@
+// Package stripetest records the requests made to the Stripe API, and the
+// responses to them, in fixture files that can be replayed later, so that
+// code built on the stripe package can be tested end to end in CI without API
+// keys or network access.
+//
+// A test records its fixtures once against the Stripe test mode API:
+//
+//	rec := stripetest.Record("testdata/charges.json")
+//	stripe.SetHTTPClient(&http.Client{Transport: rec})
+//	defer rec.Save()
+//
+// and then replays them on every later run:
+//
+//	rec, err := stripetest.Replay("testdata/charges.json")
+//	stripe.SetHTTPClient(&http.Client{Transport: rec})
+//
+// New chooses between the two, recording only when STRIPE_RECORD is set or
+// the fixture file does not exist yet.
+package stripetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/cupcake/stripe"
+)
+
+// Interaction is a single recorded request and the response to it.
+type Interaction struct {
+	Method   string `json:"method"`
+	URL      string `json:"url"`
+	Body     string `json:"body,omitempty"`
+	Status   int    `json:"status"`
+	Response string `json:"response"`
+}
+
+// Recorder is an http.RoundTripper that either records the interactions it
+// forwards to the Stripe API, or replays previously recorded ones.
+type Recorder struct {
+	path      string
+	transport http.RoundTripper
+	recording bool
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	replayed     []bool
+}
+
+// secrets matches API keys, client secrets and webhook signing secrets, which
+// are redacted before interactions are saved.
+var secrets = regexp.MustCompile(`\b((?:sk|rk|pk)_(?:test|live)|whsec|ek_(?:test|live))_[0-9A-Za-z]+|\b(\w+_\w+)_secret_[0-9A-Za-z]+`)
+
+// Sanitize redacts secrets in s, such as API keys and client secrets.
+func Sanitize(s string) string {
+	return secrets.ReplaceAllStringFunc(s, func(m string) string {
+		sub := secrets.FindStringSubmatch(m)
+		if sub[1] != "" {
+			return sub[1] + "_REDACTED"
+		}
+		return sub[2] + "_secret_REDACTED"
+	})
+}
+
+// sanitizeBody redacts the body of a request: secrets as Sanitize redacts
+// them and, in form-encoded bodies, the values of parameters such as card
+// numbers, CVCs and bank account numbers as stripe.SanitizeParams redacts them.
+// The parameters keep their order, so requests still match their recording.
+func sanitizeBody(body string) string {
+	if body == "" || strings.HasPrefix(body, "{") {
+		return Sanitize(body)
+	}
+	pairs := strings.Split(body, "&")
+	for i, pair := range pairs {
+		values, err := url.ParseQuery(pair)
+		if err != nil {
+			continue
+		}
+		if clean := stripe.SanitizeParams(values).Encode(); clean != values.Encode() {
+			pairs[i] = clean
+		}
+	}
+	return Sanitize(strings.Join(pairs, "&"))
+}
+
+// Record returns a Recorder that forwards requests to the Stripe API using
+// http.DefaultTransport, and records them to be written to the fixture file
+// at path by Save.
+func Record(path string) *Recorder {
+	return &Recorder{path: path, transport: http.DefaultTransport, recording: true}
+}
+
+// Replay returns a Recorder that answers requests with the interactions
+// recorded in the fixture file at path, without network access.
+func Replay(path string) (*Recorder, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	r := &Recorder{path: path}
+	if err := json.Unmarshal(data, &r.interactions); err != nil {
+		return nil, err
+	}
+	r.replayed = make([]bool, len(r.interactions))
+	return r, nil
+}
+
+// New returns a Recorder that records to the fixture file at path if the
+// STRIPE_RECORD environment variable is set or the file does not exist, and
+// otherwise replays it.
+func New(path string) (*Recorder, error) {
+	if _, err := os.Stat(path); os.Getenv("STRIPE_RECORD") != "" || os.IsNotExist(err) {
+		return Record(path), nil
+	}
+	return Replay(path)
+}
+
+// Recording reports whether the Recorder is recording rather than replaying.
+func (r *Recorder) Recording() bool {
+	return r.recording
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	key := &Interaction{Method: req.Method, URL: req.URL.RequestURI(), Body: sanitizeBody(string(body))}
+
+	if !r.recording {
+		return r.replay(req, key)
+	}
+
+	// let the transport negotiate and undo compression itself, so that the
+	// recorded responses are plain JSON
+	req = req.Clone(req.Context())
+	req.Header.Del("Accept-Encoding")
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+	key.Status = res.StatusCode
+	key.Response = Sanitize(string(data))
+	r.mu.Lock()
+	r.interactions = append(r.interactions, key)
+	r.mu.Unlock()
+	return res, nil
+}
+
+// replay answers the request with the first recorded interaction with the
+// same method, URL and body that has not been replayed yet.
+func (r *Recorder) replay(req *http.Request, key *Interaction) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, in := range r.interactions {
+		if r.replayed[i] || in.Method != key.Method || in.URL != key.URL || in.Body != key.Body {
+			continue
+		}
+		r.replayed[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Status, http.StatusText(in.Status)),
+			StatusCode:    in.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": {"application/json"}},
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(in.Response))),
+			ContentLength: int64(len(in.Response)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("stripetest: no recorded response for %s %s in %s", key.Method, key.URL, r.path)
+}
+
+// Save writes the recorded interactions to the fixture file. It does nothing
+// when replaying.
+func (r *Recorder) Save() error {
+	if !r.recording {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, append(data, '\n'), 0644)
+}
@@ -0,0 +1,85 @@
+package stripetest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cupcake/stripe"
+)
+
+func TestRecordReplay(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"cus_1","object":"customer","email":"a@example.com"}`))
+	}))
+	stripe.SetUrl(ts.URL)
+	defer stripe.SetUrl("https://api.stripe.com")
+	defer stripe.SetHTTPClient(nil)
+
+	dir, err := ioutil.TempDir("", "stripetest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "customers.json")
+
+	rec := Record(path)
+	stripe.SetHTTPClient(&http.Client{Transport: rec})
+	if _, err := stripe.Customers.Get("cus_1"); err != nil {
+		t.Fatalf("Expected recorded Customer, got Error %s", err.Error())
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	ts.Close()
+
+	rep, err := Replay(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stripe.SetHTTPClient(&http.Client{Transport: rep})
+	customer, err := stripe.Customers.Get("cus_1")
+	if err != nil {
+		t.Fatalf("Expected replayed Customer, got Error %s", err.Error())
+	}
+	if customer.Email != "a@example.com" {
+		t.Errorf("Expected Email a@example.com, got %s", customer.Email)
+	}
+
+	_, err = stripe.Customers.Get("cus_2")
+	if err == nil || !strings.Contains(err.Error(), "no recorded response for GET /v1/customers/cus_2") {
+		t.Errorf("Expected missing recording Error, got %v", err)
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	in := `{"secret":"whsec_abc123","key":"sk_test_4eC39Hq","client_secret":"pi_123_secret_xyz"}`
+	out := Sanitize(in)
+	for _, secret := range []string{"whsec_abc123", "sk_test_4eC39Hq", "pi_123_secret_xyz"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("Expected %s to be redacted, got %s", secret, out)
+		}
+	}
+	if !strings.Contains(out, "pi_123_secret_REDACTED") {
+		t.Errorf("Expected redacted client secret, got %s", out)
+	}
+}
+
+// TestSanitizeBody will test that card and bank account numbers are redacted
+// from form-encoded request bodies, without reordering their parameters.
+func TestSanitizeBody(t *testing.T) {
+	body := "amount=400&card%5Bnumber%5D=4242424242424242&card%5Bcvc%5D=123&bank_account%5Baccount_number%5D=000123456789&key=sk_test_4eC39Hq"
+	out := sanitizeBody(body)
+	for _, secret := range []string{"4242424242424242", "123&", "000123456789", "4eC39Hq"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("Expected %s to be redacted, got %s", secret, out)
+		}
+	}
+	if !strings.HasPrefix(out, "amount=400&card%5Bnumber%5D=REDACTED&") {
+		t.Errorf("Expected the parameters to keep their order, got %s", out)
+	}
+}
@@ -3,70 +3,113 @@ package stripe
 import (
 	"fmt"
 	"net/url"
-	"strconv"
 )
 
+// SubscriptionStatus is the status of a Subscription.
+type SubscriptionStatus string
+
 // Subscription Statuses
 const (
-	SubscriptionTrialing = "trialing"
-	SubscriptionActive   = "active"
-	SubscriptionPastDue  = "past_due"
-	SubscriptionCanceled = "canceled"
-	SubscriptionUnpaid   = "unpaid"
+	SubscriptionTrialing          SubscriptionStatus = "trialing"
+	SubscriptionActive            SubscriptionStatus = "active"
+	SubscriptionPastDue           SubscriptionStatus = "past_due"
+	SubscriptionCanceled          SubscriptionStatus = "canceled"
+	SubscriptionUnpaid            SubscriptionStatus = "unpaid"
+	SubscriptionIncomplete        SubscriptionStatus = "incomplete"
+	SubscriptionIncompleteExpired SubscriptionStatus = "incomplete_expired"
+	SubscriptionPaused            SubscriptionStatus = "paused"
 )
 
 // Subscriptions represents a recurring charge a customer's card.
 //
 // see https://stripe.com/docs/api#subscription_object
 type Subscription struct {
-	ID                 string    `json:"id"`
-	Customer           string    `json:"customer"`
-	Status             string    `json:"status"`
-	Plan               *Plan     `json:"plan"`
-	Start              UnixTime  `json:"start"`
-	EndedAt            *UnixTime `json:"ended_at,omitempty"`
-	CurrentPeriodStart UnixTime  `json:"current_period_start"`
-	CurrentPeriodEnd   UnixTime  `json:"current_period_end"`
-	TrialStart         *UnixTime `json:"trial_start,omitempty"`
-	TrialEnd           *UnixTime `json:"trial_end,omitempty"`
-	CanceledAt         *UnixTime `json:"canceled_at,omitempty"`
-	CancelAtPeriodEnd  bool      `json:"cancel_at_period_end"`
-	Quantity           int       `json:"quantity"`
-	Discount           *Discount `json:"discount,omitempty"`
+	APIResource
+
+	ID                 string             `json:"id"`
+	Customer           string             `json:"customer"`
+	Status             SubscriptionStatus `json:"status"`
+	Plan               *Plan              `json:"plan"`
+	Start              UnixTime           `json:"start"`
+	EndedAt            *UnixTime          `json:"ended_at,omitempty"`
+	CurrentPeriodStart UnixTime           `json:"current_period_start"`
+	CurrentPeriodEnd   UnixTime           `json:"current_period_end"`
+	TrialStart         *UnixTime          `json:"trial_start,omitempty"`
+	TrialEnd           *UnixTime          `json:"trial_end,omitempty"`
+	CanceledAt         *UnixTime          `json:"canceled_at,omitempty"`
+	CancelAtPeriodEnd  bool               `json:"cancel_at_period_end"`
+	Quantity           int                `json:"quantity"`
+	Discount           *Discount          `json:"discount,omitempty"`
+
+	// The percentage of each invoice's total the platform collects as a
+	// fee, for subscriptions on connected accounts.
+	ApplicationFeePercent *float64 `json:"application_fee_percent,omitempty"`
+
+	// The tax applied, as a percentage by older API versions and as rates by
+	// newer ones. TaxRates returns either as rates.
+	TaxPercent      *float64   `json:"tax_percent,omitempty"`
+	DefaultTaxRates []*TaxRate `json:"default_tax_rates,omitempty"`
+
+	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
 // SubscriptionClient encapsulates operations for updating and canceling
 // customer subscriptions using the Stripe REST API.
-type SubscriptionClient struct{}
+type SubscriptionClient struct{ scope }
+
+// With returns a SubscriptionClient configured with the given options.
+func (c SubscriptionClient) With(opts ...ClientOption) SubscriptionClient {
+	c.apply(opts)
+	return c
+}
 
 // SubscriptionParams encapsulates options for updating a Customer's
 // subscription.
 type SubscriptionParams struct {
+	Params
+
 	// The identifier of the plan to subscribe the customer to.
-	Plan string
+	Plan string `form:"plan"`
 
 	// (Optional) The code of the coupon to apply to the customer if you would
 	// like to apply it at the same time as creating the subscription.
-	Coupon string
+	Coupon *string `form:"coupon"`
 
 	// (Optional) Flag telling us whether to prorate switching plans during a
 	// billing cycle. Default is true.
-	Prorate *bool
+	Prorate *bool `form:"prorate"`
+
+	// (Optional) The time prorations are calculated from when switching
+	// plans, e.g. the time a preview of the change was made at, so that the
+	// amounts match the preview. Defaults to now.
+	ProrationDate *UnixTime `form:"proration_date"`
 
 	// (Optional) UTC integer timestamp representing the end of the trial period
 	// the customer will get before being charged for the first time. If set,
 	// trial_end will override the default trial period of the plan the customer
 	// is being subscribed to.
-	TrialEnd *UnixTime
+	TrialEnd *UnixTime `form:"trial_end"`
 
 	// (Optional) A new card to attach to the customer.
-	Card *CardParams
+	Card *CardParams `form:"card"`
 
 	// (Optional) A new card Token to attach to the customer.
-	Token string
+	Token *string `form:"card"`
 
 	// (Optional) The quantity you'd like to apply to the subscription you're creating.
-	Quantity int
+	Quantity *int64 `form:"quantity"`
+
+	// (Optional) The ID of a connected account the subscription's invoices
+	// are issued on behalf of.
+	OnBehalfOf *string `form:"on_behalf_of"`
+
+	// (Optional) The percentage, from 0 to 100, of each invoice's total the
+	// platform collects as a fee, for subscriptions on connected accounts.
+	ApplicationFeePercent *float64 `form:"application_fee_percent"`
+
+	// (Optional) Metadata to attach to the subscription. Set a key to an empty
+	// value to unset it.
+	Metadata map[string]string `form:"metadata"`
 }
 
 func (c SubscriptionClient) path(customerID, subscriptionID string) string {
@@ -78,55 +121,35 @@ func (c SubscriptionClient) path(customerID, subscriptionID string) string {
 }
 
 func (c SubscriptionClient) Create(customerID string, params *SubscriptionParams) (*Subscription, error) {
-	res := &Subscription{}
-	return res, query("POST", c.path(customerID, ""), c.values(params), res)
-}
-
-func (c SubscriptionClient) values(params *SubscriptionParams) url.Values {
-	values := make(url.Values)
-	if params.Plan != "" {
-		values.Add("plan", params.Plan)
-	}
-	if params.Coupon != "" {
-		values.Add("coupon", params.Coupon)
-	}
-	if params.Prorate != nil && !*params.Prorate {
-		values.Add("prorate", "false")
-	}
-	if params.TrialEnd != nil {
-		values.Add("trial_end", strconv.FormatInt(params.TrialEnd.Unix(), 10))
+	if err := params.Card.validate(); err != nil {
+		return nil, err
 	}
-	if params.Quantity != 0 {
-		values.Add("quantity", strconv.Itoa(params.Quantity))
-	}
-	if params.Token != "" {
-		values.Add("card", params.Token)
-	} else if params.Card != nil {
-		appendCardParams(values, true, params.Card)
-	}
-	return values
+	res := &Subscription{}
+	return res, c.query("POST", c.path(customerID, ""), formValues(params), res)
 }
 
 // Subscribes a customer to a new plan.
 //
 // see https://stripe.com/docs/api#update_subscription
 func (c SubscriptionClient) Update(customerID, subscriptionID string, params *SubscriptionParams) (*Subscription, error) {
+	if err := params.Card.validate(); err != nil {
+		return nil, err
+	}
 	res := &Subscription{}
-	return res, query("POST", c.path(customerID, subscriptionID), c.values(params), res)
+	return res, c.query("POST", c.path(customerID, subscriptionID), formValues(params), res)
 }
 
 func (c SubscriptionClient) Cancel(customerID, subscriptionID string, atPeriodEnd bool) (*Subscription, error) {
-	values := make(url.Values)
-	if atPeriodEnd {
-		values.Add("at_period_end", "true")
-	}
+	values := formValues(struct {
+		AtPeriodEnd bool `form:"at_period_end"`
+	}{atPeriodEnd})
 	res := &Subscription{}
-	return res, query("DELETE", c.path(customerID, subscriptionID), values, res)
+	return res, c.query("DELETE", c.path(customerID, subscriptionID), values, res)
 }
 
 func (c SubscriptionClient) Get(customerID, subscriptionID string) (*Subscription, error) {
 	res := &Subscription{}
-	return res, query("GET", c.path(customerID, subscriptionID), nil, res)
+	return res, c.query("GET", c.path(customerID, subscriptionID), nil, res)
 }
 
 func (c SubscriptionClient) List(customerID string, limit int, before, after string) ([]*Subscription, bool, error) {
@@ -134,6 +157,82 @@ func (c SubscriptionClient) List(customerID string, limit int, before, after str
 		ListObject
 		Data []*Subscription
 	}{}
-	err := query("GET", c.path(customerID, ""), listParams(limit, before, after), res)
+	err := c.query("GET", c.path(customerID, ""), listParams(limit, before, after), &res)
 	return res.Data, res.More, err
 }
+
+// PlanChangeOptions encapsulates options for changing the Plan of a
+// Subscription with ChangePlan.
+type PlanChangeOptions struct {
+	// (Optional) Whether to prorate the change over the current billing
+	// period. Default is true.
+	Prorate *bool
+
+	// (Optional) The quantity of the new Plan. Defaults to the current
+	// quantity.
+	Quantity *int64
+
+	// (Optional) Only preview the change, without applying it.
+	PreviewOnly bool
+}
+
+// PlanChange is the result of ChangePlan.
+type PlanChange struct {
+	// The upcoming Invoice of the Subscription as it would be with the new
+	// Plan, including the proration line items.
+	Preview *Invoice
+
+	// The sum of the proration line items in the Preview, i.e. the amount
+	// the change adds to the next invoice, or credits if negative.
+	Proration int64
+
+	// The Subscription once changed. Nil if PreviewOnly was set.
+	Subscription *Subscription
+}
+
+// ChangePlan switches the Subscription with the given ID to a new Plan. It
+// first previews the upcoming invoice with the change, and then applies it
+// with the same proration date, so that the amounts charged match those
+// previewed. Both the preview and the changed Subscription are returned.
+//
+// see https://stripe.com/docs/billing/subscriptions/prorations#preview-proration
+func (c SubscriptionClient) ChangePlan(customerID, subscriptionID, plan string, opts *PlanChangeOptions) (*PlanChange, error) {
+	if opts == nil {
+		opts = &PlanChangeOptions{}
+	}
+	prorationDate := NewUnixTime(_clock.Now())
+	values := formValues(&UpcomingInvoiceLinesParams{
+		Customer:                  customerID,
+		Subscription:              &subscriptionID,
+		SubscriptionPlan:          &plan,
+		SubscriptionQuantity:      opts.Quantity,
+		SubscriptionProrate:       opts.Prorate,
+		SubscriptionProrationDate: &prorationDate,
+	})
+	change := &PlanChange{Preview: &Invoice{}}
+	if err := c.query("GET", "/invoices/upcoming", values, change.Preview); err != nil {
+		return nil, err
+	}
+	if lines := change.Preview.Lines; lines != nil {
+		for _, line := range lines.Data {
+			if line.Proration {
+				change.Proration += line.Amount
+			}
+		}
+	}
+	if opts.PreviewOnly {
+		return change, nil
+	}
+
+	sub, err := c.Update(customerID, subscriptionID, &SubscriptionParams{
+		Plan:          plan,
+		Prorate:       opts.Prorate,
+		ProrationDate: &prorationDate,
+		Quantity:      opts.Quantity,
+	})
+	if err != nil {
+		return change, err
+	}
+	change.Subscription = sub
+	return change, nil
+}
@@ -1,6 +1,11 @@
 package stripe
 
 import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 )
@@ -24,11 +29,11 @@ var (
 	// Subscriptions with all fields, plus new Credit Card
 	sub2 = SubscriptionParams{
 		Plan:     "plan1",
-		Coupon:   "test coupon 1",
+		Coupon:   String("test coupon 1"),
 		TrialEnd: &UnixTime{time.Now().Add(24 * time.Hour)},
-		Quantity: 5,
+		Quantity: Int64(5),
 		Card: &CardParams{
-			Name:     "George Costanza",
+			Name:     String("George Costanza"),
 			Number:   "4242424242424242",
 			ExpYear:  time.Now().Year() + 1,
 			ExpMonth: 6,
@@ -73,15 +78,15 @@ func TestCreateSubscriptionCard(t *testing.T) {
 
 	// Create the coupon, and defer its deletion
 	Coupons.Create(&c1)
-	defer Coupons.Delete(c1.ID)
+	defer Coupons.Delete(*c1.ID)
 
 	// Subscribe a Customer to a new plan, using a new Credit Card
 	resp, err := Subscriptions.Create(cust.ID, &sub2)
 	if err != nil {
 		t.Errorf("Expected Subscription, got error %s", err.Error())
 	}
-	if resp.Quantity != sub2.Quantity {
-		t.Errorf("Expected Quantity %d, got %d", sub2.Quantity, resp.Quantity)
+	if int64(resp.Quantity) != *sub2.Quantity {
+		t.Errorf("Expected Quantity %d, got %d", *sub2.Quantity, resp.Quantity)
 	}
 
 	// Check to see if the customer's card was added
@@ -108,7 +113,7 @@ func TestCreateSubscriptionToken(t *testing.T) {
 	token, _ := Tokens.Create(&token1)
 
 	// Subscribe the Customer to the Plan, using the Token
-	params := SubscriptionParams{Plan: "plan1", Token: token.ID}
+	params := SubscriptionParams{Plan: "plan1", Token: String(token.ID)}
 	_, err := Subscriptions.Create(cust.ID, &params)
 	if err != nil {
 		t.Errorf("Expected Subscription with Token, got error %s", err.Error())
@@ -176,3 +181,90 @@ func TestCancelSubscriptionAtPeriodEnd(t *testing.T) {
 		t.Errorf("Expected CancelAtPeriodEnd to be %t, got %t", true, subs.CancelAtPeriodEnd)
 	}
 }
+
+// TestSubscriptionQuantityZero will test that an explicit quantity of 0 is
+// sent, while an unset quantity is not.
+func TestSubscriptionQuantityZero(t *testing.T) {
+	values := formValues(&SubscriptionParams{Plan: "plan1", Quantity: Int64(0)})
+	if got, ok := values["quantity"]; !ok || got[0] != "0" {
+		t.Errorf("Expected quantity 0, got %v", got)
+	}
+	values = formValues(&SubscriptionParams{Plan: "plan1"})
+	if _, ok := values["quantity"]; ok {
+		t.Errorf("Expected quantity not to be sent")
+	}
+}
+
+// TestChangePlan will test that a plan change is applied with the proration
+// date it was previewed at.
+func TestChangePlan(t *testing.T) {
+	var previewed, applied string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		switch r.URL.Path {
+		case "/v1/invoices/upcoming":
+			if r.Form.Get("subscription_plan") != "gold" || r.Form.Get("subscription") != "sub_1" {
+				t.Errorf("Expected a preview of sub_1 on gold, got %v", r.Form)
+			}
+			previewed = r.Form.Get("subscription_proration_date")
+			w.Write([]byte(`{"id":"in_upcoming","total":1500,"lines":{"data":[
+				{"amount":-500,"proration":true},{"amount":1000,"proration":true},{"amount":1000}]}}`))
+		case "/v1/customers/cus_1/subscriptions/sub_1":
+			if r.Form.Get("plan") != "gold" {
+				t.Errorf("Expected sub_1 to be changed to gold, got %v", r.Form)
+			}
+			applied = r.Form.Get("proration_date")
+			w.Write([]byte(`{"id":"sub_1","plan":{"id":"gold"}}`))
+		}
+	}))
+	defer server.Close()
+
+	change, err := Subscriptions.With(WithBaseURL(server.URL)).ChangePlan("cus_1", "sub_1", "gold", &PlanChangeOptions{PreviewOnly: true})
+	if err != nil || change.Proration != 500 || change.Subscription != nil || applied != "" {
+		t.Fatalf("Expected a preview with a proration of 500, got %+v, %v", change, err)
+	}
+
+	change, err = Subscriptions.With(WithBaseURL(server.URL)).ChangePlan("cus_1", "sub_1", "gold", nil)
+	if err != nil || change.Subscription == nil || change.Subscription.Plan.ID != "gold" {
+		t.Fatalf("Expected the change to be applied, got %+v, %v", change, err)
+	}
+	if previewed == "" || applied != previewed {
+		t.Errorf("Expected proration date %s to be applied, got %s", previewed, applied)
+	}
+}
+
+// TestApplicationFees will test that the platform's fee is sent with
+// PaymentIntents, invoices and subscriptions, and that fees out of range
+// fail before a request is made.
+func TestApplicationFees(t *testing.T) {
+	var sent []url.Values
+	backend := BackendFunc(func(method, path string, params url.Values, v interface{}) error {
+		sent = append(sent, params)
+		return json.Unmarshal([]byte(`{"id":"obj_1","application_fee_amount":123,"application_fee_percent":12.5}`), v)
+	})
+
+	pi, err := PaymentIntents.With(WithBackend(backend), WithAccount("acct_1")).Create(&PaymentIntentParams{Amount: 1000, Currency: USD, ApplicationFeeAmount: Int64(123)})
+	if err != nil || pi.ApplicationFeeAmount != 123 {
+		t.Errorf("Expected a PaymentIntent with a fee of 123, got %v and %v", pi, err)
+	}
+	inv, err := Invoices.With(WithBackend(backend)).Create(&InvoiceParams{Customer: "cus_1", ApplicationFeeAmount: Int64(123)})
+	if err != nil || inv.ApplicationFeeAmount != 123 {
+		t.Errorf("Expected an Invoice with a fee of 123, got %v and %v", inv, err)
+	}
+	percent := 12.5
+	sub, err := Subscriptions.With(WithBackend(backend)).Create("cus_1", &SubscriptionParams{Plan: "gold", ApplicationFeePercent: &percent})
+	if err != nil || *sub.ApplicationFeePercent != 12.5 {
+		t.Errorf("Expected a Subscription with a fee of 12.5%%, got %v and %v", sub, err)
+	}
+	if len(sent) != 3 || sent[0].Get("application_fee_amount") != "123" || sent[1].Get("application_fee_amount") != "123" || sent[2].Get("application_fee_percent") != "12.5" {
+		t.Errorf("Expected the fees to be sent, got %v", sent)
+	}
+
+	if _, err := PaymentIntents.With(WithBackend(backend)).Create(&PaymentIntentParams{Amount: 100, Currency: USD, ApplicationFeeAmount: Int64(123)}); !errors.Is(err, InvalidApplicationFeeError) {
+		t.Errorf("Expected InvalidApplicationFeeError for a fee above the amount, got %v", err)
+	}
+	percent = 120
+	if err := (&SubscriptionParams{ApplicationFeePercent: &percent}).Validate(); !errors.Is(err, InvalidApplicationFeeError) {
+		t.Errorf("Expected InvalidApplicationFeeError for a fee above 100%%, got %v", err)
+	}
+}
@@ -0,0 +1,284 @@
+package stripe
+
+import "net/url"
+
+// Tax Behaviors
+const (
+	TaxExclusive = "exclusive"
+	TaxInclusive = "inclusive"
+)
+
+// TaxCalculation holds the tax computed by Stripe Tax for a set of line items
+// shipped to a customer. Calculations expire, and are recorded as a
+// TaxTransaction once the payment succeeds.
+//
+// see https://stripe.com/docs/api/tax/calculations/object
+type TaxCalculation struct {
+	APIResource
+
+	ID                 string              `json:"id"`
+	AmountTotal        int64               `json:"amount_total"`
+	Currency           Currency            `json:"currency"`
+	Customer           string              `json:"customer,omitempty"`
+	CustomerDetails    *TaxCustomerDetails `json:"customer_details"`
+	ExpiresAt          *UnixTime           `json:"expires_at,omitempty"`
+	LineItems          *TaxLineItemList    `json:"line_items,omitempty"`
+	ShippingCost       *TaxShippingCost    `json:"shipping_cost,omitempty"`
+	TaxAmountExclusive int64               `json:"tax_amount_exclusive"`
+	TaxAmountInclusive int64               `json:"tax_amount_inclusive"`
+	TaxBreakdown       []TaxBreakdown      `json:"tax_breakdown"`
+	TaxDate            UnixTime            `json:"tax_date"`
+	Livemode           bool                `json:"livemode"`
+}
+
+// TaxCustomerDetails describes the customer whose location determines the tax
+// owed.
+type TaxCustomerDetails struct {
+	Address       *Address `json:"address,omitempty" form:"address"`
+	AddressSource string   `json:"address_source,omitempty" form:"address_source"`
+	IPAddress     string   `json:"ip_address,omitempty" form:"ip_address"`
+}
+
+// TaxLineItem is a single item of a TaxCalculation or TaxTransaction, with
+// the tax computed for it.
+type TaxLineItem struct {
+	APIResource
+
+	ID               string `json:"id"`
+	Amount           int64  `json:"amount"`
+	AmountTax        int64  `json:"amount_tax"`
+	Product          string `json:"product,omitempty"`
+	Quantity         int    `json:"quantity"`
+	Reference        string `json:"reference"`
+	TaxBehavior      string `json:"tax_behavior"`
+	TaxCode          string `json:"tax_code"`
+	OriginalLineItem string `json:"original_line_item,omitempty"`
+	Livemode         bool   `json:"livemode"`
+}
+
+// TaxLineItemList represents a list of Tax Line Items.
+type TaxLineItemList struct {
+	ListObject
+	Data []*TaxLineItem `json:"data"`
+}
+
+// TaxShippingCost holds the shipping cost of a calculation and its tax.
+type TaxShippingCost struct {
+	Amount      int64  `json:"amount" form:"amount"`
+	AmountTax   int64  `json:"amount_tax" form:"amount_tax"`
+	TaxBehavior string `json:"tax_behavior,omitempty" form:"tax_behavior"`
+	TaxCode     string `json:"tax_code,omitempty" form:"tax_code"`
+}
+
+// TaxBreakdown is the tax owed to a single jurisdiction.
+type TaxBreakdown struct {
+	Amount           int64  `json:"amount"`
+	Inclusive        bool   `json:"inclusive"`
+	TaxableAmount    int64  `json:"taxable_amount"`
+	TaxabilityReason string `json:"taxability_reason"`
+	TaxRateDetails   *struct {
+		Country           string `json:"country,omitempty"`
+		PercentageDecimal string `json:"percentage_decimal"`
+		State             string `json:"state,omitempty"`
+		TaxType           string `json:"tax_type,omitempty"`
+	} `json:"tax_rate_details,omitempty"`
+}
+
+// TaxLineItemParams describes an item to calculate tax for.
+type TaxLineItemParams struct {
+	// The total amount of the line item, including quantity.
+	Amount int64 `form:"amount"`
+
+	// A unique reference for the line item, e.g. your SKU.
+	Reference string `form:"reference"`
+
+	// (Optional) The quantity. Defaults to 1.
+	Quantity *int64 `form:"quantity"`
+
+	// (Optional) The ID of a Product whose tax code should be used.
+	Product *string `form:"product"`
+
+	// (Optional) Whether Amount is exclusive or inclusive of tax.
+	TaxBehavior *string `form:"tax_behavior"`
+
+	// (Optional) The tax code of the item, e.g. "txcd_99999999".
+	TaxCode *string `form:"tax_code"`
+}
+
+// TaxCalculationParams encapsulates options for creating a new Tax
+// Calculation.
+type TaxCalculationParams struct {
+	Params
+
+	// 3-letter ISO code for currency.
+	Currency Currency `form:"currency"`
+
+	// The items to calculate tax for.
+	LineItems []TaxLineItemParams `form:"line_items"`
+
+	// (Optional) The ID of a Customer whose address and tax IDs are used.
+	Customer *string `form:"customer"`
+
+	// (Optional) The customer's location, if Customer is not given.
+	CustomerDetails *TaxCustomerDetails `form:"customer_details"`
+
+	// (Optional) The shipping cost, whose Amount, TaxBehavior and TaxCode are
+	// sent.
+	ShippingCost *TaxShippingCost `form:"shipping_cost"`
+
+	// (Optional) The time to calculate tax at. Defaults to now.
+	TaxDate *UnixTime `form:"tax_date"`
+}
+
+// TaxCalculationClient encapsulates operations for calculating tax using the
+// Stripe REST API.
+type TaxCalculationClient struct{ scope }
+
+// With returns a TaxCalculationClient configured with the given options.
+func (c TaxCalculationClient) With(opts ...ClientOption) TaxCalculationClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Tax Calculation.
+//
+// see https://stripe.com/docs/api/tax/calculations/create
+func (c TaxCalculationClient) Create(params *TaxCalculationParams) (*TaxCalculation, error) {
+	res := &TaxCalculation{}
+	return res, c.query("POST", "/tax/calculations", formValues(params), res)
+}
+
+// Returns the line items of the Tax Calculation with the given ID.
+//
+// see https://stripe.com/docs/api/tax/calculations/line_items
+func (c TaxCalculationClient) LineItems(id string, limit int, before, after string) ([]*TaxLineItem, bool, error) {
+	res := TaxLineItemList{}
+	path := "/tax/calculations/" + url.QueryEscape(id) + "/line_items"
+	err := c.query("GET", path, listParams(limit, before, after), &res)
+	return res.Data, res.More, err
+}
+
+// Tax Transaction Types
+const (
+	TaxTransactionTransaction = "transaction"
+	TaxTransactionReversal    = "reversal"
+)
+
+// TaxTransaction records the tax collected on a completed payment, or the
+// reversal of it when the payment is refunded, for tax reporting.
+//
+// see https://stripe.com/docs/api/tax/transactions/object
+type TaxTransaction struct {
+	APIResource
+
+	ID              string              `json:"id"`
+	Created         UnixTime            `json:"created"`
+	Currency        Currency            `json:"currency"`
+	Customer        string              `json:"customer,omitempty"`
+	CustomerDetails *TaxCustomerDetails `json:"customer_details"`
+	LineItems       *TaxLineItemList    `json:"line_items,omitempty"`
+	Reference       string              `json:"reference"`
+	Reversal        *struct {
+		OriginalTransaction string `json:"original_transaction"`
+	} `json:"reversal,omitempty"`
+	ShippingCost *TaxShippingCost  `json:"shipping_cost,omitempty"`
+	TaxDate      UnixTime          `json:"tax_date"`
+	Type         string            `json:"type"`
+	Metadata     map[string]string `json:"metadata,omitempty"`
+	Livemode     bool              `json:"livemode"`
+}
+
+// TaxReversalLineItem is a line item of the original transaction to reverse.
+type TaxReversalLineItem struct {
+	// The ID of the line item of the original transaction.
+	OriginalLineItem string `form:"original_line_item"`
+
+	// A unique reference for the reversed line item.
+	Reference string `form:"reference"`
+
+	// The amount to reverse, and the tax on it, as negative integers.
+	Amount    int64 `form:"amount"`
+	AmountTax int64 `form:"amount_tax"`
+
+	// (Optional) The quantity reversed.
+	Quantity int `form:"quantity"`
+}
+
+// TaxReversalParams encapsulates options for reversing a Tax Transaction.
+type TaxReversalParams struct {
+	Params
+
+	// The ID of the Tax Transaction to reverse.
+	OriginalTransaction string `form:"original_transaction"`
+
+	// A unique reference for the reversal, e.g. your refund ID.
+	Reference string `form:"reference"`
+
+	// Whether the full transaction or only part of it is reversed: full or
+	// partial.
+	Mode string `form:"mode"`
+
+	// (Optional) The line items to reverse, for partial reversals.
+	LineItems []TaxReversalLineItem `form:"line_items"`
+
+	// (Optional) A negative amount to reverse proportionally across all line
+	// items, for partial reversals.
+	FlatAmount *int64 `form:"flat_amount"`
+
+	// (Optional) The shipping cost to reverse, whose Amount and AmountTax are
+	// sent as negative integers.
+	ShippingCost *TaxShippingCost `form:"shipping_cost"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// TaxTransactionClient encapsulates operations for recording and querying tax
+// transactions using the Stripe REST API.
+type TaxTransactionClient struct{ scope }
+
+// With returns a TaxTransactionClient configured with the given options.
+func (c TaxTransactionClient) With(opts ...ClientOption) TaxTransactionClient {
+	c.apply(opts)
+	return c
+}
+
+// Records the Tax Calculation with the given ID as a Tax Transaction. The
+// reference should uniquely identify the payment, e.g. the PaymentIntent ID.
+//
+// see https://stripe.com/docs/api/tax/transactions/create_from_calculation
+func (c TaxTransactionClient) CreateFromCalculation(calculation, reference string, metadata map[string]string) (*TaxTransaction, error) {
+	values := formValues(struct {
+		Calculation string            `form:"calculation"`
+		Reference   string            `form:"reference"`
+		Metadata    map[string]string `form:"metadata"`
+	}{calculation, reference, metadata})
+
+	res := &TaxTransaction{}
+	return res, c.query("POST", "/tax/transactions/create_from_calculation", values, res)
+}
+
+// Reverses all or part of a Tax Transaction, e.g. after a refund.
+//
+// see https://stripe.com/docs/api/tax/transactions/create_reversal
+func (c TaxTransactionClient) CreateReversal(params *TaxReversalParams) (*TaxTransaction, error) {
+	res := &TaxTransaction{}
+	return res, c.query("POST", "/tax/transactions/create_reversal", formValues(params), res)
+}
+
+// Retrieves the Tax Transaction with the given ID.
+//
+// see https://stripe.com/docs/api/tax/transactions/retrieve
+func (c TaxTransactionClient) Get(id string) (*TaxTransaction, error) {
+	res := &TaxTransaction{}
+	return res, c.query("GET", "/tax/transactions/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns the line items of the Tax Transaction with the given ID.
+//
+// see https://stripe.com/docs/api/tax/transactions/line_items
+func (c TaxTransactionClient) LineItems(id string, limit int, before, after string) ([]*TaxLineItem, bool, error) {
+	res := TaxLineItemList{}
+	path := "/tax/transactions/" + url.QueryEscape(id) + "/line_items"
+	err := c.query("GET", path, listParams(limit, before, after), &res)
+	return res.Data, res.More, err
+}
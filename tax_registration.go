@@ -0,0 +1,151 @@
+package stripe
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Tax Registration Statuses
+const (
+	TaxRegistrationActive    = "active"
+	TaxRegistrationExpired   = "expired"
+	TaxRegistrationScheduled = "scheduled"
+)
+
+// TaxRegistration records that the business is registered to collect tax in
+// a country, or a state or other jurisdiction within it, so that Stripe Tax
+// calculates and collects tax there while the registration is active.
+//
+// see https://stripe.com/docs/api/tax/registrations/object
+type TaxRegistration struct {
+	APIResource
+
+	ID         string    `json:"id"`
+	ActiveFrom UnixTime  `json:"active_from"`
+	Country    string    `json:"country"`
+	Created    UnixTime  `json:"created"`
+	ExpiresAt  *UnixTime `json:"expires_at,omitempty"`
+	Status     string    `json:"status"`
+	Livemode   bool      `json:"livemode"`
+
+	// The type of the registration and its jurisdiction, keyed by the
+	// lowercase country code, e.g. "us".
+	CountryOptions map[string]*TaxRegistrationCountryOptions `json:"country_options"`
+}
+
+// TaxRegistrationCountryOptions describes the kind of registration in a
+// country.
+type TaxRegistrationCountryOptions struct {
+	// The type of the registration, e.g. "standard" in most countries,
+	// "state_sales_tax" in a US state or "oss_union" for the EU's One Stop
+	// Shop.
+	Type string `json:"type" form:"type"`
+
+	// The ISO 3166-2 code of the state, province or other subdivision
+	// registered in, e.g. "CA" in the US. Required in the US and some other
+	// countries.
+	State string `json:"state,omitempty" form:"state"`
+
+	// The place of supply scheme of a standard registration in the EU, e.g.
+	// "small_seller".
+	Standard *struct {
+		PlaceOfSupplyScheme string `json:"place_of_supply_scheme" form:"place_of_supply_scheme"`
+	} `json:"standard,omitempty" form:"standard"`
+}
+
+// TaxRegistrationTime is when a Tax Registration takes effect or expires:
+// TaxRegistrationNow, or a time given with TaxRegistrationAt.
+type TaxRegistrationTime string
+
+// TaxRegistrationNow is the time Stripe receives the request.
+const TaxRegistrationNow TaxRegistrationTime = "now"
+
+// TaxRegistrationAt returns the TaxRegistrationTime of t.
+func TaxRegistrationAt(t time.Time) TaxRegistrationTime {
+	return TaxRegistrationTime(strconv.FormatInt(t.Unix(), 10))
+}
+
+// TaxRegistrationParams encapsulates options for creating and updating Tax
+// Registrations. Only ActiveFrom and ExpiresAt can be updated.
+type TaxRegistrationParams struct {
+	Params
+
+	// The two-letter ISO code of the country registered in.
+	Country string `form:"country"`
+
+	// The type of the registration, keyed by the lowercase country code,
+	// e.g. "us" for a registration of type "state_sales_tax" in a US state.
+	CountryOptions map[string]*TaxRegistrationCountryOptions `form:"country_options"`
+
+	// (Optional) When the registration takes effect. Defaults to
+	// TaxRegistrationNow when creating a registration.
+	ActiveFrom TaxRegistrationTime `form:"active_from"`
+
+	// (Optional) When the registration expires, e.g. TaxRegistrationNow.
+	ExpiresAt TaxRegistrationTime `form:"expires_at"`
+}
+
+// TaxRegistrationListParams encapsulates options for filtering a list of Tax
+// Registrations.
+type TaxRegistrationListParams struct {
+	ListParams
+
+	// (Optional) Only return registrations with the given status, e.g.
+	// TaxRegistrationActive, or "all".
+	Status string `form:"status"`
+}
+
+// TaxRegistrationClient encapsulates operations for managing the
+// jurisdictions the business collects tax in using the Stripe REST API.
+type TaxRegistrationClient struct{ scope }
+
+// With returns a TaxRegistrationClient configured with the given options.
+func (c TaxRegistrationClient) With(opts ...ClientOption) TaxRegistrationClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a Tax Registration.
+//
+// see https://stripe.com/docs/api/tax/registrations/create
+func (c TaxRegistrationClient) Create(params *TaxRegistrationParams) (*TaxRegistration, error) {
+	if params.ActiveFrom == "" {
+		p := *params
+		p.ActiveFrom = TaxRegistrationNow
+		params = &p
+	}
+	res := &TaxRegistration{}
+	return res, c.query("POST", "/tax/registrations", formValues(params), res)
+}
+
+// Retrieves the Tax Registration with the given ID.
+//
+// see https://stripe.com/docs/api/tax/registrations/retrieve
+func (c TaxRegistrationClient) Get(id string) (*TaxRegistration, error) {
+	res := &TaxRegistration{}
+	return res, c.query("GET", "/tax/registrations/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates when the Tax Registration with the given ID takes effect or
+// expires, e.g. to expire it once the business is no longer registered.
+//
+// see https://stripe.com/docs/api/tax/registrations/update
+func (c TaxRegistrationClient) Update(id string, params *TaxRegistrationParams) (*TaxRegistration, error) {
+	// Stripe rejects the parameters that can only be set on creation
+	update := &TaxRegistrationParams{Params: params.Params, ActiveFrom: params.ActiveFrom, ExpiresAt: params.ExpiresAt}
+	res := &TaxRegistration{}
+	return res, c.query("POST", "/tax/registrations/"+url.QueryEscape(id), formValues(update), res)
+}
+
+// Returns a list of Tax Registrations matching the params.
+//
+// see https://stripe.com/docs/api/tax/registrations/all
+func (c TaxRegistrationClient) List(params *TaxRegistrationListParams) ([]*TaxRegistration, bool, error) {
+	res := struct {
+		ListObject
+		Data []*TaxRegistration
+	}{}
+	err := c.query("GET", "/tax/registrations", formValues(params), &res)
+	return res.Data, res.More, err
+}
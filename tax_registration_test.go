@@ -0,0 +1,65 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestTaxRegistrations will test that a registration is created in a state
+// from now, and expired by an update.
+func TestTaxRegistrations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		switch r.URL.Path {
+		case "/v1/tax/registrations":
+			if r.Form.Get("country") != "US" || r.Form.Get("country_options[us][type]") != "state_sales_tax" ||
+				r.Form.Get("country_options[us][state]") != "CA" || r.Form.Get("active_from") != "now" {
+				t.Errorf("Expected a registration in California from now, got %v", r.Form)
+			}
+			w.Write([]byte(`{"id":"taxreg_1","country":"US","status":"active","active_from":1700000000,
+				"country_options":{"us":{"type":"state_sales_tax","state":"CA"}}}`))
+		case "/v1/tax/registrations/taxreg_1":
+			if r.Form.Get("expires_at") != "now" || r.Form.Get("active_from") != "" || r.Form.Get("country") != "" {
+				t.Errorf("Expected the registration to be expired now, got %v", r.Form)
+			}
+			w.Write([]byte(`{"id":"taxreg_1","country":"US","status":"expired","expires_at":1700086400}`))
+		}
+	}))
+	defer server.Close()
+	registrations := TaxRegistrations.With(WithBaseURL(server.URL))
+
+	reg, err := registrations.Create(&TaxRegistrationParams{
+		Country:        "US",
+		CountryOptions: map[string]*TaxRegistrationCountryOptions{"us": {Type: "state_sales_tax", State: "CA"}},
+	})
+	if err != nil || reg.CountryOptions["us"] == nil || reg.CountryOptions["us"].State != "CA" {
+		t.Fatalf("Expected the registration to be created, got %+v, %v", reg, err)
+	}
+	reg, err = registrations.Update("taxreg_1", &TaxRegistrationParams{Country: "US", ExpiresAt: TaxRegistrationNow})
+	if err != nil || reg.Status != TaxRegistrationExpired || reg.ExpiresAt == nil {
+		t.Errorf("Expected the registration to be expired, got %+v, %v", reg, err)
+	}
+}
+
+// TestTaxSettings will test that the head office is sent with the settings,
+// and the fields missing for them to become active are returned.
+func TestTaxSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.Method != "POST" || r.Form.Get("head_office[address][country]") != "US" || r.Form.Get("defaults[tax_behavior]") != TaxExclusive {
+			t.Errorf("Expected the head office and default tax behavior, got %s %v", r.Method, r.Form)
+		}
+		w.Write([]byte(`{"object":"tax.settings","defaults":{"tax_behavior":"exclusive"},"status":"pending",
+			"status_details":{"pending":{"missing_fields":["head_office.address.postal_code"]}}}`))
+	}))
+	defer server.Close()
+
+	settings, err := TaxSettings.With(WithBaseURL(server.URL)).Update(&TaxSettingsParams{DefaultTaxBehavior: String(TaxExclusive), HeadOffice: &Address{Country: "US", State: "CA"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settings.Status != TaxSettingsPending || settings.StatusDetails.Pending == nil || len(settings.StatusDetails.Pending.MissingFields) != 1 {
+		t.Errorf("Expected pending settings missing a postal code, got %+v", settings)
+	}
+}
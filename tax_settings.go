@@ -0,0 +1,81 @@
+package stripe
+
+import ()
+
+// Tax Settings Statuses
+const (
+	TaxSettingsActive  = "active"
+	TaxSettingsPending = "pending"
+)
+
+// AccountTaxSettings holds the settings Stripe Tax calculates tax with for the
+// account: the defaults of its products and the address of its head office.
+// Tax is only calculated once the settings are active, when no required field
+// is missing.
+//
+// see https://stripe.com/docs/api/tax/settings/object
+type AccountTaxSettings struct {
+	APIResource
+
+	Defaults struct {
+		TaxBehavior string `json:"tax_behavior,omitempty"`
+		TaxCode     string `json:"tax_code,omitempty"`
+	} `json:"defaults"`
+	HeadOffice *struct {
+		Address *Address `json:"address"`
+	} `json:"head_office,omitempty"`
+	Status        string `json:"status"`
+	StatusDetails struct {
+		Pending *struct {
+			// The fields that must be set for the settings to become active,
+			// e.g. "head_office".
+			MissingFields []string `json:"missing_fields"`
+		} `json:"pending,omitempty"`
+	} `json:"status_details"`
+	Livemode bool `json:"livemode"`
+}
+
+// TaxSettingsParams encapsulates options for updating the account's tax
+// settings.
+type TaxSettingsParams struct {
+	Params
+
+	// (Optional) Whether prices are exclusive or inclusive of tax by default:
+	// TaxExclusive, TaxInclusive or "inferred_by_currency".
+	DefaultTaxBehavior *string `form:"defaults[tax_behavior]"`
+
+	// (Optional) The tax code of products that have none, e.g.
+	// "txcd_10000000".
+	DefaultTaxCode *string `form:"defaults[tax_code]"`
+
+	// (Optional) The address of the business's head office.
+	HeadOffice *Address `form:"head_office[address]"`
+}
+
+// TaxSettingsClient encapsulates operations for retrieving and updating the
+// account's Stripe Tax settings using the Stripe REST API. The account has a
+// single set of settings, so they are named AccountTaxSettings rather than
+// after the client.
+type TaxSettingsClient struct{ scope }
+
+// With returns a TaxSettingsClient configured with the given options.
+func (c TaxSettingsClient) With(opts ...ClientOption) TaxSettingsClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the account's tax settings.
+//
+// see https://stripe.com/docs/api/tax/settings/retrieve
+func (c TaxSettingsClient) Get() (*AccountTaxSettings, error) {
+	res := &AccountTaxSettings{}
+	return res, c.query("GET", "/tax/settings", nil, res)
+}
+
+// Updates the account's tax settings.
+//
+// see https://stripe.com/docs/api/tax/settings/update
+func (c TaxSettingsClient) Update(params *TaxSettingsParams) (*AccountTaxSettings, error) {
+	res := &AccountTaxSettings{}
+	return res, c.query("POST", "/tax/settings", formValues(params), res)
+}
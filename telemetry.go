@@ -0,0 +1,60 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// whether the X-Stripe-Client-Telemetry header is sent with requests
+var _telemetry bool
+
+// SetTelemetry will enable or disable sending the X-Stripe-Client-Telemetry
+// header, which reports the ID and duration of a previous request to Stripe to
+// help diagnose latency issues. It is disabled by default.
+func SetTelemetry(enabled bool) {
+	_telemetry = enabled
+}
+
+// requestMetrics are the metrics of a completed request, reported to Stripe in
+// the X-Stripe-Client-Telemetry header of a later one.
+type requestMetrics struct {
+	RequestID         string `json:"request_id"`
+	RequestDurationMS int64  `json:"request_duration_ms"`
+}
+
+// the metrics of completed requests not yet reported, which are dropped once
+// the buffer is full rather than blocking requests
+var metrics = make(chan requestMetrics, 16)
+
+// addTelemetry sets the X-Stripe-Client-Telemetry header of req to the metrics
+// of a previous request, if telemetry is enabled and one is waiting.
+func addTelemetry(req *http.Request) {
+	if !_telemetry {
+		return
+	}
+	select {
+	case m := <-metrics:
+		data, _ := json.Marshal(struct {
+			LastRequestMetrics requestMetrics `json:"last_request_metrics"`
+		}{m})
+		req.Header.Set("X-Stripe-Client-Telemetry", string(data))
+	default:
+	}
+}
+
+// recordTelemetry keeps the metrics of a request that was answered with r
+// after starting at start, to be reported by a later request.
+func recordTelemetry(r *http.Response, start time.Time) {
+	if !_telemetry {
+		return
+	}
+	id := r.Header.Get("Request-Id")
+	if id == "" {
+		return
+	}
+	select {
+	case metrics <- requestMetrics{id, int64(time.Since(start) / time.Millisecond)}:
+	default:
+	}
+}
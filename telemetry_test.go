@@ -0,0 +1,39 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestTelemetry will test that the ID of a request is reported in the
+// X-Stripe-Client-Telemetry header of the next one, only once enabled.
+func TestTelemetry(t *testing.T) {
+	var headers []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get("X-Stripe-Client-Telemetry"))
+		w.Header().Set("Request-Id", "req_"+string(rune('0'+len(headers))))
+		w.Write([]byte(`{"id":"cus_1"}`))
+	}))
+	defer server.Close()
+
+	Customers.With(WithBaseURL(server.URL)).Get("cus_1")
+	defer SetTelemetry(false)
+	SetTelemetry(true)
+	Customers.With(WithBaseURL(server.URL)).Get("cus_1")
+	Customers.With(WithBaseURL(server.URL)).Get("cus_1")
+
+	if headers[0] != "" || headers[1] != "" {
+		t.Errorf("Expected no telemetry before a request was recorded, got %q", headers[:2])
+	}
+	var telemetry struct {
+		LastRequestMetrics requestMetrics `json:"last_request_metrics"`
+	}
+	if err := json.Unmarshal([]byte(headers[2]), &telemetry); err != nil {
+		t.Fatalf("Expected telemetry JSON, got %q", headers[2])
+	}
+	if telemetry.LastRequestMetrics.RequestID != "req_2" || telemetry.LastRequestMetrics.RequestDurationMS < 0 {
+		t.Errorf("Expected metrics of req_2, got %+v", telemetry.LastRequestMetrics)
+	}
+}
@@ -0,0 +1,369 @@
+package stripe
+
+import "net/url"
+
+// ConnectionToken is a short-lived secret that the Terminal SDKs use to
+// connect to readers.
+//
+// see https://stripe.com/docs/api/terminal/connection_tokens/object
+type ConnectionToken struct {
+	APIResource
+
+	Location string `json:"location,omitempty"`
+	Secret   string `json:"secret"`
+}
+
+// ConnectionTokenClient encapsulates operations for creating Terminal
+// connection tokens using the Stripe REST API.
+type ConnectionTokenClient struct{ scope }
+
+// With returns a ConnectionTokenClient configured with the given options.
+func (c ConnectionTokenClient) With(opts ...ClientOption) ConnectionTokenClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Connection Token for the SDK to pass to a reader. If location
+// is not empty, the token can only connect to readers assigned to the Location
+// with that ID.
+//
+// see https://stripe.com/docs/api/terminal/connection_tokens/create
+func (c ConnectionTokenClient) Create(location string) (*ConnectionToken, error) {
+	values := formValues(struct {
+		Location string `form:"location"`
+	}{location})
+	res := &ConnectionToken{}
+	return res, c.query("POST", "/terminal/connection_tokens", values, res)
+}
+
+// TerminalLocation represents a physical place, such as a store, that card
+// readers are assigned to.
+//
+// see https://stripe.com/docs/api/terminal/locations/object
+type TerminalLocation struct {
+	APIResource
+
+	ID                     string            `json:"id"`
+	Address                *Address          `json:"address"`
+	ConfigurationOverrides string            `json:"configuration_overrides,omitempty"`
+	DisplayName            string            `json:"display_name"`
+	Metadata               map[string]string `json:"metadata,omitempty"`
+	Livemode               bool              `json:"livemode"`
+}
+
+// TerminalLocationParams encapsulates options for creating and updating
+// Terminal Locations.
+type TerminalLocationParams struct {
+	Params
+
+	// A name for the location, e.g. the name of the store.
+	DisplayName string `form:"display_name"`
+
+	// The full address of the location, which is required on creation.
+	Address *Address `form:"address"`
+
+	// (Optional) The ID of a Terminal Configuration to use for the
+	// location's readers.
+	ConfigurationOverrides *string `form:"configuration_overrides"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// TerminalLocationClient encapsulates operations for creating, updating,
+// deleting and querying Terminal locations using the Stripe REST API.
+type TerminalLocationClient struct{ scope }
+
+// With returns a TerminalLocationClient configured with the given options.
+func (c TerminalLocationClient) With(opts ...ClientOption) TerminalLocationClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Terminal Location.
+//
+// see https://stripe.com/docs/api/terminal/locations/create
+func (c TerminalLocationClient) Create(params *TerminalLocationParams) (*TerminalLocation, error) {
+	res := &TerminalLocation{}
+	return res, c.query("POST", "/terminal/locations", formValues(params), res)
+}
+
+// Retrieves the Terminal Location with the given ID.
+//
+// see https://stripe.com/docs/api/terminal/locations/retrieve
+func (c TerminalLocationClient) Get(id string) (*TerminalLocation, error) {
+	res := &TerminalLocation{}
+	return res, c.query("GET", "/terminal/locations/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the Terminal Location with the given ID.
+//
+// see https://stripe.com/docs/api/terminal/locations/update
+func (c TerminalLocationClient) Update(id string, params *TerminalLocationParams) (*TerminalLocation, error) {
+	res := &TerminalLocation{}
+	return res, c.query("POST", "/terminal/locations/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Deletes the Terminal Location with the given ID.
+//
+// see https://stripe.com/docs/api/terminal/locations/delete
+func (c TerminalLocationClient) Delete(id string) (*Deleted, error) {
+	res := &Deleted{}
+	return res, c.query("DELETE", "/terminal/locations/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of your Terminal Locations with the specified range.
+//
+// see https://stripe.com/docs/api/terminal/locations/list
+func (c TerminalLocationClient) List(limit int, before, after string) ([]*TerminalLocation, bool, error) {
+	res := struct {
+		ListObject
+		Data []*TerminalLocation
+	}{}
+	err := c.query("GET", "/terminal/locations", listParams(limit, before, after), &res)
+	return res.Data, res.More, err
+}
+
+// Reader Action Statuses
+const (
+	ReaderActionInProgress = "in_progress"
+	ReaderActionSucceeded  = "succeeded"
+	ReaderActionFailed     = "failed"
+)
+
+// Reader Statuses
+const (
+	ReaderOnline  = "online"
+	ReaderOffline = "offline"
+)
+
+// TerminalReader represents a physical card reader registered to your
+// account.
+//
+// see https://stripe.com/docs/api/terminal/readers/object
+type TerminalReader struct {
+	APIResource
+
+	ID              string            `json:"id"`
+	Action          *ReaderAction     `json:"action,omitempty"`
+	DeviceSwVersion string            `json:"device_sw_version,omitempty"`
+	DeviceType      string            `json:"device_type"`
+	IPAddress       string            `json:"ip_address,omitempty"`
+	Label           string            `json:"label"`
+	LastSeenAt      *UnixTime         `json:"last_seen_at,omitempty"`
+	Location        string            `json:"location,omitempty"`
+	SerialNumber    string            `json:"serial_number"`
+	Status          string            `json:"status,omitempty"`
+	Metadata        map[string]string `json:"metadata,omitempty"`
+	Livemode        bool              `json:"livemode"`
+}
+
+// TerminalReaderParams encapsulates options for registering and updating
+// Terminal Readers.
+type TerminalReaderParams struct {
+	Params
+
+	// The code shown on the reader's screen when it is ready to be
+	// registered. Can only be set on creation.
+	RegistrationCode string `form:"registration_code"`
+
+	// (Optional) The ID of the Location to assign the reader to. Can only be
+	// set on creation.
+	Location *string `form:"location"`
+
+	// (Optional) A name for the reader, e.g. "Front Counter".
+	Label *string `form:"label"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// TerminalReaderListParams encapsulates options for filtering a list of
+// Terminal Readers.
+type TerminalReaderListParams struct {
+	ListParams
+
+	// (Optional) Only return readers of the given type, e.g. bbpos_wisepos_e.
+	DeviceType string `form:"device_type"`
+
+	// (Optional) Only return readers assigned to the Location with the given
+	// ID.
+	Location string `form:"location"`
+
+	// (Optional) Only return the reader with the given serial number.
+	SerialNumber string `form:"serial_number"`
+
+	// (Optional) Only return readers that are online or offline.
+	Status string `form:"status"`
+}
+
+// TerminalReaderClient encapsulates operations for registering, updating,
+// deleting and querying card readers using the Stripe REST API.
+type TerminalReaderClient struct{ scope }
+
+// With returns a TerminalReaderClient configured with the given options.
+func (c TerminalReaderClient) With(opts ...ClientOption) TerminalReaderClient {
+	c.apply(opts)
+	return c
+}
+
+// Registers a new Terminal Reader using the registration code it displays.
+//
+// see https://stripe.com/docs/api/terminal/readers/create
+func (c TerminalReaderClient) Create(params *TerminalReaderParams) (*TerminalReader, error) {
+	res := &TerminalReader{}
+	return res, c.query("POST", "/terminal/readers", formValues(params), res)
+}
+
+// Retrieves the Terminal Reader with the given ID.
+//
+// see https://stripe.com/docs/api/terminal/readers/retrieve
+func (c TerminalReaderClient) Get(id string) (*TerminalReader, error) {
+	res := &TerminalReader{}
+	return res, c.query("GET", "/terminal/readers/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the label or metadata of the Terminal Reader with the given ID.
+//
+// see https://stripe.com/docs/api/terminal/readers/update
+func (c TerminalReaderClient) Update(id string, params *TerminalReaderParams) (*TerminalReader, error) {
+	res := &TerminalReader{}
+	return res, c.query("POST", "/terminal/readers/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Deletes the Terminal Reader with the given ID.
+//
+// see https://stripe.com/docs/api/terminal/readers/delete
+func (c TerminalReaderClient) Delete(id string) (*Deleted, error) {
+	res := &Deleted{}
+	return res, c.query("DELETE", "/terminal/readers/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of Terminal Readers matching the params.
+//
+// see https://stripe.com/docs/api/terminal/readers/list
+func (c TerminalReaderClient) List(params *TerminalReaderListParams) ([]*TerminalReader, bool, error) {
+	res := struct {
+		ListObject
+		Data []*TerminalReader
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/terminal/readers", values, &res)
+	return res.Data, res.More, err
+}
+
+// ReaderAction describes the action a server-driven reader is performing, or
+// last performed.
+type ReaderAction struct {
+	Type           string `json:"type"`
+	Status         string `json:"status"`
+	FailureCode    string `json:"failure_code,omitempty"`
+	FailureMessage string `json:"failure_message,omitempty"`
+
+	ProcessPaymentIntent *struct {
+		PaymentIntent string `json:"payment_intent"`
+	} `json:"process_payment_intent,omitempty"`
+
+	ProcessSetupIntent *struct {
+		SetupIntent   string `json:"setup_intent"`
+		GeneratedCard string `json:"generated_card,omitempty"`
+	} `json:"process_setup_intent,omitempty"`
+
+	RefundPayment *ReaderRefundParams `json:"refund_payment,omitempty"`
+
+	SetReaderDisplay *struct {
+		Type string      `json:"type"`
+		Cart *ReaderCart `json:"cart,omitempty"`
+	} `json:"set_reader_display,omitempty"`
+}
+
+// ReaderCart holds the line items and totals shown on a reader's display.
+type ReaderCart struct {
+	Currency  Currency         `json:"currency" form:"currency"`
+	LineItems []ReaderLineItem `json:"line_items" form:"line_items"`
+	Tax       int64            `json:"tax,omitempty" form:"tax"`
+	Total     int64            `json:"total" form:"total"`
+}
+
+// ReaderLineItem is a single line shown in a ReaderCart.
+type ReaderLineItem struct {
+	Amount      int64  `json:"amount" form:"amount"`
+	Description string `json:"description" form:"description"`
+	Quantity    int    `json:"quantity" form:"quantity"`
+}
+
+// ReaderRefundParams encapsulates options for refunding an in-person payment
+// on a reader, which collects the card again to verify it.
+type ReaderRefundParams struct {
+	Params
+
+	// The ID of the Charge or PaymentIntent to refund; one is required.
+	Charge        string `json:"charge,omitempty" form:"charge"`
+	PaymentIntent string `json:"payment_intent,omitempty" form:"payment_intent"`
+
+	// (Optional) The amount to refund. Defaults to the full amount.
+	Amount *int64 `json:"amount,omitempty" form:"amount"`
+
+	Metadata map[string]string `json:"metadata,omitempty" form:"metadata"`
+}
+
+// Hands the PaymentIntent with the given ID to the reader, which collects and
+// processes a payment method for it.
+//
+// see https://stripe.com/docs/api/terminal/readers/process_payment_intent
+func (c TerminalReaderClient) ProcessPaymentIntent(id, paymentIntent string) (*TerminalReader, error) {
+	values := url.Values{"payment_intent": {paymentIntent}}
+	return c.action(id, "process_payment_intent", values)
+}
+
+// Hands the SetupIntent with the given ID to the reader, which collects a
+// payment method to save for later use. allowRedisplay is one of always,
+// limited or unspecified.
+//
+// see https://stripe.com/docs/api/terminal/readers/process_setup_intent
+func (c TerminalReaderClient) ProcessSetupIntent(id, setupIntent, allowRedisplay string) (*TerminalReader, error) {
+	values := url.Values{
+		"setup_intent":    {setupIntent},
+		"allow_redisplay": {allowRedisplay},
+	}
+	return c.action(id, "process_setup_intent", values)
+}
+
+// Shows the cart on the screen of the reader with the given ID.
+//
+// see https://stripe.com/docs/api/terminal/readers/set_reader_display
+func (c TerminalReaderClient) SetReaderDisplay(id string, cart *ReaderCart) (*TerminalReader, error) {
+	values := formValues(struct {
+		Type string      `form:"type"`
+		Cart *ReaderCart `form:"cart"`
+	}{"cart", cart})
+	return c.action(id, "set_reader_display", values)
+}
+
+// Refunds an in-person payment using the reader with the given ID.
+//
+// see https://stripe.com/docs/api/terminal/readers/refund_payment
+func (c TerminalReaderClient) RefundPayment(id string, params *ReaderRefundParams) (*TerminalReader, error) {
+	return c.action(id, "refund_payment", formValues(params))
+}
+
+// Cancels the action in progress on the reader with the given ID.
+//
+// see https://stripe.com/docs/api/terminal/readers/cancel_action
+func (c TerminalReaderClient) CancelAction(id string) (*TerminalReader, error) {
+	return c.action(id, "cancel_action", nil)
+}
+
+// Simulates presenting a card to the simulated reader with the given ID, to
+// complete its in-progress action. Test mode only.
+//
+// see https://stripe.com/docs/api/terminal/readers/present_payment_method
+func (c TerminalReaderClient) PresentPaymentMethod(id string) (*TerminalReader, error) {
+	res := &TerminalReader{}
+	path := "/test_helpers/terminal/readers/" + url.QueryEscape(id) + "/present_payment_method"
+	return res, c.query("POST", path, nil, res)
+}
+
+func (c TerminalReaderClient) action(id, action string, values url.Values) (*TerminalReader, error) {
+	res := &TerminalReader{}
+	return res, c.query("POST", "/terminal/readers/"+url.QueryEscape(id)+"/"+action, values, res)
+}
@@ -0,0 +1,98 @@
+package stripe
+
+// Card numbers Stripe accepts in test mode, with any future expiration date
+// and any security code. They are declined in live mode.
+//
+// see https://stripe.com/docs/testing#cards
+const (
+	TestCardVisa             = "4242424242424242"
+	TestCardVisaDebit        = "4000056655665556"
+	TestCardMasterCard       = "5555555555554444"
+	TestCardMasterCard2      = "2223003122003222"
+	TestCardMasterCardDebit  = "5200828282828210"
+	TestCardMasterCardPrepay = "5105105105105100"
+	TestCardAmex             = "378282246310005"
+	TestCardDiscover         = "6011111111111117"
+	TestCardDinersClub       = "3056930009020004"
+	TestCardDinersClub14     = "36227206271667"
+	TestCardJCB              = "3566002020360505"
+	TestCardUnionPay         = "6200000000000005"
+)
+
+// Test card numbers on which Stripe simulates declines and other outcomes.
+//
+// see https://stripe.com/docs/testing#declined-payments
+const (
+	// Charges are declined with the card_declined code.
+	TestCardChargeDeclined = "4000000000000002"
+
+	// Charges are declined with the insufficient_funds decline code.
+	TestCardInsufficientFunds = "4000000000009995"
+
+	// Charges are declined with the lost_card decline code.
+	TestCardLost = "4000000000009987"
+
+	// Charges are declined with the stolen_card decline code.
+	TestCardStolen = "4000000000009979"
+
+	// Charges are declined with the expired_card code.
+	TestCardExpired = "4000000000000069"
+
+	// Charges are declined with the incorrect_cvc code.
+	TestCardIncorrectCVC = "4000000000000127"
+
+	// Charges are declined with the processing_error code.
+	TestCardProcessingError = "4000000000000119"
+
+	// Charges are blocked by Radar as fraudulent.
+	TestCardFraudulent = "4100000000000019"
+
+	// Charges succeed, but attaching the card to a Customer fails.
+	TestCardAttachDeclined = "4000000000000341"
+
+	// Charges succeed, and are then disputed as fraudulent.
+	TestCardDisputed = "4000000000000259"
+
+	// Payments require 3D Secure authentication.
+	TestCardAuthenticationRequired = "4000002500003155"
+)
+
+// US bank account details Stripe accepts in test mode.
+//
+// see https://stripe.com/docs/testing#ach-direct-debit
+const (
+	TestRoutingNumber = "110000000"
+
+	// Payouts and debits succeed.
+	TestAccountNumber = "000123456789"
+
+	// Payouts fail with the no_account code.
+	TestAccountNumberNoAccount = "000111111116"
+
+	// Payouts fail with the account_closed code.
+	TestAccountNumberClosed = "000111111113"
+
+	// Debits fail with the insufficient_funds code.
+	TestAccountNumberInsufficientFunds = "000222222227"
+)
+
+var testCards = map[string]bool{
+	TestCardVisa: true, TestCardVisaDebit: true, TestCardMasterCard: true,
+	TestCardMasterCard2: true, TestCardMasterCardDebit: true,
+	TestCardMasterCardPrepay: true, TestCardAmex: true, TestCardDiscover: true,
+	TestCardDinersClub: true, TestCardDinersClub14: true, TestCardJCB: true,
+	TestCardUnionPay: true,
+
+	TestCardChargeDeclined: true, TestCardInsufficientFunds: true,
+	TestCardLost: true, TestCardStolen: true, TestCardExpired: true,
+	TestCardIncorrectCVC: true, TestCardProcessingError: true,
+	TestCardFraudulent: true, TestCardAttachDeclined: true,
+	TestCardDisputed: true, TestCardAuthenticationRequired: true,
+}
+
+// IsTestCard reports whether number, which may contain spaces and dashes, is
+// one of the test card numbers above, e.g. to keep them out of live mode.
+func IsTestCard(number string) bool {
+	number, err := ParseCardNumber(number)
+	return err == nil && testCards[number]
+}
@@ -0,0 +1,113 @@
+package stripe
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+var TestClockFailedError = errors.New("stripe: test clock failed to advance")
+
+// Test Clock Statuses
+const (
+	TestClockReady           = "ready"
+	TestClockAdvancing       = "advancing"
+	TestClockInternalFailure = "internal_failure"
+)
+
+// TestClock simulates the passage of time for the Customers attached to it,
+// so billing behaviour such as trials and renewals can be tested in test
+// mode.
+//
+// see https://stripe.com/docs/api/test_clocks/object
+type TestClock struct {
+	APIResource
+
+	ID           string   `json:"id"`
+	Created      UnixTime `json:"created"`
+	DeletesAfter UnixTime `json:"deletes_after"`
+	FrozenTime   UnixTime `json:"frozen_time"`
+	Name         string   `json:"name,omitempty"`
+	Status       string   `json:"status"`
+	Livemode     bool     `json:"livemode"`
+}
+
+// TestClockClient encapsulates operations for creating, advancing and
+// querying test clocks using the Stripe REST API.
+type TestClockClient struct{ scope }
+
+// With returns a TestClockClient configured with the given options.
+func (c TestClockClient) With(opts ...ClientOption) TestClockClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Test Clock frozen at the given time.
+//
+// see https://stripe.com/docs/api/test_clocks/create
+func (c TestClockClient) Create(frozenTime time.Time, name string) (*TestClock, error) {
+	values := url.Values{"frozen_time": {strconv.FormatInt(frozenTime.Unix(), 10)}}
+	if name != "" {
+		values.Add("name", name)
+	}
+	res := &TestClock{}
+	return res, c.query("POST", "/test_helpers/test_clocks", values, res)
+}
+
+// Retrieves the Test Clock with the given ID.
+//
+// see https://stripe.com/docs/api/test_clocks/retrieve
+func (c TestClockClient) Get(id string) (*TestClock, error) {
+	res := &TestClock{}
+	return res, c.query("GET", "/test_helpers/test_clocks/"+url.QueryEscape(id), nil, res)
+}
+
+// Deletes the Test Clock with the given ID, along with its Customers.
+//
+// see https://stripe.com/docs/api/test_clocks/delete
+func (c TestClockClient) Delete(id string) (*Deleted, error) {
+	res := &Deleted{}
+	return res, c.query("DELETE", "/test_helpers/test_clocks/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of your Test Clocks with the specified range.
+//
+// see https://stripe.com/docs/api/test_clocks/list
+func (c TestClockClient) List(limit int, before, after string) ([]*TestClock, bool, error) {
+	res := struct {
+		ListObject
+		Data []*TestClock
+	}{}
+	err := c.query("GET", "/test_helpers/test_clocks", listParams(limit, before, after), &res)
+	return res.Data, res.More, err
+}
+
+// Advances the Test Clock with the given ID to the given time, which must be
+// later than its current frozen time. Advancing is asynchronous; use Wait to
+// block until the clock is ready again.
+//
+// see https://stripe.com/docs/api/test_clocks/advance
+func (c TestClockClient) Advance(id string, frozenTime time.Time) (*TestClock, error) {
+	values := url.Values{"frozen_time": {strconv.FormatInt(frozenTime.Unix(), 10)}}
+	res := &TestClock{}
+	return res, c.query("POST", "/test_helpers/test_clocks/"+url.QueryEscape(id)+"/advance", values, res)
+}
+
+// Wait polls the Test Clock with the given ID every interval until it has
+// finished advancing.
+func (c TestClockClient) Wait(id string, interval time.Duration) (*TestClock, error) {
+	for {
+		clock, err := c.Get(id)
+		if err != nil {
+			return clock, err
+		}
+		switch clock.Status {
+		case TestClockReady:
+			return clock, nil
+		case TestClockInternalFailure:
+			return clock, TestClockFailedError
+		}
+		<-_clock.After(interval)
+	}
+}
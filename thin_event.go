@@ -0,0 +1,71 @@
+package stripe
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var RelatedObjectMissingError = errors.New("stripe: thin event has no related object")
+
+// ThinEvent is the lightweight payload delivered to event destinations by
+// Stripe's v2 event system. Unlike Event it does not embed a snapshot of the
+// object that changed; instead it carries a pointer to it, which can be
+// fetched with FetchRelatedObject.
+//
+// see https://docs.stripe.com/event-destinations#thin-events
+type ThinEvent struct {
+	ID            string         `json:"id"`
+	Object        string         `json:"object"`
+	Type          string         `json:"type"`
+	Created       time.Time      `json:"created"`
+	Livemode      bool           `json:"livemode"`
+	Context       string         `json:"context,omitempty"`
+	RelatedObject *RelatedObject `json:"related_object,omitempty"`
+	Reason        *EventReason   `json:"reason,omitempty"`
+
+	// Additional data specific to the event's type. It is only set on events
+	// retrieved with V2EventClient, not on those delivered to destinations.
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// RelatedObject identifies the API resource a ThinEvent refers to.
+type RelatedObject struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// EventReason describes what caused a ThinEvent, such as an API request.
+type EventReason struct {
+	Type    string `json:"type"`
+	Request *struct {
+		ID             string `json:"id"`
+		IdempotencyKey string `json:"idempotency_key,omitempty"`
+	} `json:"request,omitempty"`
+}
+
+// ParseThinEvent verifies the Stripe-Signature header of a v2 event
+// destination request using the destination's signing secret, or any of
+// several as ParseEvent does, and, if valid, parses the payload as a
+// ThinEvent.
+func ParseThinEvent(payload []byte, header string, secrets ...string) (*ThinEvent, error) {
+	if err := VerifySignatureAny(payload, header, secrets, DefaultTolerance); err != nil {
+		return nil, err
+	}
+	event := ThinEvent{}
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// FetchRelatedObject retrieves the current state of the event's related object
+// from the Stripe API and stores the result in the value pointed to by v,
+// which should be a pointer to the matching resource type (e.g. *Invoice).
+// If the event occurred on a connected account, the object is fetched on
+// behalf of that account. The request is made as by V2Events; use
+// V2EventClient.FetchRelatedObject to fetch it with another client.
+func (e *ThinEvent) FetchRelatedObject(v interface{}) error {
+	return V2Events.FetchRelatedObject(e, v)
+}
@@ -1,14 +1,14 @@
 package stripe
 
-import (
-	"net/url"
-)
+import "net/url"
 
 // Token represents a unique identifier for a credit card that can be safely
 // stored without having to hold sensitive card information on your own servers.
 //
 // see https://stripe.com/docs/api#token_object
 type Token struct {
+	APIResource
+
 	ID       string   `json:"id"`
 	Card     *Card    `json:"card"`
 	Created  UnixTime `json:"created"`
@@ -18,7 +18,13 @@ type Token struct {
 
 // TokenClient encapsulates operations for creating and querying tokens using
 // the Stripe REST API.
-type TokenClient struct{}
+type TokenClient struct{ scope }
+
+// With returns a TokenClient configured with the given options.
+func (c TokenClient) With(opts ...ClientOption) TokenClient {
+	c.apply(opts)
+	return c
+}
 
 // Creates a single use token that wraps the details of a credit card.
 // This token can be used in place of a credit card hash with any API method.
@@ -26,21 +32,26 @@ type TokenClient struct{}
 // attaching them to a customer.
 //
 // see https://stripe.com/docs/api#create_token
-func (TokenClient) Create(params *CardParams) (*Token, error) {
+func (c TokenClient) Create(params *CardParams) (*Token, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
 	token := &Token{}
-	values := make(url.Values)
-	appendCardParams(values, true, params)
+	values := formValues(struct {
+		Card *CardParams `form:"card"`
+	}{params})
+	params.appendExtra(values)
 
-	err := query("POST", "/tokens", values, token)
+	err := c.query("POST", "/tokens", values, token)
 	return token, err
 }
 
 // Retrieves the card token with the given ID.
 //
 // see https://stripe.com/docs/api#retrieve_token
-func (TokenClient) Get(id string) (*Token, error) {
+func (c TokenClient) Get(id string) (*Token, error) {
 	token := Token{}
 	path := "/tokens/" + url.QueryEscape(id)
-	err := query("GET", path, nil, &token)
+	err := c.query("GET", path, nil, &token)
 	return &token, err
 }
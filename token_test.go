@@ -18,7 +18,7 @@ var (
 
 	// Charge with only the required fields
 	token1 = CardParams{
-		Name:     "George Costanza",
+		Name:     String("George Costanza"),
 		Number:   "4242424242424242",
 		ExpYear:  time.Now().Year() + 1,
 		ExpMonth: 5,
@@ -44,8 +44,8 @@ func TestCreateToken(t *testing.T) {
 		t.Errorf("Expected Token Card not nil")
 		return
 	}
-	if resp.Card.Name != token1.Name {
-		t.Errorf("Expected Token Card Name %s, got %s", token1.Name, resp.Card.Name)
+	if resp.Card.Name != *token1.Name {
+		t.Errorf("Expected Token Card Name %s, got %s", *token1.Name, resp.Card.Name)
 	}
 	if resp.Card.ExpMonth != token1.ExpMonth {
 		t.Errorf("Expected Token Card ExpMonth %d, got %d", token1.ExpMonth, resp.Card.ExpMonth)
@@ -0,0 +1,121 @@
+package stripe
+
+import "net/url"
+
+// Topup represents funds added to your Stripe balance from a bank account.
+//
+// see https://stripe.com/docs/api#topup_object
+type Topup struct {
+	APIResource
+
+	ID                       string            `json:"id"`
+	Amount                   int64             `json:"amount"`
+	BalanceTransaction       string            `json:"balance_transaction,omitempty"`
+	Created                  UnixTime          `json:"created"`
+	Currency                 Currency          `json:"currency"`
+	Description              string            `json:"description,omitempty"`
+	ExpectedAvailabilityDate *UnixTime         `json:"expected_availability_date,omitempty"`
+	FailureCode              string            `json:"failure_code,omitempty"`
+	FailureMessage           string            `json:"failure_message,omitempty"`
+	StatementDescriptor      string            `json:"statement_descriptor,omitempty"`
+	Status                   string            `json:"status"`
+	TransferGroup            string            `json:"transfer_group,omitempty"`
+	Metadata                 map[string]string `json:"metadata,omitempty"`
+	Livemode                 bool              `json:"livemode"`
+}
+
+// TopupParams encapsulates options for creating and updating Top-ups.
+type TopupParams struct {
+	Params
+
+	// A positive integer in cents representing how much to add.
+	Amount int64 `form:"amount"`
+
+	// 3-letter ISO code for currency.
+	Currency Currency `form:"currency"`
+
+	// (Optional) An arbitrary string attached to the top-up.
+	Description *string `form:"description"`
+
+	// (Optional) The ID of a source to transfer funds from. Defaults to the
+	// bank account on file.
+	Source *string `form:"source"`
+
+	// (Optional) A string displayed on your bank statement. This may be up to
+	// 15 characters.
+	StatementDescriptor *string `form:"statement_descriptor"`
+
+	// (Optional) A string that identifies this top-up as part of a group of
+	// transfers.
+	TransferGroup *string `form:"transfer_group"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// TopupListParams encapsulates options for filtering a list of Top-ups.
+type TopupListParams struct {
+	ListParams
+
+	// (Optional) Only return top-ups with the given status: canceled, failed,
+	// pending or succeeded.
+	Status string `form:"status"`
+
+	// (Optional) Only return top-ups created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// TopupClient encapsulates operations for creating, updating, canceling and
+// querying top-ups using the Stripe REST API.
+type TopupClient struct{ scope }
+
+// With returns a TopupClient configured with the given options.
+func (c TopupClient) With(opts ...ClientOption) TopupClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Top-up.
+//
+// see https://stripe.com/docs/api#create_topup
+func (c TopupClient) Create(params *TopupParams) (*Topup, error) {
+	res := &Topup{}
+	return res, c.query("POST", "/topups", formValues(params), res)
+}
+
+// Retrieves the Top-up with the given ID.
+//
+// see https://stripe.com/docs/api#retrieve_topup
+func (c TopupClient) Get(id string) (*Topup, error) {
+	res := &Topup{}
+	return res, c.query("GET", "/topups/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the description and metadata of the Top-up with the given ID.
+//
+// see https://stripe.com/docs/api#update_topup
+func (c TopupClient) Update(id string, params *TopupParams) (*Topup, error) {
+	res := &Topup{}
+	return res, c.query("POST", "/topups/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Cancels the pending Top-up with the given ID.
+//
+// see https://stripe.com/docs/api#cancel_topup
+func (c TopupClient) Cancel(id string) (*Topup, error) {
+	res := &Topup{}
+	return res, c.query("POST", "/topups/"+url.QueryEscape(id)+"/cancel", nil, res)
+}
+
+// Returns a list of Top-ups matching the given filters.
+//
+// see https://stripe.com/docs/api#list_topups
+func (c TopupClient) List(params *TopupListParams) ([]*Topup, bool, error) {
+	res := struct {
+		ListObject
+		Data []*Topup
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/topups", values, &res)
+	return res.Data, res.More, err
+}
@@ -0,0 +1,151 @@
+package stripe
+
+import "net/url"
+
+// Transfer represents a movement of funds from your platform's balance to a
+// connected account.
+//
+// see https://stripe.com/docs/api#transfer_object
+type Transfer struct {
+	APIResource
+
+	ID                 string            `json:"id"`
+	Amount             int64             `json:"amount"`
+	AmountReversed     int64             `json:"amount_reversed"`
+	Currency           Currency          `json:"currency"`
+	Created            UnixTime          `json:"created"`
+	Description        string            `json:"description,omitempty"`
+	Destination        string            `json:"destination"`
+	DestinationPayment string            `json:"destination_payment,omitempty"`
+	BalanceTransaction string            `json:"balance_transaction"`
+	Reversed           bool              `json:"reversed"`
+	Reversals          *ReversalList     `json:"reversals,omitempty"`
+	SourceTransaction  string            `json:"source_transaction,omitempty"`
+	SourceType         string            `json:"source_type,omitempty"`
+	TransferGroup      string            `json:"transfer_group,omitempty"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+	Livemode           bool              `json:"livemode"`
+}
+
+// TransferParams encapsulates options for creating and updating Transfers.
+type TransferParams struct {
+	Params
+
+	// A positive integer in cents representing how much to transfer.
+	Amount int64 `form:"amount"`
+
+	// 3-letter ISO code for currency.
+	Currency Currency `form:"currency"`
+
+	// The ID of the connected account the funds are sent to.
+	Destination string `form:"destination"`
+
+	// (Optional) An arbitrary string attached to the transfer.
+	Description *string `form:"description"`
+
+	// (Optional) A string that identifies this transaction as part of a group
+	// of charges and transfers, e.g. an order ID.
+	TransferGroup *string `form:"transfer_group"`
+
+	// (Optional) The ID of an existing charge used as the source of funds. The
+	// transfer will not be paid out until the charge's funds are available.
+	SourceTransaction *string `form:"source_transaction"`
+
+	// (Optional) The source balance to draw from: card, fpx or bank_account.
+	SourceType *string `form:"source_type"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// TransferClient encapsulates operations for creating, updating and querying
+// transfers using the Stripe REST API.
+type TransferClient struct{ scope }
+
+// With returns a TransferClient configured with the given options.
+func (c TransferClient) With(opts ...ClientOption) TransferClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Transfer to a connected account.
+//
+// see https://stripe.com/docs/api#create_transfer
+func (c TransferClient) Create(params *TransferParams) (*Transfer, error) {
+	res := &Transfer{}
+	return res, c.query("POST", "/transfers", formValues(params), res)
+}
+
+// Retrieves the Transfer with the given ID.
+//
+// see https://stripe.com/docs/api#retrieve_transfer
+func (c TransferClient) Get(id string) (*Transfer, error) {
+	res := &Transfer{}
+	return res, c.query("GET", "/transfers/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the description and metadata of the Transfer with the given ID.
+//
+// see https://stripe.com/docs/api#update_transfer
+func (c TransferClient) Update(id string, params *TransferParams) (*Transfer, error) {
+	res := &Transfer{}
+	return res, c.query("POST", "/transfers/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Returns a list of your Transfers with the specified range.
+//
+// see https://stripe.com/docs/api#list_transfers
+func (c TransferClient) List(limit int, before, after string) ([]*Transfer, bool, error) {
+	return c.list(nil, limit, before, after)
+}
+
+// Returns a list of the Transfers in the given transfer group, e.g. those
+// paying out the funds of an order to each connected account involved.
+//
+// see https://stripe.com/docs/api#list_transfers
+func (c TransferClient) GroupList(group string, limit int, before, after string) ([]*Transfer, bool, error) {
+	return c.list(url.Values{"transfer_group": {group}}, limit, before, after)
+}
+
+// Returns a list of the Transfers sent to the given connected account.
+//
+// see https://stripe.com/docs/api#list_transfers
+func (c TransferClient) DestinationList(destination string, limit int, before, after string) ([]*Transfer, bool, error) {
+	return c.list(url.Values{"destination": {destination}}, limit, before, after)
+}
+
+func (c TransferClient) list(filters url.Values, limit int, before, after string) ([]*Transfer, bool, error) {
+	res := struct {
+		ListObject
+		Data []*Transfer
+	}{}
+	params := listParams(limit, before, after)
+	for k, v := range filters {
+		params[k] = v
+	}
+	err := c.query("GET", "/transfers", params, &res)
+	return res.Data, res.More, err
+}
+
+// ReversalList is a list of reversals, as embedded in a Transfer.
+type ReversalList struct {
+	ListObject
+	Data []*Reversal `json:"data"`
+}
+
+// Reversal represents funds returned to your platform's balance from a
+// Transfer previously sent to a connected account.
+//
+// see https://stripe.com/docs/api#transfer_reversal_object
+type Reversal struct {
+	APIResource
+
+	ID                       string            `json:"id"`
+	Amount                   int64             `json:"amount"`
+	Currency                 Currency          `json:"currency"`
+	Created                  UnixTime          `json:"created"`
+	Transfer                 string            `json:"transfer"`
+	BalanceTransaction       string            `json:"balance_transaction"`
+	DestinationPaymentRefund string            `json:"destination_payment_refund,omitempty"`
+	SourceRefund             string            `json:"source_refund,omitempty"`
+	Metadata                 map[string]string `json:"metadata,omitempty"`
+}
@@ -0,0 +1,79 @@
+package stripe
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ReversalParams encapsulates options for creating and updating Transfer
+// Reversals.
+type ReversalParams struct {
+	Params
+
+	// (Optional) A positive integer in cents representing how much of the
+	// transfer to reverse. Defaults to the entire remaining amount.
+	Amount *int64 `form:"amount"`
+
+	// (Optional) An arbitrary string attached to the reversal.
+	Description *string `form:"description"`
+
+	// (Optional) Whether the application fee refunded by the associated
+	// charge should also be reversed.
+	RefundApplicationFee *bool `form:"refund_application_fee"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// ReversalClient encapsulates operations for reversing transfers to connected
+// accounts using the Stripe REST API.
+type ReversalClient struct{ scope }
+
+// With returns a ReversalClient configured with the given options.
+func (c ReversalClient) With(opts ...ClientOption) ReversalClient {
+	c.apply(opts)
+	return c
+}
+
+func (c ReversalClient) path(transferID, reversalID string) string {
+	p := fmt.Sprintf("/transfers/%s/reversals", url.QueryEscape(transferID))
+	if reversalID != "" {
+		p += "/" + url.QueryEscape(reversalID)
+	}
+	return p
+}
+
+// Reverses all or part of the Transfer with the given ID.
+//
+// see https://stripe.com/docs/api#create_transfer_reversal
+func (c ReversalClient) Create(transferID string, params *ReversalParams) (*Reversal, error) {
+	res := &Reversal{}
+	return res, c.query("POST", c.path(transferID, ""), formValues(params), res)
+}
+
+// Retrieves the Reversal with the given ID.
+//
+// see https://stripe.com/docs/api#retrieve_transfer_reversal
+func (c ReversalClient) Get(transferID, reversalID string) (*Reversal, error) {
+	res := &Reversal{}
+	return res, c.query("GET", c.path(transferID, reversalID), nil, res)
+}
+
+// Updates the metadata of the Reversal with the given ID.
+//
+// see https://stripe.com/docs/api#update_transfer_reversal
+func (c ReversalClient) Update(transferID, reversalID string, params *ReversalParams) (*Reversal, error) {
+	res := &Reversal{}
+	return res, c.query("POST", c.path(transferID, reversalID), formValues(params), res)
+}
+
+// Returns a list of the Reversals of the Transfer with the given ID.
+//
+// see https://stripe.com/docs/api#list_transfer_reversals
+func (c ReversalClient) List(transferID string, limit int, before, after string) ([]*Reversal, bool, error) {
+	res := struct {
+		ListObject
+		Data []*Reversal
+	}{}
+	err := c.query("GET", c.path(transferID, ""), listParams(limit, before, after), &res)
+	return res.Data, res.More, err
+}
@@ -0,0 +1,147 @@
+package stripe
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+)
+
+// TestCreateTransfer will test that we can successfully send funds from a
+// charge to a connected Account, and that the transfer group is recorded.
+func TestCreateTransfer(t *testing.T) {
+	acct, err := Accounts.Create(&acct1)
+	if err != nil {
+		t.Errorf("Expected Account, got Error %s", err.Error())
+		return
+	}
+	defer Accounts.Delete(acct.ID)
+
+	charge, err := Charges.Create(&charge1)
+	if err != nil {
+		t.Errorf("Expected Successful Charge, got Error %s", err.Error())
+		return
+	}
+
+	transfer, err := Transfers.Create(&TransferParams{
+		Amount:            100,
+		Currency:          USD,
+		Destination:       acct.ID,
+		TransferGroup:     String("ORDER_100"),
+		SourceTransaction: String(charge.ID),
+	})
+	if err != nil {
+		t.Errorf("Expected Transfer, got Error %s", err.Error())
+		return
+	}
+	if transfer.Destination != acct.ID {
+		t.Errorf("Expected Transfer Destination %s, got %s", acct.ID, transfer.Destination)
+	}
+	if transfer.TransferGroup != "ORDER_100" {
+		t.Errorf("Expected Transfer Group ORDER_100, got %s", transfer.TransferGroup)
+	}
+
+	transfers, _, err := Transfers.DestinationList(acct.ID, 10, "", "")
+	if err != nil {
+		t.Errorf("Expected Transfer List, got Error %s", err.Error())
+	}
+	if len(transfers) != 1 {
+		t.Errorf("Expected 1 Transfer, got %d", len(transfers))
+	}
+}
+
+// TestCreateReversal will test that we can reverse part of a Transfer, and
+// that the amount reversed is reflected on the Transfer.
+func TestCreateReversal(t *testing.T) {
+	acct, err := Accounts.Create(&acct1)
+	if err != nil {
+		t.Errorf("Expected Account, got Error %s", err.Error())
+		return
+	}
+	defer Accounts.Delete(acct.ID)
+
+	transfer, err := Transfers.Create(&TransferParams{Amount: 100, Currency: USD, Destination: acct.ID})
+	if err != nil {
+		t.Errorf("Expected Transfer, got Error %s", err.Error())
+		return
+	}
+
+	reversal, err := Reversals.Create(transfer.ID, &ReversalParams{Amount: Int64(40)})
+	if err != nil {
+		t.Errorf("Expected Reversal, got Error %s", err.Error())
+		return
+	}
+	if reversal.Amount != 40 {
+		t.Errorf("Expected Reversal Amount 40, got %d", reversal.Amount)
+	}
+
+	transfer, err = Transfers.Get(transfer.ID)
+	if err != nil {
+		t.Errorf("Expected Transfer, got Error %s", err.Error())
+		return
+	}
+	if transfer.AmountReversed != 40 {
+		t.Errorf("Expected Transfer Amount Reversed 40, got %d", transfer.AmountReversed)
+	}
+}
+
+// TestTransferGroup will test that charges and PaymentIntents are created in a
+// transfer group, and that the transfers of a group are listed.
+func TestTransferGroup(t *testing.T) {
+	var sent []url.Values
+	backend := BackendFunc(func(method, path string, params url.Values, v interface{}) error {
+		sent = append(sent, params)
+		if path == "/transfers" {
+			return json.Unmarshal([]byte(`{"data":[{"id":"tr_1","transfer_group":"ORDER_100"}],"has_more":false}`), v)
+		}
+		return json.Unmarshal([]byte(`{"id":"ch_1","transfer_group":"ORDER_100"}`), v)
+	})
+
+	charge, err := Charges.With(WithBackend(backend)).Create(&ChargeParams{Amount: 400, Currency: USD, Customer: String("cus_1"), TransferGroup: String("ORDER_100")})
+	if err != nil || charge.TransferGroup != "ORDER_100" {
+		t.Errorf("Expected a Charge in group ORDER_100, got %v and %v", charge, err)
+	}
+	PaymentIntents.With(WithBackend(backend)).Create(&PaymentIntentParams{Amount: 400, Currency: USD, TransferGroup: String("ORDER_100")})
+	transfers, _, err := Transfers.With(WithBackend(backend)).GroupList("ORDER_100", 10, "", "")
+	if err != nil || len(transfers) != 1 || transfers[0].TransferGroup != "ORDER_100" {
+		t.Errorf("Expected the group's Transfer, got %v and %v", transfers, err)
+	}
+	for _, values := range sent {
+		if values.Get("transfer_group") != "ORDER_100" {
+			t.Errorf("Expected the transfer group to be sent, got %v", values)
+		}
+	}
+}
+
+// TestDestinationCharges will test that destination charges and PaymentIntents
+// send the connected account their funds are transferred to, and that a
+// transfer amount cannot be combined with an application fee.
+func TestDestinationCharges(t *testing.T) {
+	var sent []url.Values
+	backend := BackendFunc(func(method, path string, params url.Values, v interface{}) error {
+		sent = append(sent, params)
+		return json.Unmarshal([]byte(`{"id":"ch_1","on_behalf_of":"acct_1","transfer_data":{"destination":"acct_1","amount":877}}`), v)
+	})
+
+	charge, err := Charges.With(WithBackend(backend)).Create(&ChargeParams{Amount: 1000, Currency: USD, Customer: String("cus_1"),
+		OnBehalfOf: String("acct_1"), TransferData: &TransferData{Destination: "acct_1", Amount: Int64(877)}})
+	if err != nil || charge.TransferData.Destination != "acct_1" || *charge.TransferData.Amount != 877 || charge.OnBehalfOf != "acct_1" {
+		t.Errorf("Expected a destination Charge to acct_1, got %+v and %v", charge, err)
+	}
+	PaymentIntents.With(WithBackend(backend)).Create(&PaymentIntentParams{Amount: 1000, Currency: USD,
+		OnBehalfOf: String("acct_1"), TransferData: &TransferData{Destination: "acct_1"}, ApplicationFeeAmount: Int64(123)})
+	if len(sent) != 2 || sent[0].Get("transfer_data[amount]") != "877" || sent[1].Get("application_fee_amount") != "123" {
+		t.Fatalf("Expected the transfer amount and application fee, got %v", sent)
+	}
+	for _, values := range sent {
+		if values.Get("on_behalf_of") != "acct_1" || values.Get("transfer_data[destination]") != "acct_1" {
+			t.Errorf("Expected funds to be transferred to acct_1, got %v", values)
+		}
+	}
+
+	_, err = Charges.With(WithBackend(backend)).Create(&ChargeParams{Amount: 1000, Currency: USD, Customer: String("cus_1"),
+		TransferData: &TransferData{Destination: "acct_1", Amount: Int64(877)}, ApplicationFeeAmount: Int64(123)})
+	if !errors.Is(err, TransferAmountWithFeeError) || len(sent) != 2 {
+		t.Errorf("Expected TransferAmountWithFeeError before the request, got %v", err)
+	}
+}
@@ -0,0 +1,133 @@
+package stripe
+
+import "net/url"
+
+// FinancialAccount represents a Treasury account that holds and moves funds on
+// behalf of a connected account. Treasury requests must be made on behalf of
+// the connected account, i.e. through FinancialAccounts.With(WithAccount(id)).
+//
+// see https://stripe.com/docs/api/treasury/financial_accounts/object
+type FinancialAccount struct {
+	APIResource
+
+	ID                  string                   `json:"id"`
+	ActiveFeatures      []string                 `json:"active_features"`
+	PendingFeatures     []string                 `json:"pending_features"`
+	RestrictedFeatures  []string                 `json:"restricted_features"`
+	Balance             *FinancialAccountBalance `json:"balance"`
+	Country             string                   `json:"country"`
+	Created             UnixTime                 `json:"created"`
+	FinancialAddresses  []*FinancialAddress      `json:"financial_addresses"`
+	Status              string                   `json:"status"`
+	SupportedCurrencies []Currency               `json:"supported_currencies"`
+	Metadata            map[string]string        `json:"metadata,omitempty"`
+	Livemode            bool                     `json:"livemode"`
+}
+
+// FinancialAccountBalance holds the funds in a FinancialAccount, keyed by
+// currency.
+type FinancialAccountBalance struct {
+	Cash            map[Currency]int64 `json:"cash"`
+	InboundPending  map[Currency]int64 `json:"inbound_pending"`
+	OutboundPending map[Currency]int64 `json:"outbound_pending"`
+}
+
+// FinancialAddress holds the routing details used to send funds to a
+// FinancialAccount from outside Stripe.
+type FinancialAddress struct {
+	Type              string   `json:"type"`
+	SupportedNetworks []string `json:"supported_networks"`
+	ABA               *struct {
+		AccountHolderName  string `json:"account_holder_name"`
+		AccountNumberLast4 string `json:"account_number_last4"`
+		BankName           string `json:"bank_name"`
+		RoutingNumber      string `json:"routing_number"`
+	} `json:"aba,omitempty"`
+}
+
+// FinancialAccountParams encapsulates options for creating and updating
+// Financial Accounts.
+type FinancialAccountParams struct {
+	Params
+
+	// The currencies the account can hold, e.g. []string{"usd"}. Can only be
+	// set on creation.
+	SupportedCurrencies []Currency `form:"supported_currencies"`
+
+	// (Optional) Features to request or un-request.
+	Features *FinancialAccountFeatures `form:"features"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// FinancialAccountFeatures requests (true) or un-requests (false) the
+// features of a FinancialAccount. Features left nil are unchanged.
+type FinancialAccountFeatures struct {
+	CardIssuing                     *bool `form:"card_issuing[requested]"`
+	DepositInsurance                *bool `form:"deposit_insurance[requested]"`
+	FinancialAddressesABA           *bool `form:"financial_addresses[aba][requested]"`
+	InboundTransfersACH             *bool `form:"inbound_transfers[ach][requested]"`
+	IntraStripeFlows                *bool `form:"intra_stripe_flows[requested]"`
+	OutboundPaymentsACH             *bool `form:"outbound_payments[ach][requested]"`
+	OutboundPaymentsUSDomesticWire  *bool `form:"outbound_payments[us_domestic_wire][requested]"`
+	OutboundTransfersACH            *bool `form:"outbound_transfers[ach][requested]"`
+	OutboundTransfersUSDomesticWire *bool `form:"outbound_transfers[us_domestic_wire][requested]"`
+}
+
+// FinancialAccountListParams encapsulates options for filtering a list of
+// Financial Accounts.
+type FinancialAccountListParams struct {
+	ListParams
+
+	// (Optional) Only return accounts created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// FinancialAccountClient encapsulates operations for creating, updating and
+// querying Treasury financial accounts using the Stripe REST API.
+type FinancialAccountClient struct{ scope }
+
+// With returns a FinancialAccountClient configured with the given options.
+func (c FinancialAccountClient) With(opts ...ClientOption) FinancialAccountClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Financial Account.
+//
+// see https://stripe.com/docs/api/treasury/financial_accounts/create
+func (c FinancialAccountClient) Create(params *FinancialAccountParams) (*FinancialAccount, error) {
+	res := &FinancialAccount{}
+	return res, c.query("POST", "/treasury/financial_accounts", formValues(params), res)
+}
+
+// Retrieves the Financial Account with the given ID.
+//
+// see https://stripe.com/docs/api/treasury/financial_accounts/retrieve
+func (c FinancialAccountClient) Get(id string) (*FinancialAccount, error) {
+	res := &FinancialAccount{}
+	return res, c.query("GET", "/treasury/financial_accounts/"+url.QueryEscape(id), nil, res)
+}
+
+// Updates the features and metadata of the Financial Account with the given
+// ID.
+//
+// see https://stripe.com/docs/api/treasury/financial_accounts/update
+func (c FinancialAccountClient) Update(id string, params *FinancialAccountParams) (*FinancialAccount, error) {
+	res := &FinancialAccount{}
+	return res, c.query("POST", "/treasury/financial_accounts/"+url.QueryEscape(id), formValues(params), res)
+}
+
+// Returns a list of Financial Accounts matching the given filters.
+//
+// see https://stripe.com/docs/api/treasury/financial_accounts/list
+func (c FinancialAccountClient) List(params *FinancialAccountListParams) ([]*FinancialAccount, bool, error) {
+	res := struct {
+		ListObject
+		Data []*FinancialAccount
+	}{}
+	values := formValues(params)
+
+	err := c.query("GET", "/treasury/financial_accounts", values, &res)
+	return res.Data, res.More, err
+}
@@ -0,0 +1,101 @@
+package stripe
+
+import "net/url"
+
+// InboundTransfer represents funds pulled into a FinancialAccount from a bank
+// account belonging to the same connected account.
+//
+// see https://stripe.com/docs/api/treasury/inbound_transfers/object
+type InboundTransfer struct {
+	APIResource
+
+	ID             string   `json:"id"`
+	Amount         int64    `json:"amount"`
+	Cancelable     bool     `json:"cancelable"`
+	Created        UnixTime `json:"created"`
+	Currency       Currency `json:"currency"`
+	Description    string   `json:"description,omitempty"`
+	FailureDetails *struct {
+		Code string `json:"code"`
+	} `json:"failure_details,omitempty"`
+	FinancialAccount    string            `json:"financial_account"`
+	OriginPaymentMethod string            `json:"origin_payment_method"`
+	Returned            bool              `json:"returned"`
+	StatementDescriptor string            `json:"statement_descriptor,omitempty"`
+	Status              string            `json:"status"`
+	Transaction         string            `json:"transaction"`
+	Metadata            map[string]string `json:"metadata,omitempty"`
+	Livemode            bool              `json:"livemode"`
+}
+
+// InboundTransferParams encapsulates options for creating a new Inbound
+// Transfer.
+type InboundTransferParams struct {
+	Params
+
+	// The ID of the FinancialAccount to send funds to.
+	FinancialAccount string `form:"financial_account"`
+
+	// A positive integer in cents representing how much to pull.
+	Amount int64 `form:"amount"`
+
+	// 3-letter ISO code for currency.
+	Currency Currency `form:"currency"`
+
+	// The ID of the PaymentMethod of the bank account to pull funds from.
+	OriginPaymentMethod string `form:"origin_payment_method"`
+
+	// (Optional) An arbitrary string attached to the transfer.
+	Description *string `form:"description"`
+
+	// (Optional) A string displayed on the originating bank statement.
+	StatementDescriptor *string `form:"statement_descriptor"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// InboundTransferClient encapsulates operations for creating, canceling and
+// querying Treasury inbound transfers using the Stripe REST API.
+type InboundTransferClient struct{ scope }
+
+// With returns an InboundTransferClient configured with the given options.
+func (c InboundTransferClient) With(opts ...ClientOption) InboundTransferClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Inbound Transfer.
+//
+// see https://stripe.com/docs/api/treasury/inbound_transfers/create
+func (c InboundTransferClient) Create(params *InboundTransferParams) (*InboundTransfer, error) {
+	res := &InboundTransfer{}
+	return res, c.query("POST", "/treasury/inbound_transfers", formValues(params), res)
+}
+
+// Retrieves the Inbound Transfer with the given ID.
+//
+// see https://stripe.com/docs/api/treasury/inbound_transfers/retrieve
+func (c InboundTransferClient) Get(id string) (*InboundTransfer, error) {
+	res := &InboundTransfer{}
+	return res, c.query("GET", "/treasury/inbound_transfers/"+url.QueryEscape(id), nil, res)
+}
+
+// Cancels the Inbound Transfer with the given ID, if it is still cancelable.
+//
+// see https://stripe.com/docs/api/treasury/inbound_transfers/cancel
+func (c InboundTransferClient) Cancel(id string) (*InboundTransfer, error) {
+	res := &InboundTransfer{}
+	return res, c.query("POST", "/treasury/inbound_transfers/"+url.QueryEscape(id)+"/cancel", nil, res)
+}
+
+// Returns a list of the Inbound Transfers of a FinancialAccount.
+//
+// see https://stripe.com/docs/api/treasury/inbound_transfers/list
+func (c InboundTransferClient) List(params *TreasuryListParams) ([]*InboundTransfer, bool, error) {
+	res := struct {
+		ListObject
+		Data []*InboundTransfer
+	}{}
+	err := c.query("GET", "/treasury/inbound_transfers", formValues(params), &res)
+	return res.Data, res.More, err
+}
@@ -0,0 +1,230 @@
+package stripe
+
+import "net/url"
+
+// Treasury money movement Statuses
+const (
+	TreasuryProcessing = "processing"
+	TreasuryPosted     = "posted"
+	TreasuryFailed     = "failed"
+	TreasuryCanceled   = "canceled"
+	TreasuryReturned   = "returned"
+)
+
+// OutboundTransfer represents funds sent from a FinancialAccount to a bank
+// account belonging to the same connected account.
+//
+// see https://stripe.com/docs/api/treasury/outbound_transfers/object
+type OutboundTransfer struct {
+	APIResource
+
+	ID                       string            `json:"id"`
+	Amount                   int64             `json:"amount"`
+	Cancelable               bool              `json:"cancelable"`
+	Created                  UnixTime          `json:"created"`
+	Currency                 Currency          `json:"currency"`
+	Description              string            `json:"description,omitempty"`
+	DestinationPaymentMethod string            `json:"destination_payment_method"`
+	ExpectedArrivalDate      UnixTime          `json:"expected_arrival_date"`
+	FinancialAccount         string            `json:"financial_account"`
+	ReturnedDetails          *ReturnedDetails  `json:"returned_details,omitempty"`
+	StatementDescriptor      string            `json:"statement_descriptor,omitempty"`
+	Status                   string            `json:"status"`
+	Transaction              string            `json:"transaction"`
+	Metadata                 map[string]string `json:"metadata,omitempty"`
+	Livemode                 bool              `json:"livemode"`
+}
+
+// OutboundPayment represents funds sent from a FinancialAccount to a third
+// party's bank account.
+//
+// see https://stripe.com/docs/api/treasury/outbound_payments/object
+type OutboundPayment struct {
+	APIResource
+
+	ID                       string            `json:"id"`
+	Amount                   int64             `json:"amount"`
+	Cancelable               bool              `json:"cancelable"`
+	Created                  UnixTime          `json:"created"`
+	Currency                 Currency          `json:"currency"`
+	Customer                 string            `json:"customer,omitempty"`
+	Description              string            `json:"description,omitempty"`
+	DestinationPaymentMethod string            `json:"destination_payment_method,omitempty"`
+	ExpectedArrivalDate      UnixTime          `json:"expected_arrival_date"`
+	FinancialAccount         string            `json:"financial_account"`
+	ReturnedDetails          *ReturnedDetails  `json:"returned_details,omitempty"`
+	StatementDescriptor      string            `json:"statement_descriptor,omitempty"`
+	Status                   string            `json:"status"`
+	Transaction              string            `json:"transaction"`
+	Metadata                 map[string]string `json:"metadata,omitempty"`
+	Livemode                 bool              `json:"livemode"`
+}
+
+// ReturnedDetails explains why an outbound money movement was returned.
+type ReturnedDetails struct {
+	Code        string `json:"code"`
+	Transaction string `json:"transaction"`
+}
+
+// OutboundTransferParams encapsulates options for creating a new Outbound
+// Transfer.
+type OutboundTransferParams struct {
+	Params
+
+	// The ID of the FinancialAccount to send funds from.
+	FinancialAccount string `form:"financial_account"`
+
+	// A positive integer in cents representing how much to send.
+	Amount int64 `form:"amount"`
+
+	// 3-letter ISO code for currency.
+	Currency Currency `form:"currency"`
+
+	// The ID of the PaymentMethod of the bank account to send funds to.
+	DestinationPaymentMethod string `form:"destination_payment_method"`
+
+	// (Optional) An arbitrary string attached to the transfer.
+	Description *string `form:"description"`
+
+	// (Optional) A string displayed on the receiving bank statement.
+	StatementDescriptor *string `form:"statement_descriptor"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// OutboundPaymentParams encapsulates options for creating a new Outbound
+// Payment.
+type OutboundPaymentParams struct {
+	Params
+
+	// The ID of the FinancialAccount to send funds from.
+	FinancialAccount string `form:"financial_account"`
+
+	// A positive integer in cents representing how much to send.
+	Amount int64 `form:"amount"`
+
+	// 3-letter ISO code for currency.
+	Currency Currency `form:"currency"`
+
+	// The ID of the PaymentMethod of the bank account to send funds to.
+	DestinationPaymentMethod string `form:"destination_payment_method"`
+
+	// (Optional) The ID of the customer the destination PaymentMethod belongs
+	// to.
+	Customer *string `form:"customer"`
+
+	// (Optional) An arbitrary string attached to the payment.
+	Description *string `form:"description"`
+
+	// (Optional) A string displayed on the receiving bank statement.
+	StatementDescriptor *string `form:"statement_descriptor"`
+
+	Metadata map[string]string `form:"metadata"`
+}
+
+// TreasuryListParams encapsulates options for filtering a list of Treasury
+// objects, which are always scoped to a single FinancialAccount.
+type TreasuryListParams struct {
+	ListParams
+
+	// The ID of the FinancialAccount to list objects for.
+	FinancialAccount string `form:"financial_account"`
+
+	// (Optional) Only return objects with the given status.
+	Status string `form:"status"`
+
+	// (Optional) Only return objects created within the range.
+	Created *DateRange `form:"created"`
+}
+
+// OutboundTransferClient encapsulates operations for creating, canceling and
+// querying Treasury outbound transfers using the Stripe REST API.
+type OutboundTransferClient struct{ scope }
+
+// With returns an OutboundTransferClient configured with the given options.
+func (c OutboundTransferClient) With(opts ...ClientOption) OutboundTransferClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Outbound Transfer.
+//
+// see https://stripe.com/docs/api/treasury/outbound_transfers/create
+func (c OutboundTransferClient) Create(params *OutboundTransferParams) (*OutboundTransfer, error) {
+	res := &OutboundTransfer{}
+	return res, c.query("POST", "/treasury/outbound_transfers", formValues(params), res)
+}
+
+// Retrieves the Outbound Transfer with the given ID.
+//
+// see https://stripe.com/docs/api/treasury/outbound_transfers/retrieve
+func (c OutboundTransferClient) Get(id string) (*OutboundTransfer, error) {
+	res := &OutboundTransfer{}
+	return res, c.query("GET", "/treasury/outbound_transfers/"+url.QueryEscape(id), nil, res)
+}
+
+// Cancels the Outbound Transfer with the given ID, if it is still cancelable.
+//
+// see https://stripe.com/docs/api/treasury/outbound_transfers/cancel
+func (c OutboundTransferClient) Cancel(id string) (*OutboundTransfer, error) {
+	res := &OutboundTransfer{}
+	return res, c.query("POST", "/treasury/outbound_transfers/"+url.QueryEscape(id)+"/cancel", nil, res)
+}
+
+// Returns a list of the Outbound Transfers of a FinancialAccount.
+//
+// see https://stripe.com/docs/api/treasury/outbound_transfers/list
+func (c OutboundTransferClient) List(params *TreasuryListParams) ([]*OutboundTransfer, bool, error) {
+	res := struct {
+		ListObject
+		Data []*OutboundTransfer
+	}{}
+	err := c.query("GET", "/treasury/outbound_transfers", formValues(params), &res)
+	return res.Data, res.More, err
+}
+
+// OutboundPaymentClient encapsulates operations for creating, canceling and
+// querying Treasury outbound payments using the Stripe REST API.
+type OutboundPaymentClient struct{ scope }
+
+// With returns an OutboundPaymentClient configured with the given options.
+func (c OutboundPaymentClient) With(opts ...ClientOption) OutboundPaymentClient {
+	c.apply(opts)
+	return c
+}
+
+// Creates a new Outbound Payment.
+//
+// see https://stripe.com/docs/api/treasury/outbound_payments/create
+func (c OutboundPaymentClient) Create(params *OutboundPaymentParams) (*OutboundPayment, error) {
+	res := &OutboundPayment{}
+	return res, c.query("POST", "/treasury/outbound_payments", formValues(params), res)
+}
+
+// Retrieves the Outbound Payment with the given ID.
+//
+// see https://stripe.com/docs/api/treasury/outbound_payments/retrieve
+func (c OutboundPaymentClient) Get(id string) (*OutboundPayment, error) {
+	res := &OutboundPayment{}
+	return res, c.query("GET", "/treasury/outbound_payments/"+url.QueryEscape(id), nil, res)
+}
+
+// Cancels the Outbound Payment with the given ID, if it is still cancelable.
+//
+// see https://stripe.com/docs/api/treasury/outbound_payments/cancel
+func (c OutboundPaymentClient) Cancel(id string) (*OutboundPayment, error) {
+	res := &OutboundPayment{}
+	return res, c.query("POST", "/treasury/outbound_payments/"+url.QueryEscape(id)+"/cancel", nil, res)
+}
+
+// Returns a list of the Outbound Payments of a FinancialAccount.
+//
+// see https://stripe.com/docs/api/treasury/outbound_payments/list
+func (c OutboundPaymentClient) List(params *TreasuryListParams) ([]*OutboundPayment, bool, error) {
+	res := struct {
+		ListObject
+		Data []*OutboundPayment
+	}{}
+	err := c.query("GET", "/treasury/outbound_payments", formValues(params), &res)
+	return res.Data, res.More, err
+}
@@ -0,0 +1,103 @@
+package stripe
+
+import "net/url"
+
+// ReceivedCredit represents funds sent to a FinancialAccount from outside
+// Stripe, e.g. an ACH credit or wire, or from another FinancialAccount.
+//
+// see https://stripe.com/docs/api/treasury/received_credits/object
+type ReceivedCredit struct {
+	APIResource
+
+	ID               string   `json:"id"`
+	Amount           int64    `json:"amount"`
+	Created          UnixTime `json:"created"`
+	Currency         Currency `json:"currency"`
+	Description      string   `json:"description"`
+	FailureCode      string   `json:"failure_code,omitempty"`
+	FinancialAccount string   `json:"financial_account"`
+	Network          string   `json:"network"`
+	Status           string   `json:"status"`
+	Transaction      string   `json:"transaction,omitempty"`
+	Livemode         bool     `json:"livemode"`
+}
+
+// ReceivedDebit represents funds pulled from a FinancialAccount by a third
+// party, e.g. an ACH debit.
+//
+// see https://stripe.com/docs/api/treasury/received_debits/object
+type ReceivedDebit struct {
+	APIResource
+
+	ID               string   `json:"id"`
+	Amount           int64    `json:"amount"`
+	Created          UnixTime `json:"created"`
+	Currency         Currency `json:"currency"`
+	Description      string   `json:"description"`
+	FailureCode      string   `json:"failure_code,omitempty"`
+	FinancialAccount string   `json:"financial_account"`
+	Network          string   `json:"network"`
+	Status           string   `json:"status"`
+	Transaction      string   `json:"transaction,omitempty"`
+	Livemode         bool     `json:"livemode"`
+}
+
+// ReceivedCreditClient encapsulates operations for querying Treasury received
+// credits using the Stripe REST API.
+type ReceivedCreditClient struct{ scope }
+
+// With returns a ReceivedCreditClient configured with the given options.
+func (c ReceivedCreditClient) With(opts ...ClientOption) ReceivedCreditClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Received Credit with the given ID.
+//
+// see https://stripe.com/docs/api/treasury/received_credits/retrieve
+func (c ReceivedCreditClient) Get(id string) (*ReceivedCredit, error) {
+	res := &ReceivedCredit{}
+	return res, c.query("GET", "/treasury/received_credits/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of the Received Credits of a FinancialAccount.
+//
+// see https://stripe.com/docs/api/treasury/received_credits/list
+func (c ReceivedCreditClient) List(params *TreasuryListParams) ([]*ReceivedCredit, bool, error) {
+	res := struct {
+		ListObject
+		Data []*ReceivedCredit
+	}{}
+	err := c.query("GET", "/treasury/received_credits", formValues(params), &res)
+	return res.Data, res.More, err
+}
+
+// ReceivedDebitClient encapsulates operations for querying Treasury received
+// debits using the Stripe REST API.
+type ReceivedDebitClient struct{ scope }
+
+// With returns a ReceivedDebitClient configured with the given options.
+func (c ReceivedDebitClient) With(opts ...ClientOption) ReceivedDebitClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Received Debit with the given ID.
+//
+// see https://stripe.com/docs/api/treasury/received_debits/retrieve
+func (c ReceivedDebitClient) Get(id string) (*ReceivedDebit, error) {
+	res := &ReceivedDebit{}
+	return res, c.query("GET", "/treasury/received_debits/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of the Received Debits of a FinancialAccount.
+//
+// see https://stripe.com/docs/api/treasury/received_debits/list
+func (c ReceivedDebitClient) List(params *TreasuryListParams) ([]*ReceivedDebit, bool, error) {
+	res := struct {
+		ListObject
+		Data []*ReceivedDebit
+	}{}
+	err := c.query("GET", "/treasury/received_debits", formValues(params), &res)
+	return res.Data, res.More, err
+}
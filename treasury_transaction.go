@@ -0,0 +1,54 @@
+package stripe
+
+import "net/url"
+
+// TreasuryTransaction represents a change to the balance of a
+// FinancialAccount, caused by a money movement such as an OutboundPayment or
+// ReceivedCredit.
+//
+// see https://stripe.com/docs/api/treasury/transactions/object
+type TreasuryTransaction struct {
+	APIResource
+
+	ID               string                   `json:"id"`
+	Amount           int64                    `json:"amount"`
+	BalanceImpact    *FinancialAccountBalance `json:"balance_impact"`
+	Created          UnixTime                 `json:"created"`
+	Currency         Currency                 `json:"currency"`
+	Description      string                   `json:"description"`
+	FinancialAccount string                   `json:"financial_account"`
+	Flow             string                   `json:"flow"`
+	FlowType         string                   `json:"flow_type"`
+	Status           string                   `json:"status"`
+	Livemode         bool                     `json:"livemode"`
+}
+
+// TreasuryTransactionClient encapsulates operations for querying Treasury
+// transactions using the Stripe REST API.
+type TreasuryTransactionClient struct{ scope }
+
+// With returns a TreasuryTransactionClient configured with the given options.
+func (c TreasuryTransactionClient) With(opts ...ClientOption) TreasuryTransactionClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the Transaction with the given ID.
+//
+// see https://stripe.com/docs/api/treasury/transactions/retrieve
+func (c TreasuryTransactionClient) Get(id string) (*TreasuryTransaction, error) {
+	res := &TreasuryTransaction{}
+	return res, c.query("GET", "/treasury/transactions/"+url.QueryEscape(id), nil, res)
+}
+
+// Returns a list of the Transactions of a FinancialAccount.
+//
+// see https://stripe.com/docs/api/treasury/transactions/list
+func (c TreasuryTransactionClient) List(params *TreasuryListParams) ([]*TreasuryTransaction, bool, error) {
+	res := struct {
+		ListObject
+		Data []*TreasuryTransaction
+	}{}
+	err := c.query("GET", "/treasury/transactions", formValues(params), &res)
+	return res.Data, res.More, err
+}
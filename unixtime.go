@@ -1,6 +1,7 @@
 package stripe
 
 import (
+	"database/sql/driver"
 	"errors"
 	"strconv"
 	"time"
@@ -8,31 +9,70 @@ import (
 
 var UnixTimeUnmarshalError = errors.New("stripe: invalid timestamp")
 
+// UnixTime is a timestamp that Stripe represents as the number of seconds
+// since the Unix epoch. A null or omitted timestamp decodes to the zero
+// UnixTime, which encodes back to null.
 type UnixTime struct{ time.Time }
 
+// NewUnixTime returns the UnixTime for t, truncated to the second as Stripe
+// does.
+func NewUnixTime(t time.Time) UnixTime {
+	if t.IsZero() {
+		return UnixTime{}
+	}
+	return UnixTime{t.Truncate(time.Second)}
+}
+
+// Time returns a pointer to the UnixTime for t, for setting optional
+// timestamp params inline:
+//
+//	params := &SubscriptionParams{TrialEnd: stripe.Time(time.Now().AddDate(0, 0, 14))}
+func Time(t time.Time) *UnixTime {
+	u := NewUnixTime(t)
+	return &u
+}
+
 func (t UnixTime) MarshalJSON() ([]byte, error) {
-	return strconv.AppendInt(nil, t.UnixNano()/int64(time.Millisecond), 10), nil
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return strconv.AppendInt(nil, t.Unix(), 10), nil
 }
 
 func (t *UnixTime) UnmarshalJSON(data []byte) error {
 	str := string(data)
-	var i int64
-	if str != "null" {
-		var err error
-		i, err = strconv.ParseInt(str, 10, 64)
-		if err != nil {
-			return UnixTimeUnmarshalError
-		}
+	if str == "null" {
+		t.Time = time.Time{}
+		return nil
+	}
+	i, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return UnixTimeUnmarshalError
 	}
 	t.Time = time.Unix(i, 0)
 	return nil
 }
 
+// Scan implements sql.Scanner, accepting a time.Time, a Unix timestamp in
+// seconds, or NULL.
 func (t *UnixTime) Scan(src interface{}) error {
-	ts, ok := src.(time.Time)
-	if !ok {
+	switch v := src.(type) {
+	case time.Time:
+		t.Time = v
+	case int64:
+		t.Time = time.Unix(v, 0)
+	case nil:
+		t.Time = time.Time{}
+	default:
 		return UnixTimeUnmarshalError
 	}
-	t.Time = ts
 	return nil
 }
+
+// Value implements driver.Valuer, storing the zero UnixTime as NULL.
+func (t UnixTime) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t.Time, nil
+}
@@ -0,0 +1,45 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// TestUnixTimeJSON will test that timestamps round-trip as Unix seconds and
+// that null timestamps decode to, and encode from, the zero UnixTime.
+func TestUnixTimeJSON(t *testing.T) {
+	ts := NewUnixTime(time.Unix(1700000000, 500))
+	data, err := json.Marshal(ts)
+	if err != nil || string(data) != "1700000000" {
+		t.Fatalf("Expected 1700000000, got %s (%v)", data, err)
+	}
+	var decoded UnixTime
+	if err := json.Unmarshal(data, &decoded); err != nil || !decoded.Equal(ts.Time) {
+		t.Errorf("Expected %v, got %v (%v)", ts, decoded, err)
+	}
+
+	if err := json.Unmarshal([]byte("null"), &decoded); err != nil || !decoded.IsZero() {
+		t.Errorf("Expected zero UnixTime from null, got %v (%v)", decoded, err)
+	}
+	if data, _ := json.Marshal(UnixTime{}); string(data) != "null" {
+		t.Errorf("Expected null, got %s", data)
+	}
+
+	sub := Subscription{}
+	if err := json.Unmarshal([]byte(`{"id":"sub_1","trial_end":null,"start":1700000000}`), &sub); err != nil {
+		t.Fatalf("Expected Subscription, got Error %s", err.Error())
+	}
+	if sub.TrialEnd != nil || sub.Start.Unix() != 1700000000 {
+		t.Errorf("Expected no trial end and a start time, got %v and %v", sub.TrialEnd, sub.Start)
+	}
+}
+
+// TestUnixTimeParam will test that timestamps are sent as Unix seconds.
+func TestUnixTimeParam(t *testing.T) {
+	end := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	values := formValues(&SubscriptionParams{TrialEnd: Time(end)})
+	if got := values.Get("trial_end"); got != "1893553445" {
+		t.Errorf("Expected trial_end 1893553445, got %s", got)
+	}
+}
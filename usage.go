@@ -0,0 +1,200 @@
+package stripe
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// UsageRecord reports the usage of a metered Subscription Item.
+//
+// see https://stripe.com/docs/api#usage_records
+type UsageRecord struct {
+	ID               string   `json:"id"`
+	Live             bool     `json:"livemode"`
+	Quantity         int64    `json:"quantity"`
+	SubscriptionItem string   `json:"subscription_item"`
+	Timestamp        UnixTime `json:"timestamp"`
+}
+
+// UsageReporter reports usage of metered Subscription Items without making a
+// request for every unit used: increments are added up in memory for each
+// Subscription Item, and sent as a single usage record per item when the
+// interval passes, or sooner once enough items have pending usage.
+//
+// Every usage record is sent with an Idempotency-Key. If Stripe cannot be
+// reached, the record is kept with its key and sent again by the next flush,
+// so usage is never reported twice; usage added in the meantime is sent in a
+// record of its own.
+type UsageReporter struct {
+	// (Optional) Called when a flush made in the background fails. Usage that
+	// could not reach Stripe is kept for the next flush.
+	OnError func(err error)
+
+	subs     SubscriptionClient
+	interval time.Duration
+	maxItems int
+
+	mu      sync.Mutex
+	pending map[string]*usageBatch
+	failed  map[string]*usageBatch
+
+	flushing sync.Mutex
+	full     chan struct{}
+	quit     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// usageBatch is the usage of a Subscription Item sent in one usage record.
+type usageBatch struct {
+	quantity int64
+	since    time.Time
+	key      string
+}
+
+// NewUsageReporter returns a UsageReporter that sends usage records with
+// subs, e.g. Subscriptions or the Subscriptions of a Client, and that, once
+// started, flushes usage every interval, or as soon as maxItems Subscription
+// Items have pending usage. A maxItems of zero flushes on the interval alone.
+func NewUsageReporter(subs SubscriptionClient, interval time.Duration, maxItems int) *UsageReporter {
+	return &UsageReporter{
+		subs:     subs,
+		interval: interval,
+		maxItems: maxItems,
+		pending:  make(map[string]*usageBatch),
+		failed:   make(map[string]*usageBatch),
+		full:     make(chan struct{}, 1),
+	}
+}
+
+// Add records quantity units of usage for the Subscription Item, to be sent
+// with the next flush. It does not make a request.
+func (r *UsageReporter) Add(subscriptionItem string, quantity int64) {
+	r.mu.Lock()
+	b, ok := r.pending[subscriptionItem]
+	if !ok {
+		b = &usageBatch{since: _clock.Now()}
+		r.pending[subscriptionItem] = b
+	}
+	b.quantity += quantity
+	full := r.maxItems > 0 && len(r.pending) >= r.maxItems
+	r.mu.Unlock()
+
+	if full {
+		select {
+		case r.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Pending returns the usage not yet accepted by Stripe for each Subscription
+// Item.
+func (r *UsageReporter) Pending() map[string]int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pending := make(map[string]int64, len(r.pending)+len(r.failed))
+	for item, b := range r.failed {
+		pending[item] += b.quantity
+	}
+	for item, b := range r.pending {
+		pending[item] += b.quantity
+	}
+	return pending
+}
+
+// Start launches a goroutine that flushes usage on the interval, and whenever
+// the number of items given to NewUsageReporter have pending usage.
+func (r *UsageReporter) Start() {
+	r.quit = make(chan struct{})
+	r.wg.Add(1)
+	go r.run(r.quit)
+}
+
+// Stop stops the background flushes, and then flushes any usage that is
+// still pending. Calling it before Start, or more than once, has no effect.
+func (r *UsageReporter) Stop() error {
+	if r.quit == nil {
+		return nil
+	}
+	close(r.quit)
+	r.quit = nil
+	r.wg.Wait()
+	return r.Flush()
+}
+
+// Flush sends the pending usage, one usage record per Subscription Item,
+// starting with records a previous flush could not send. It returns the first
+// error: records that could not reach Stripe are kept to be sent again with
+// the same Idempotency-Key, while records Stripe rejected, e.g. because the
+// Subscription Item was deleted, are dropped.
+func (r *UsageReporter) Flush() error {
+	r.flushing.Lock()
+	defer r.flushing.Unlock()
+
+	r.mu.Lock()
+	batches := r.failed
+	r.failed = make(map[string]*usageBatch)
+	for item, b := range r.pending {
+		// an item's failed record must keep its quantity to be replayed
+		// safely, so newer usage waits for the next flush
+		if _, ok := batches[item]; ok {
+			continue
+		}
+		b.key = newIdempotencyKey()
+		batches[item] = b
+		delete(r.pending, item)
+	}
+	r.mu.Unlock()
+
+	items := make([]string, 0, len(batches))
+	for item := range batches {
+		items = append(items, item)
+	}
+	sort.Strings(items)
+
+	var first error
+	for _, item := range items {
+		b := batches[item]
+		err := r.send(item, b)
+		if unreachable(err) {
+			r.mu.Lock()
+			r.failed[item] = b
+			r.mu.Unlock()
+		}
+		if err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+func (r *UsageReporter) run(quit chan struct{}) {
+	defer r.wg.Done()
+	for {
+		select {
+		case <-_clock.After(r.interval):
+		case <-r.full:
+		case <-quit:
+			return
+		}
+		if err := r.Flush(); err != nil && r.OnError != nil {
+			r.OnError(err)
+		}
+	}
+}
+
+// send creates the usage record for the batch with its Idempotency-Key.
+func (r *UsageReporter) send(item string, b *usageBatch) error {
+	values := url.Values{
+		"quantity":  {strconv.FormatInt(b.quantity, 10)},
+		"timestamp": {strconv.FormatInt(b.since.Unix(), 10)},
+		"action":    {"increment"},
+	}
+	record := UsageRecord{}
+	s := r.subs.scope
+	s.idempotencyKey = b.key
+	return s.query("POST", "/subscription_items/"+url.PathEscape(item)+"/usage_records", values, &record)
+}
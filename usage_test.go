@@ -0,0 +1,96 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestUsageReporter will test that usage is aggregated per Subscription Item,
+// that records which could not reach Stripe are resent with the same
+// idempotency key, and that the item limit triggers a flush.
+func TestUsageReporter(t *testing.T) {
+	var mu sync.Mutex
+	var down bool
+	var sent []string
+	keys := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		r.ParseForm()
+		if r.Form.Get("action") != "increment" || r.Form.Get("timestamp") == "" {
+			t.Errorf("Expected an increment with a timestamp, got %v", r.Form)
+		}
+		record := r.URL.Path + " " + r.Form.Get("quantity")
+		if k, ok := keys[record]; ok && k != r.Header.Get("Idempotency-Key") {
+			t.Errorf("Expected %s to be resent with key %s, got %s", record, k, r.Header.Get("Idempotency-Key"))
+		}
+		keys[record] = r.Header.Get("Idempotency-Key")
+		if down {
+			w.WriteHeader(503)
+			w.Write([]byte(`{"error":{"type":"api_error","message":"Stripe is down"}}`))
+			return
+		}
+		sent = append(sent, record)
+		w.Write([]byte(`{"id":"mbur_1"}`))
+	}))
+	defer server.Close()
+
+	r := NewUsageReporter(Subscriptions.With(WithBaseURL(server.URL)), time.Hour, 2)
+	if err := r.Stop(); err != nil {
+		t.Errorf("Expected stopping a reporter that was not started to do nothing, got %v", err)
+	}
+	r.Add("si_1", 3)
+	r.Add("si_1", 4)
+
+	mu.Lock()
+	down = true
+	mu.Unlock()
+	if err := r.Flush(); err == nil {
+		t.Fatal("Expected flush to fail while Stripe is down")
+	}
+	r.Add("si_1", 1)
+	if p := r.Pending(); p["si_1"] != 8 {
+		t.Errorf("Expected 8 units pending, got %v", p)
+	}
+
+	mu.Lock()
+	down = false
+	mu.Unlock()
+	if err := r.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if len(sent) != 2 || sent[0] != "/v1/subscription_items/si_1/usage_records 7" || sent[1] != "/v1/subscription_items/si_1/usage_records 1" {
+		t.Errorf("Expected the failed record to be resent before newer usage, got %v", sent)
+	}
+
+	flushed := make(chan struct{})
+	r.OnError = func(err error) { t.Error(err) }
+	r.Start()
+	r.Add("si_2", 5)
+	r.Add("si_3", 6)
+	go func() {
+		for len(r.Pending()) != 0 {
+			time.Sleep(time.Millisecond)
+		}
+		close(flushed)
+	}()
+	select {
+	case <-flushed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected reaching the item limit to flush")
+	}
+	if err := r.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(sent) != 4 {
+		t.Errorf("Expected a record for each item, got %v", sent)
+	}
+}
@@ -0,0 +1,101 @@
+package stripe
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// V2EventListParams encapsulates options for listing events of the /v2 API.
+type V2EventListParams struct {
+	// Only return events about the object with the given ID.
+	ObjectID string
+
+	// (Optional) The number of events to return per page.
+	Limit int
+
+	// (Optional) The token of the page to return, from V2Page.NextPage.
+	Page string
+}
+
+// V2EventList is a page of events of the /v2 API.
+type V2EventList struct {
+	V2Page
+	Data []*ThinEvent `json:"data"`
+}
+
+// V2EventClient encapsulates operations for querying events of the /v2 API,
+// which are retrieved as ThinEvents with their Data.
+type V2EventClient struct{ scope }
+
+// With returns a V2EventClient configured with the given options.
+func (c V2EventClient) With(opts ...ClientOption) V2EventClient {
+	c.apply(opts)
+	return c
+}
+
+// Retrieves the event with the given ID.
+//
+// see https://docs.stripe.com/api/v2/core/events/retrieve
+func (c V2EventClient) Get(id string) (*ThinEvent, error) {
+	res := &ThinEvent{}
+	return res, c.queryV2("GET", "/core/events/"+url.QueryEscape(id), nil, nil, res)
+}
+
+// Returns a page of events about an object, newest first.
+//
+// see https://docs.stripe.com/api/v2/core/events/list
+func (c V2EventClient) List(params *V2EventListParams) (*V2EventList, error) {
+	values := url.Values{"object_id": {params.ObjectID}}
+	if params.Limit > 0 {
+		values.Set("limit", strconv.Itoa(params.Limit))
+	}
+	if params.Page != "" {
+		values.Set("page", params.Page)
+	}
+	res := &V2EventList{}
+	return res, c.queryV2("GET", "/core/events", values, nil, res)
+}
+
+// Each calls fn with each event matching params, following the pages of
+// the list until it ends, starting with params.Page. An error returned by fn
+// stops the listing and is returned.
+func (c V2EventClient) Each(params *V2EventListParams, fn func(*ThinEvent) error) error {
+	p := *params
+	for {
+		page, err := c.List(&p)
+		if err != nil {
+			return err
+		}
+		for _, e := range page.Data {
+			if err := fn(e); err != nil {
+				return err
+			}
+		}
+		if p.Page = page.NextPage(); p.Page == "" {
+			return nil
+		}
+	}
+}
+
+// FetchRelatedObject retrieves the object the event is about, as it is now,
+// into the value pointed to by v, e.g. a *Customer. If the event occurred on
+// a connected account, and the client is not already for an account, the
+// object is fetched on behalf of that account.
+func (c V2EventClient) FetchRelatedObject(e *ThinEvent, v interface{}) error {
+	if e.RelatedObject == nil || e.RelatedObject.URL == "" {
+		return RelatedObjectMissingError
+	}
+	u, err := url.Parse(e.RelatedObject.URL)
+	if err != nil {
+		return err
+	}
+	if c.account == "" {
+		c.account = e.Context
+	}
+	if b := c.backendFor(); b != nil {
+		// Backends receive /v1 paths without their version prefix
+		return b.Call("GET", strings.TrimPrefix(u.Path, "/v1"), u.Query(), v)
+	}
+	return c.do(c.apiURL(), "GET", u.Path, u.Query(), v)
+}
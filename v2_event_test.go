@@ -0,0 +1,81 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestV2Events will test that /v2 events are listed across pages with the
+// /v2 Stripe-Version, and that their related objects are retrieved.
+func TestV2Events(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/customers/cus_1" && r.Header.Get("Stripe-Version") != apiVersionV2 {
+			t.Errorf("Expected /v2 requests to send version %s, got %s", apiVersionV2, r.Header.Get("Stripe-Version"))
+		}
+		switch r.URL.Path {
+		case "/v2/core/events":
+			if r.URL.Query().Get("object_id") != "cus_1" {
+				t.Errorf("Expected events about cus_1, got %v", r.URL.Query())
+			}
+			if r.URL.Query().Get("page") == "" {
+				w.Write([]byte(`{"data":[{"id":"evt_2","type":"v1.customer.updated","created":"2024-10-01T12:00:00.000Z",
+					"related_object":{"id":"cus_1","type":"customer","url":"/v1/customers/cus_1"}}],
+					"next_page_url":"/v2/core/events?object_id=cus_1&page=page_2"}`))
+				return
+			}
+			if r.URL.Query().Get("page") != "page_2" {
+				t.Errorf("Expected the second page, got %v", r.URL.Query())
+			}
+			w.Write([]byte(`{"data":[{"id":"evt_1","type":"v1.customer.created","created":"2024-10-01T11:00:00.000Z"}],"next_page_url":null}`))
+		case "/v2/core/echo":
+			var body map[string]string
+			if r.Header.Get("Content-Type") != "application/json" || json.NewDecoder(r.Body).Decode(&body) != nil || body["name"] != "Jenny" {
+				t.Errorf("Expected a JSON body, got %s", r.Header.Get("Content-Type"))
+			}
+			w.Write([]byte(`{}`))
+		case "/v1/customers/cus_1":
+			if account := r.Header.Get("Stripe-Account"); account != "acct_1" {
+				t.Errorf("Expected the object to be fetched on behalf of the event's account, got %q", account)
+			}
+			w.Write([]byte(`{"id":"cus_1","email":"jenny@example.com"}`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	events := V2Events.With(WithBaseURL(server.URL))
+	var ids []string
+	var updated *ThinEvent
+	err := events.Each(&V2EventListParams{ObjectID: "cus_1"}, func(e *ThinEvent) error {
+		ids = append(ids, e.ID)
+		if e.Type == "v1.customer.updated" {
+			updated = e
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 || ids[0] != "evt_2" || ids[1] != "evt_1" {
+		t.Fatalf("Expected events from both pages, got %v", ids)
+	}
+	if updated.Created.Hour() != 12 {
+		t.Errorf("Expected the RFC 3339 creation time to be decoded, got %v", updated.Created)
+	}
+
+	cust := &Customer{}
+	updated.Context = "acct_1"
+	if err := events.FetchRelatedObject(updated, cust); err != nil {
+		t.Fatal(err)
+	}
+	if cust.Email != "jenny@example.com" {
+		t.Errorf("Expected the related customer, got %+v", cust)
+	}
+
+	if err := events.queryV2("POST", "/core/echo", nil, map[string]string{"name": "Jenny"}, &struct{}{}); err != nil {
+		t.Fatal(err)
+	}
+}
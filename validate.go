@@ -0,0 +1,266 @@
+package stripe
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	RequiredParamError   = errors.New("stripe: parameter is required")
+	InvalidAmountError   = errors.New("stripe: amount must be between 1 and 99999999")
+	InvalidCurrencyError = errors.New("stripe: currency is not supported")
+	InvalidIntervalError = errors.New("stripe: interval must be day, week, month or year")
+	InvalidQuantityError = errors.New("stripe: quantity may not be negative")
+
+	InvalidApplicationFeeError = errors.New("stripe: application fee must be between 0 and the amount, or 0 and 100 percent")
+	TransferAmountWithFeeError = errors.New("stripe: transfer amount cannot be combined with an application fee")
+
+	InvalidDescriptorLengthError = errors.New("stripe: statement descriptor must be 5 to 22 characters")
+	InvalidDescriptorCharsError  = errors.New("stripe: statement descriptor must be Latin characters other than < > \\ ' \" *, including a letter")
+)
+
+// maxDescriptorLength is the number of characters of a statement descriptor
+// that fit on a card statement, including the prefix a suffix is added to.
+const maxDescriptorLength = 22
+
+// maxAmount is the largest amount Stripe accepts, in the smallest unit of the
+// currency.
+const maxAmount = 99999999
+
+// FieldError describes a parameter that failed validation.
+type FieldError struct {
+	// The name of the parameter, as Stripe names it in requests, e.g.
+	// "card[exp_month]".
+	Field string
+
+	// Why the parameter is invalid, e.g. RequiredParamError.
+	Err error
+}
+
+func (e *FieldError) Error() string {
+	return "stripe: invalid " + e.Field + ": " + strings.TrimPrefix(e.Err.Error(), "stripe: ")
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError is returned by the Validate methods of Params, and holds an
+// error for each of the parameters that are invalid. errors.Is reports
+// whether any of them is the target, e.g. ExpiredCardError.
+type ValidationError []*FieldError
+
+func (e ValidationError) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	msgs := make([]string, len(e))
+	for i, f := range e {
+		msgs[i] = strings.TrimPrefix(f.Error(), "stripe: ")
+	}
+	return fmt.Sprintf("stripe: %d invalid parameters: %s", len(e), strings.Join(msgs, "; "))
+}
+
+func (e ValidationError) Is(target error) bool {
+	for _, f := range e {
+		if errors.Is(f, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *ValidationError) add(field string, err error) {
+	*e = append(*e, &FieldError{Field: field, Err: err})
+}
+
+// err returns the ValidationError, or nil if no parameters are invalid.
+func (e ValidationError) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *ValidationError) checkAmount(field string, amount int64, zero bool) {
+	if amount > maxAmount || amount < 0 || (amount == 0 && !zero) {
+		e.add(field, InvalidAmountError)
+	}
+}
+
+// checkDescriptor adds an error if the statement descriptor is set but will
+// be rejected by Stripe: it must be Latin characters, other than the special
+// characters < > \ ' " *, and fit on the statement. A complete descriptor
+// must also be 5 characters or more with at least one letter, while a suffix
+// is added to the account's prefix, so the two together may not exceed 22.
+func (e *ValidationError) checkDescriptor(field string, descriptor *string, suffix bool) {
+	if descriptor == nil || *descriptor == "" {
+		return
+	}
+	letters := 0
+	for _, r := range *descriptor {
+		switch {
+		case r < ' ' || r > '~' && !unicode.Is(unicode.Latin, r) || strings.ContainsRune("<>\\'\"*", r):
+			e.add(field, InvalidDescriptorCharsError)
+			return
+		case unicode.IsLetter(r):
+			letters++
+		}
+	}
+	if n := utf8.RuneCountInString(*descriptor); n > maxDescriptorLength || (!suffix && n < 5) {
+		e.add(field, InvalidDescriptorLengthError)
+	} else if !suffix && letters == 0 {
+		e.add(field, InvalidDescriptorCharsError)
+	}
+}
+
+// checkDescriptors checks the statement descriptor and suffix of a payment
+// before it is made, returning a ValidationError if Stripe will reject them.
+func checkDescriptors(descriptor, suffix *string) error {
+	var errs ValidationError
+	errs.checkDescriptor("statement_descriptor", descriptor, false)
+	errs.checkDescriptor("statement_descriptor_suffix", suffix, true)
+	return errs.err()
+}
+
+func (e *ValidationError) checkCurrency(field string, c Currency) {
+	switch {
+	case c == "":
+		e.add(field, RequiredParamError)
+	case !c.Supported():
+		e.add(field, InvalidCurrencyError)
+	}
+}
+
+// Validate checks the card's details before they are sent, returning a
+// ValidationError for those that are invalid: a number that is malformed or
+// fails the Luhn check, an expiration date that is out of range or has
+// passed, or a security code that does not fit the card's brand. A card with
+// a number must also have an expiration date.
+func (c *CardParams) Validate() error {
+	var errs ValidationError
+	c.check(&errs, "")
+	return errs.err()
+}
+
+// check adds the errors in the card's details to errs, naming the fields
+// with the given prefix, e.g. "card".
+func (c *CardParams) check(errs *ValidationError, prefix string) {
+	field := func(name string) string {
+		if prefix == "" {
+			return name
+		}
+		return prefix + "[" + name + "]"
+	}
+	if c.Number != "" {
+		if number, err := ParseCardNumber(c.Number); err != nil {
+			errs.add(field("number"), err)
+		} else if valid, _ := IsLuhnValid(number); !valid {
+			errs.add(field("number"), InvalidCardNumberError)
+		}
+		if c.ExpMonth == 0 {
+			errs.add(field("exp_month"), RequiredParamError)
+		}
+		if c.ExpYear == 0 {
+			errs.add(field("exp_year"), RequiredParamError)
+		}
+	}
+	if c.ExpMonth != 0 && (c.ExpMonth < 1 || c.ExpMonth > 12) {
+		errs.add(field("exp_month"), InvalidExpMonthError)
+	} else if c.ExpYear != 0 {
+		month := c.ExpMonth
+		if month == 0 {
+			month = 12
+		}
+		if IsExpired(month, c.ExpYear, _clock.Now()) {
+			errs.add(field("exp_year"), ExpiredCardError)
+		}
+	}
+	if c.CVC != "" && !IsCVCValid(c.Number, c.CVC) {
+		errs.add(field("cvc"), InvalidCVCError)
+	}
+}
+
+// Validate checks the Charge's parameters before they are sent, returning a
+// ValidationError for those that are invalid: the amount must be positive
+// and the currency supported, and a Customer, Card or Token must be given to
+// charge. The Card's details are checked as by CardParams.Validate, and the
+// statement descriptor and its suffix must be accepted by Stripe, as must
+// any Level 3 data.
+func (p *ChargeParams) Validate() error {
+	var errs ValidationError
+	errs.checkAmount("amount", p.Amount, false)
+	errs.checkCurrency("currency", p.Currency)
+	errs.checkDescriptor("statement_descriptor", p.StatementDescriptor, false)
+	errs.checkDescriptor("statement_descriptor_suffix", p.StatementDescriptorSuffix, true)
+	errs.checkLevel3(p.Level3, p.Amount)
+	switch {
+	case p.Card != nil:
+		if p.Card.Number == "" {
+			errs.add("card[number]", RequiredParamError)
+		}
+		p.Card.check(&errs, "card")
+	case p.Token == nil && p.Customer == nil:
+		errs.add("customer", RequiredParamError)
+	}
+	return errs.err()
+}
+
+// Validate checks the Customer's parameters before they are sent, returning
+// a ValidationError for those that are invalid. The Card's details are
+// checked as by CardParams.Validate, and the balance must be within the
+// amounts Stripe accepts.
+func (p *CustomerParams) Validate() error {
+	var errs ValidationError
+	if p.Balance != nil && (*p.Balance > maxAmount || *p.Balance < -maxAmount) {
+		errs.add("account_balance", InvalidAmountError)
+	}
+	if p.Card != nil {
+		p.Card.check(&errs, "card")
+	}
+	return errs.err()
+}
+
+// Validate checks the Plan's parameters before they are created, returning a
+// ValidationError for those that are invalid: an ID, name, supported
+// currency and interval are required, and the amount may not be negative.
+func (p *PlanParams) Validate() error {
+	var errs ValidationError
+	if p.ID == "" {
+		errs.add("id", RequiredParamError)
+	}
+	if p.Name == "" {
+		errs.add("name", RequiredParamError)
+	}
+	errs.checkAmount("amount", p.Amount, true)
+	errs.checkCurrency("currency", p.Currency)
+	switch p.Interval {
+	case "":
+		errs.add("interval", RequiredParamError)
+	case "day", "week", "month", "year":
+	default:
+		errs.add("interval", InvalidIntervalError)
+	}
+	return errs.err()
+}
+
+// Validate checks the Subscription's parameters before they are sent,
+// returning a ValidationError for those that are invalid: the quantity may
+// not be negative, the application fee percentage must be between 0 and 100,
+// and the Card's details are checked as by CardParams.Validate.
+func (p *SubscriptionParams) Validate() error {
+	var errs ValidationError
+	if p.Quantity != nil && *p.Quantity < 0 {
+		errs.add("quantity", InvalidQuantityError)
+	}
+	if f := p.ApplicationFeePercent; f != nil && (*f < 0 || *f > 100) {
+		errs.add("application_fee_percent", InvalidApplicationFeeError)
+	}
+	if p.Token == nil && p.Card != nil {
+		p.Card.check(&errs, "card")
+	}
+	return errs.err()
+}
@@ -0,0 +1,105 @@
+package stripe
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	year := time.Now().Year()
+	params := &ChargeParams{
+		Amount:   0,
+		Currency: "xyz",
+		Card: &CardParams{
+			Number:   "4242 4242 4242 4241",
+			ExpMonth: 12,
+			ExpYear:  year - 1,
+			CVC:      "12",
+		},
+	}
+	err := params.Validate()
+	errs, ok := err.(ValidationError)
+	if !ok {
+		t.Fatalf("Expected ValidationError, got %v", err)
+	}
+	fields := map[string]error{}
+	for _, f := range errs {
+		fields[f.Field] = f.Err
+	}
+	want := map[string]error{
+		"amount":         InvalidAmountError,
+		"currency":       InvalidCurrencyError,
+		"card[number]":   InvalidCardNumberError,
+		"card[exp_year]": ExpiredCardError,
+		"card[cvc]":      InvalidCVCError,
+	}
+	for field, e := range want {
+		if fields[field] != e {
+			t.Errorf("Expected %s to fail with %v, got %v", field, e, fields[field])
+		}
+	}
+	if len(fields) != len(want) {
+		t.Errorf("Expected %d field errors, got %v", len(want), err)
+	}
+	if !errors.Is(err, ExpiredCardError) {
+		t.Errorf("Expected the ValidationError to match ExpiredCardError")
+	}
+
+	params = &ChargeParams{Amount: 400, Currency: USD, Card: &CardParams{
+		Number: "4242424242424242", ExpMonth: 12, ExpYear: year + 1, CVC: "123",
+	}}
+	if err := params.Validate(); err != nil {
+		t.Errorf("Expected valid Charge, got %v", err)
+	}
+	if err := (&ChargeParams{Amount: 400, Currency: USD}).Validate(); err == nil || err.Error() != "stripe: invalid customer: parameter is required" {
+		t.Errorf("Expected Charge without a source to require a customer, got %v", err)
+	}
+
+	if err := (&CardParams{Number: "4242424242424242"}).Validate(); len(err.(ValidationError)) != 2 {
+		t.Errorf("Expected a card number to require an expiration date, got %v", err)
+	}
+	if err := (&PlanParams{ID: "gold", Name: "Gold", Currency: USD, Interval: "month"}).Validate(); err != nil {
+		t.Errorf("Expected free Plan to be valid, got %v", err)
+	}
+	if err := (&PlanParams{ID: "gold", Name: "Gold", Currency: USD, Interval: "fortnight"}).Validate(); !errors.Is(err, InvalidIntervalError) {
+		t.Errorf("Expected InvalidIntervalError, got %v", err)
+	}
+	if err := (&SubscriptionParams{Quantity: Int64(-1)}).Validate(); !errors.Is(err, InvalidQuantityError) {
+		t.Errorf("Expected InvalidQuantityError, got %v", err)
+	}
+}
+
+// TestStatementDescriptors will test that statement descriptors Stripe would
+// reject fail before a request is made.
+func TestStatementDescriptors(t *testing.T) {
+	tests := []struct {
+		descriptor, suffix string
+		want               error
+	}{
+		{"ACME CORP", "ORDER 6735", nil},
+		{"Café Lumière", "", nil},
+		{"ACME", "", InvalidDescriptorLengthError},
+		{"ACME CORPORATION INTERNATIONAL", "", InvalidDescriptorLengthError},
+		{"12345", "", InvalidDescriptorCharsError},
+		{`ACME "CORP"`, "", InvalidDescriptorCharsError},
+		{"ACME CORP", "#1", nil},
+		{"ACME CORP", "ORDER*6735", InvalidDescriptorCharsError},
+		{"ACME CORP", "順序", InvalidDescriptorCharsError},
+	}
+	for _, test := range tests {
+		params := &ChargeParams{Amount: 400, Currency: USD, Customer: String("cus_1"), StatementDescriptor: String(test.descriptor), StatementDescriptorSuffix: String(test.suffix)}
+		err := params.Validate()
+		if test.want == nil && err != nil || test.want != nil && !errors.Is(err, test.want) {
+			t.Errorf("Expected %q and %q to return %v, got %v", test.descriptor, test.suffix, test.want, err)
+		}
+	}
+
+	_, err := PaymentIntents.Create(&PaymentIntentParams{Amount: 400, Currency: USD, StatementDescriptorSuffix: String("<ORDER>")})
+	if errs, ok := err.(ValidationError); !ok || errs[0].Field != "statement_descriptor_suffix" {
+		t.Errorf("Expected the suffix to be rejected before the request, got %v", err)
+	}
+	if _, err := Invoices.Create(&InvoiceParams{Customer: "cus_1", StatementDescriptor: String("ACME")}); !errors.Is(err, InvalidDescriptorLengthError) {
+		t.Errorf("Expected InvalidDescriptorLengthError, got %v", err)
+	}
+}
@@ -0,0 +1,156 @@
+package stripe
+
+import "reflect"
+
+// the Stripe-Version sent with requests that do not set their own, or
+// apiVersion if empty
+var _version string
+
+// SetAPIVersion will set the Stripe-Version sent with requests, pinning the
+// shape of the objects Stripe returns. Passing an empty string restores the
+// version the library was written against.
+//
+// Objects are decoded the same way under any version: where a version renamed
+// or replaced a field the library models, e.g. Invoice.Closed by
+// Invoice.Status, both fields are filled in from whichever one Stripe
+// returned, so code reading either keeps working after an upgrade.
+func SetAPIVersion(version string) {
+	_version = version
+}
+
+// APIVersion returns the Stripe-Version sent with requests.
+func APIVersion() string {
+	return (scope{}).versionFor()
+}
+
+// versionFor returns the Stripe-Version requests made with the scope are sent
+// with, which may have been set with WithHeader.
+func (s scope) versionFor() string {
+	switch {
+	case s.headers.Get("Stripe-Version") != "":
+		return s.headers.Get("Stripe-Version")
+	case s.version != "":
+		return s.version
+	case _version != "":
+		return _version
+	}
+	return apiVersion
+}
+
+// API versions that changed the shape of objects the library decodes.
+const (
+	// Cards name their brand in brand rather than type.
+	versionCardBrand = "2014-06-13"
+
+	// Charges hold the card charged in source rather than card.
+	versionChargeSource = "2015-02-18"
+
+	// Invoices have a status, and are no longer closed or forgiven.
+	versionInvoiceStatus = "2018-11-08"
+)
+
+// versionAtLeast reports whether version is since or later. Versions are
+// dated, optionally followed by a release name, e.g. "2024-09-30.acacia", so
+// their dates compare as strings.
+func versionAtLeast(version, since string) bool {
+	if len(version) > len(since) {
+		version = version[:len(since)]
+	}
+	return version >= since
+}
+
+// versionNormalizer is implemented by objects whose shape depends on the API
+// version they were returned under.
+type versionNormalizer interface {
+	// normalizeVersion fills in the fields the version did not return from
+	// those it did.
+	normalizeVersion(version string)
+}
+
+// normalizeVersion normalizes v, which was decoded from a response to a
+// request made with the given version. If v is a page of a list, each of its
+// objects is normalized instead.
+func normalizeVersion(v interface{}, version string) {
+	if n, ok := v.(versionNormalizer); ok {
+		n.normalizeVersion(version)
+		return
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return
+	}
+	data := rv.Elem().FieldByName("Data")
+	if !data.IsValid() || data.Kind() != reflect.Slice {
+		return
+	}
+	for i := 0; i < data.Len(); i++ {
+		item := data.Index(i)
+		if item.Kind() != reflect.Ptr {
+			item = item.Addr()
+		}
+		if n, ok := item.Interface().(versionNormalizer); ok && !item.IsNil() {
+			n.normalizeVersion(version)
+		}
+	}
+}
+
+func (c *Card) normalizeVersion(version string) {
+	if versionAtLeast(version, versionCardBrand) {
+		if c.Type == "" {
+			c.Type = c.Brand
+		}
+	} else if c.Brand == "" {
+		c.Brand = c.Type
+	}
+}
+
+func (c *Customer) normalizeVersion(version string) {
+	if c.Cards != nil {
+		for _, card := range c.Cards.Data {
+			card.normalizeVersion(version)
+		}
+	}
+}
+
+func (c *Charge) normalizeVersion(version string) {
+	if versionAtLeast(version, versionChargeSource) && c.Card == nil && c.Source != nil && c.Source.Object == "card" {
+		c.Card = c.Source
+	}
+	if c.Card == nil && c.PaymentMethodDetails != nil && c.PaymentMethodDetails.Card != nil {
+		d := c.PaymentMethodDetails.Card
+		c.Card = &Card{
+			Brand:       d.Brand,
+			ExpMonth:    d.ExpMonth,
+			ExpYear:     d.ExpYear,
+			Last4:       d.Last4,
+			Fingerprint: d.Fingerprint,
+			Country:     d.Country,
+		}
+	}
+	if c.Card != nil {
+		c.Card.normalizeVersion(version)
+	}
+}
+
+func (inv *Invoice) normalizeVersion(version string) {
+	if versionAtLeast(version, versionInvoiceStatus) {
+		switch inv.Status {
+		case InvoicePaid:
+			inv.Paid, inv.Closed = true, true
+		case InvoiceUncollectible, InvoiceVoid:
+			inv.Closed = true
+		}
+		return
+	}
+	if inv.Status == "" {
+		switch {
+		case inv.Paid:
+			inv.Status = InvoicePaid
+		case inv.Closed:
+			inv.Status = InvoiceUncollectible
+		default:
+			inv.Status = InvoiceOpen
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestVersionDecoding will test that objects are decoded into the same fields
+// whichever API version they were returned under.
+func TestVersionDecoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		old := r.Header.Get("Stripe-Version") == apiVersion
+		switch {
+		case r.URL.Path == "/v1/invoices/in_1" && old:
+			w.Write([]byte(`{"id":"in_1","closed":true,"paid":true}`))
+		case r.URL.Path == "/v1/invoices/in_1":
+			w.Write([]byte(`{"id":"in_1","status":"paid"}`))
+		case r.URL.Path == "/v1/charges/ch_1" && old:
+			w.Write([]byte(`{"id":"ch_1","card":{"id":"card_1","type":"Visa","last4":"4242"}}`))
+		case r.URL.Path == "/v1/charges/ch_1":
+			w.Write([]byte(`{"id":"ch_1","source":{"id":"card_1","object":"card","brand":"Visa","last4":"4242"}}`))
+		case r.URL.Path == "/v1/charges":
+			w.Write([]byte(`{"data":[{"id":"ch_2","payment_method_details":{"type":"card","card":{"brand":"visa","last4":"4242"}}}]}`))
+		}
+	}))
+	defer server.Close()
+	defer SetAPIVersion("")
+
+	for _, version := range []string{"", "2019-03-14", "2024-09-30.acacia"} {
+		SetAPIVersion(version)
+		inv, err := Invoices.With(WithBaseURL(server.URL)).Get("in_1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !inv.Paid || !inv.Closed || inv.Status != InvoicePaid {
+			t.Errorf("Expected a paid invoice under %q, got paid %v closed %v status %q", APIVersion(), inv.Paid, inv.Closed, inv.Status)
+		}
+
+		ch, err := Charges.With(WithBaseURL(server.URL)).Get("ch_1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ch.Card == nil || ch.Card.ID != "card_1" || ch.Card.Type != "Visa" || ch.Card.Brand != "Visa" {
+			t.Errorf("Expected the charged card under %q, got %+v", APIVersion(), ch.Card)
+		}
+	}
+
+	charges, _, err := Charges.With(WithBaseURL(server.URL)).List(10, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(charges) != 1 || charges[0].Card == nil || charges[0].Card.Last4 != "4242" {
+		t.Errorf("Expected the card from payment_method_details, got %+v", charges)
+	}
+}
+
+// TestVersionAtLeast will test comparing dated API versions.
+func TestVersionAtLeast(t *testing.T) {
+	tests := []struct {
+		version, since string
+		want           bool
+	}{
+		{"2014-03-28", "2015-02-18", false},
+		{"2015-02-18", "2015-02-18", true},
+		{"2024-09-30.acacia", "2018-11-08", true},
+		{"2018-11-07", "2018-11-08", false},
+	}
+	for _, test := range tests {
+		if got := versionAtLeast(test.version, test.since); got != test.want {
+			t.Errorf("Expected versionAtLeast(%q, %q) to be %v", test.version, test.since, test.want)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package stripe
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTolerance is the maximum age of a webhook signature timestamp that
+// will be accepted by ParseEvent.
+const DefaultTolerance = 300 * time.Second
+
+var (
+	SignatureMissingError = errors.New("stripe: webhook has no valid signature")
+	SignatureInvalidError = errors.New("stripe: webhook signature does not match payload")
+	SignatureExpiredError = errors.New("stripe: webhook timestamp is outside the tolerance zone")
+)
+
+// ParseEvent verifies the Stripe-Signature header of a webhook request using
+// the endpoint's signing secret and, if valid, parses the payload as an Event.
+// While a secret is being rotated, or to also accept the secret of stripe
+// listen in development, several secrets can be given, and a signature made
+// with any of them is accepted.
+//
+// see https://stripe.com/docs/webhooks#signatures
+func ParseEvent(payload []byte, header string, secrets ...string) (*Event, error) {
+	if err := VerifySignatureAny(payload, header, secrets, DefaultTolerance); err != nil {
+		return nil, err
+	}
+	event := Event{}
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// VerifySignature checks that the Stripe-Signature header contains a v1
+// signature of the payload made with the given secret, and that its timestamp
+// is no older than tolerance. A tolerance of zero disables the timestamp check.
+func VerifySignature(payload []byte, header, secret string, tolerance time.Duration) error {
+	return VerifySignatureAny(payload, header, []string{secret}, tolerance)
+}
+
+// VerifySignatureAny checks the Stripe-Signature header as VerifySignature
+// does, accepting a signature made with any of the given secrets. Empty
+// secrets are ignored.
+func VerifySignatureAny(payload []byte, header string, secrets []string, tolerance time.Duration) error {
+	ts, sigs, err := parseSignatureHeader(header)
+	if err != nil {
+		return err
+	}
+	if tolerance > 0 && _clock.Now().Sub(ts) > tolerance {
+		return SignatureExpiredError
+	}
+
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		expected := computeSignature(ts, payload, secret)
+		for _, sig := range sigs {
+			if hmac.Equal(expected, sig) {
+				return nil
+			}
+		}
+	}
+	return SignatureInvalidError
+}
+
+// parseSignatureHeader extracts the timestamp and v1 signatures from a
+// Stripe-Signature header of the form "t=1492774577,v1=5257a8...,v0=6ffbb5..."
+func parseSignatureHeader(header string) (time.Time, [][]byte, error) {
+	var ts time.Time
+	var sigs [][]byte
+	for _, pair := range strings.Split(header, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		switch parts[0] {
+		case "t":
+			i, err := strconv.ParseInt(parts[1], 10, 64)
+			if err != nil {
+				return ts, nil, SignatureMissingError
+			}
+			ts = time.Unix(i, 0)
+		case "v1":
+			sig, err := hex.DecodeString(parts[1])
+			if err != nil {
+				continue
+			}
+			sigs = append(sigs, sig)
+		}
+	}
+	if ts.IsZero() || len(sigs) == 0 {
+		return ts, nil, SignatureMissingError
+	}
+	return ts, sigs, nil
+}
+
+func computeSignature(t time.Time, payload []byte, secret string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(t.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,371 @@
+package stripe
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ProcessorNotStartedError is returned by a WebhookProcessor asked to enqueue
+// an event before it was started.
+var ProcessorNotStartedError = errors.New("stripe: webhook processor not started")
+
+// WebhookStore durably records events accepted by a WebhookProcessor until
+// their handler has completed, so that no event is lost if the process exits
+// while work is still in flight.
+type WebhookStore interface {
+	// Save records the event. It is called before the webhook request is
+	// acknowledged to Stripe, and may be called more than once for the same
+	// event ID.
+	Save(e *Event) error
+
+	// Ack forgets an event whose handler completed successfully.
+	Ack(id string) error
+
+	// Pending returns all events that were saved but never acknowledged.
+	Pending() ([]*Event, error)
+}
+
+// EventStore remembers which events have been handled, so that a
+// WebhookProcessor skips events Stripe delivers more than once. It should be
+// shared by every process receiving the endpoint's webhooks, e.g. by backing
+// it with Redis or SQL.
+type EventStore interface {
+	// Seen reports whether the event with the given ID was handled.
+	Seen(id string) (bool, error)
+
+	// MarkSeen records that the event with the given ID was handled.
+	MarkSeen(id string) error
+}
+
+// EventHandler processes a single event. Returning an error causes the event
+// to be retried.
+type EventHandler func(e *Event) error
+
+// WebhookProcessor verifies incoming webhooks, persists them to a WebhookStore
+// and responds to Stripe immediately, leaving a pool of workers to invoke the
+// registered handlers in the background. Events are retried with exponential
+// backoff until their handler succeeds or MaxAttempts is reached; events that
+// exhaust their attempts remain in the store and are retried on the next
+// Start, giving at-least-once delivery semantics.
+type WebhookProcessor struct {
+	// Endpoint signing secret used to verify incoming requests.
+	Secret string
+
+	// (Optional) Further secrets whose signatures are accepted, e.g. the
+	// secret being rotated away from, which stays valid for a while after
+	// rolling it, or that of stripe listen in development.
+	Secrets []string
+
+	// Number of concurrent workers. Defaults to 4, as do values below 1.
+	Workers int
+
+	// Maximum number of times a handler is invoked for an event before giving
+	// up. Defaults to 5, as do values below 1.
+	MaxAttempts int
+
+	// Delay before the first retry, doubled on every subsequent attempt.
+	// Defaults to one second.
+	Backoff time.Duration
+
+	// (Optional) Called when an event has exhausted its attempts.
+	OnFailure func(e *Event, err error)
+
+	// (Optional) Remembers handled events, so events delivered again are
+	// acknowledged without calling their handler. If it cannot be read, the
+	// event is handled anyway.
+	Events EventStore
+
+	store    WebhookStore
+	handlers map[string]EventHandler
+	queue    chan *Event
+	quit     chan struct{}
+	stop     sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewWebhookProcessor returns a WebhookProcessor that verifies requests with
+// the given secret and persists events to store.
+func NewWebhookProcessor(secret string, store WebhookStore) *WebhookProcessor {
+	return &WebhookProcessor{
+		Secret:      secret,
+		Workers:     4,
+		MaxAttempts: 5,
+		Backoff:     time.Second,
+		store:       store,
+		handlers:    make(map[string]EventHandler),
+	}
+}
+
+// Handle registers the handler for the given event type, e.g.
+// "invoice.payment_failed". The type "*" registers a handler for all events
+// that have no more specific handler. Handle must not be called after Start.
+func (p *WebhookProcessor) Handle(eventType string, h EventHandler) {
+	p.handlers[eventType] = h
+}
+
+// Start launches the workers and queues any events left pending in the store
+// from a previous run. A stopped processor may be started again.
+func (p *WebhookProcessor) Start() error {
+	pending, err := p.store.Pending()
+	if err != nil {
+		return err
+	}
+	if p.Workers < 1 {
+		p.Workers = 4
+	}
+	if p.MaxAttempts < 1 {
+		p.MaxAttempts = 5
+	}
+
+	p.queue = make(chan *Event, p.Workers*16+len(pending))
+	p.quit = make(chan struct{})
+	p.stop = sync.Once{}
+	for _, e := range pending {
+		p.queue <- e
+	}
+	for i := 0; i < p.Workers; i++ {
+		p.wg.Add(1)
+		go p.work()
+	}
+	return nil
+}
+
+// Stop signals the workers to exit and waits for in-flight handlers to return.
+// Queued events that were not yet processed remain in the store. Calling it
+// more than once, or before Start, has no effect.
+func (p *WebhookProcessor) Stop() {
+	if p.quit == nil {
+		return
+	}
+	p.stop.Do(func() { close(p.quit) })
+	p.wg.Wait()
+}
+
+// Enqueue persists a verified event and schedules it for processing. It
+// blocks if all workers are busy and the queue is full, and returns
+// ProcessorNotStartedError if the processor was not started.
+func (p *WebhookProcessor) Enqueue(e *Event) error {
+	if p.queue == nil {
+		return ProcessorNotStartedError
+	}
+	if err := p.store.Save(e); err != nil {
+		return err
+	}
+	select {
+	case p.queue <- e:
+	case <-p.quit:
+	}
+	return nil
+}
+
+// EventReplayParams selects the events Replay lists from Stripe.
+type EventReplayParams struct {
+	// (Optional) Only replay events created after the given time, e.g. when
+	// the webhook endpoint went down.
+	Since time.Time
+
+	// (Optional) Only replay events created after the event with the given
+	// ID, e.g. the last one the endpoint received.
+	After string
+
+	// (Optional) Only replay events of the given types, of which up to 20
+	// can be given. Wildcards such as "invoice.*" are not supported.
+	Types []string
+}
+
+// Replay lists the events selected by params with events, and enqueues them
+// in the order they were created, as though they were delivered by webhook,
+// to heal the gap left once the endpoint was down. It returns the number of
+// events enqueued, and must be called after Start. Stripe keeps events for 30
+// days. Events that were already handled are skipped if the processor has an
+// EventStore.
+func (p *WebhookProcessor) Replay(events EventClient, params *EventReplayParams) (int, error) {
+	list := &EventListParams{ListParams: ListParams{Limit: 100}, Types: params.Types}
+	if !params.Since.IsZero() {
+		since := NewUnixTime(params.Since)
+		list.Created = &DateRange{GT: &since}
+	}
+
+	// events are listed most recent first, so those after an event's ID are
+	// listed page by page towards the most recent with ending_before
+	var replay []*Event
+	list.Before = params.After
+	for {
+		page, more, err := events.List(list)
+		if err != nil {
+			return 0, err
+		}
+		if len(page) == 0 {
+			break
+		}
+		if params.After != "" {
+			list.Before = page[0].ID
+			reverseEvents(page)
+		} else {
+			list.After = page[len(page)-1].ID
+		}
+		replay = append(replay, page...)
+		if !more {
+			break
+		}
+	}
+
+	if params.After == "" {
+		reverseEvents(replay)
+	}
+
+	for i, e := range replay {
+		if err := p.Enqueue(e); err != nil {
+			return i, err
+		}
+	}
+	return len(replay), nil
+}
+
+func reverseEvents(events []*Event) {
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+}
+
+// ServeHTTP implements http.Handler so the processor can be mounted directly
+// as a webhook endpoint. It responds 400 to requests with an invalid
+// signature, 500 if the event could not be persisted or the processor was not
+// started, and 200 otherwise.
+func (p *WebhookProcessor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	payload, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	event, err := ParseEvent(payload, r.Header.Get("Stripe-Signature"), append([]string{p.Secret}, p.Secrets...)...)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := p.Enqueue(event); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (p *WebhookProcessor) work() {
+	defer p.wg.Done()
+	for {
+		select {
+		case e := <-p.queue:
+			p.process(e)
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+func (p *WebhookProcessor) process(e *Event) {
+	h, ok := p.handlers[e.Type]
+	if !ok {
+		h, ok = p.handlers["*"]
+	}
+	if !ok {
+		// nobody is interested in this event, so there is nothing to retry
+		p.store.Ack(e.ID)
+		return
+	}
+
+	if p.Events != nil {
+		if seen, err := p.Events.Seen(e.ID); err == nil && seen {
+			p.store.Ack(e.ID)
+			return
+		}
+	}
+
+	delay := p.Backoff
+	var err error
+	for attempt := 1; attempt <= p.MaxAttempts; attempt++ {
+		if err = h(e); err == nil {
+			if p.Events != nil {
+				p.Events.MarkSeen(e.ID)
+			}
+			p.store.Ack(e.ID)
+			return
+		}
+		if attempt == p.MaxAttempts {
+			break
+		}
+		select {
+		case <-_clock.After(delay):
+			delay *= 2
+		case <-p.quit:
+			return
+		}
+	}
+	if p.OnFailure != nil {
+		p.OnFailure(e, err)
+	}
+}
+
+// MemoryWebhookStore is a WebhookStore that keeps events in memory. It does
+// not survive restarts and is intended for development and testing.
+type MemoryWebhookStore struct {
+	mu     sync.Mutex
+	events map[string]*Event
+}
+
+// NewMemoryWebhookStore returns an empty MemoryWebhookStore.
+func NewMemoryWebhookStore() *MemoryWebhookStore {
+	return &MemoryWebhookStore{events: make(map[string]*Event)}
+}
+
+func (s *MemoryWebhookStore) Save(e *Event) error {
+	s.mu.Lock()
+	s.events[e.ID] = e
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *MemoryWebhookStore) Ack(id string) error {
+	s.mu.Lock()
+	delete(s.events, id)
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *MemoryWebhookStore) Pending() ([]*Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	events := make([]*Event, 0, len(s.events))
+	for _, e := range s.events {
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// MemoryEventStore is an EventStore that keeps event IDs in memory. It does
+// not survive restarts or deduplicate across processes, and is intended for
+// development and testing.
+type MemoryEventStore struct {
+	mu   sync.Mutex
+	seen map[string]bool
+}
+
+// NewMemoryEventStore returns an empty MemoryEventStore.
+func NewMemoryEventStore() *MemoryEventStore {
+	return &MemoryEventStore{seen: make(map[string]bool)}
+}
+
+func (s *MemoryEventStore) Seen(id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.seen[id], nil
+}
+
+func (s *MemoryEventStore) MarkSeen(id string) error {
+	s.mu.Lock()
+	s.seen[id] = true
+	s.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,399 @@
+package stripe
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "whsec_test_secret"
+
+var testPayload = []byte(`{"id":"evt_1","type":"charge.succeeded","data":{"object":{"id":"ch_1","amount":400}}}`)
+
+func signHeader(payload []byte, secret string, t time.Time) string {
+	sig := computeSignature(t, payload, secret)
+	return fmt.Sprintf("t=%d,v1=%s", t.Unix(), hex.EncodeToString(sig))
+}
+
+func TestParseEvent(t *testing.T) {
+	header := signHeader(testPayload, testSecret, time.Now())
+	event, err := ParseEvent(testPayload, header, testSecret)
+	if err != nil {
+		t.Fatalf("Expected valid signature, got Error %s", err.Error())
+	}
+	if event.ID != "evt_1" {
+		t.Errorf("Expected Event ID evt_1, got %s", event.ID)
+	}
+
+	charge := Charge{}
+	if err := event.Decode(&charge); err != nil {
+		t.Fatalf("Expected Charge, got Error %s", err.Error())
+	}
+	if charge.Amount != 400 {
+		t.Errorf("Expected Charge Amount 400, got %d", charge.Amount)
+	}
+}
+
+func TestVerifySignatureErrors(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		header string
+		want   error
+	}{
+		{"", SignatureMissingError},
+		{"t=abc,v1=00", SignatureMissingError},
+		{fmt.Sprintf("t=%d", now.Unix()), SignatureMissingError},
+		{signHeader(testPayload, "whsec_other", now), SignatureInvalidError},
+		{signHeader(testPayload, testSecret, now.Add(-time.Hour)), SignatureExpiredError},
+	}
+	for _, test := range tests {
+		err := VerifySignature(testPayload, test.header, testSecret, DefaultTolerance)
+		if err != test.want {
+			t.Errorf("header %q: got error [%v]; want [%v]", test.header, err, test.want)
+		}
+	}
+}
+
+// TestSigningSecretRotation will test that a signature made with any of
+// several secrets is accepted, by ParseEvent and by a WebhookProcessor.
+func TestSigningSecretRotation(t *testing.T) {
+	now := time.Now()
+	for _, secret := range []string{"whsec_old", testSecret, "whsec_cli"} {
+		if _, err := ParseEvent(testPayload, signHeader(testPayload, secret, now), testSecret, "whsec_old", "whsec_cli"); err != nil {
+			t.Errorf("Expected a signature made with %s to be accepted, got Error %s", secret, err.Error())
+		}
+	}
+	if _, err := ParseEvent(testPayload, signHeader(testPayload, "whsec_other", now), testSecret, "whsec_old"); err != SignatureInvalidError {
+		t.Errorf("Expected SignatureInvalidError, got %v", err)
+	}
+	if err := VerifySignatureAny(testPayload, signHeader(testPayload, "", now), []string{"", testSecret}, DefaultTolerance); err != SignatureInvalidError {
+		t.Errorf("Expected an empty secret to be ignored, got %v", err)
+	}
+
+	p := NewWebhookProcessor(testSecret, NewMemoryWebhookStore())
+	p.Secrets = []string{"whsec_old"}
+	p.Start()
+	defer p.Stop()
+	req := httptest.NewRequest("POST", "/webhook", bytes.NewReader(testPayload))
+	req.Header.Set("Stripe-Signature", signHeader(testPayload, "whsec_old", now))
+	w := httptest.NewRecorder()
+	p.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status 200 for the rotated secret, got %d", w.Code)
+	}
+}
+
+func TestWebhookProcessor(t *testing.T) {
+	store := NewMemoryWebhookStore()
+	p := NewWebhookProcessor(testSecret, store)
+	p.Backoff = time.Millisecond
+
+	done := make(chan int, 1)
+	attempts := 0
+	p.Handle("charge.succeeded", func(e *Event) error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("transient failure")
+		}
+		done <- attempts
+		return nil
+	})
+	if err := p.Start(); err != nil {
+		t.Fatalf("Expected Start, got Error %s", err.Error())
+	}
+	defer p.Stop()
+
+	req := httptest.NewRequest("POST", "/webhook", strings.NewReader(string(testPayload)))
+	req.Header.Set("Stripe-Signature", signHeader(testPayload, testSecret, time.Now()))
+	w := httptest.NewRecorder()
+	p.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", w.Code)
+	}
+
+	select {
+	case n := <-done:
+		if n != 3 {
+			t.Errorf("Expected 3 attempts, got %d", n)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected handler to succeed, timed out")
+	}
+
+	// the ack happens after the handler returns
+	time.Sleep(10 * time.Millisecond)
+	if pending, _ := store.Pending(); len(pending) != 0 {
+		t.Errorf("Expected no pending events, got %d", len(pending))
+	}
+}
+
+// TestWebhookProcessorDedupe will test that an event delivered twice is only
+// handled once when an EventStore is set.
+func TestWebhookProcessorDedupe(t *testing.T) {
+	store := NewMemoryWebhookStore()
+	p := NewWebhookProcessor(testSecret, store)
+	p.Events = NewMemoryEventStore()
+	p.Workers = 1
+
+	handled := make(chan string, 2)
+	p.Handle("*", func(e *Event) error {
+		handled <- e.ID
+		return nil
+	})
+	if err := p.Start(); err != nil {
+		t.Fatalf("Expected Start, got Error %s", err.Error())
+	}
+	defer p.Stop()
+
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest("POST", "/webhook", strings.NewReader(string(testPayload)))
+		req.Header.Set("Stripe-Signature", signHeader(testPayload, testSecret, time.Now()))
+		w := httptest.NewRecorder()
+		p.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d", w.Code)
+		}
+	}
+
+	<-handled
+	time.Sleep(10 * time.Millisecond)
+	select {
+	case id := <-handled:
+		t.Errorf("Expected the redelivered event to be skipped, %s was handled again", id)
+	default:
+	}
+	if pending, _ := store.Pending(); len(pending) != 0 {
+		t.Errorf("Expected no pending events, got %d", len(pending))
+	}
+}
+
+// TestWebhookProcessorReplay will test that events missed since a time, or
+// after an event, are listed page by page and handled in the order they were
+// created.
+func TestWebhookProcessorReplay(t *testing.T) {
+	listed := []string{"evt_5", "evt_4", "evt_3", "evt_2", "evt_1"}
+	var created string
+	backend := BackendFunc(func(method, path string, params url.Values, v interface{}) error {
+		created = params.Get("created[gt]")
+		// pages of two events, most recent first
+		page := listed
+		if before := params.Get("ending_before"); before != "" {
+			page = listed[:indexOf(listed, before)]
+			if len(page) > 2 {
+				page = page[len(page)-2:]
+			}
+		} else if after := params.Get("starting_after"); after != "" {
+			page = listed[indexOf(listed, after)+1:]
+		}
+		more := len(page) > 2 || (params.Get("ending_before") != "" && page[0] != listed[0])
+		if len(page) > 2 {
+			page = page[:2]
+		}
+		data, _ := json.Marshal(map[string]interface{}{"data": eventsWithIDs(page), "has_more": more})
+		return json.Unmarshal(data, v)
+	})
+
+	p := NewWebhookProcessor(testSecret, NewMemoryWebhookStore())
+	p.Workers = 1
+	handled := make(chan string, 10)
+	p.Handle("*", func(e *Event) error {
+		handled <- e.ID
+		return nil
+	})
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer p.Stop()
+
+	for _, test := range []struct {
+		params *EventReplayParams
+		want   []string
+	}{
+		{&EventReplayParams{After: "evt_1"}, []string{"evt_2", "evt_3", "evt_4", "evt_5"}},
+		{&EventReplayParams{Since: time.Unix(1700000000, 0)}, []string{"evt_1", "evt_2", "evt_3", "evt_4", "evt_5"}},
+	} {
+		n, err := p.Replay(Events.With(WithBackend(backend)), test.params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for i := 0; i < n; i++ {
+			got = append(got, <-handled)
+		}
+		if strings.Join(got, ",") != strings.Join(test.want, ",") {
+			t.Errorf("Expected events %v to be handled, got %v", test.want, got)
+		}
+	}
+	if created != "1700000000" {
+		t.Errorf("Expected events created since the time to be listed, got created[gt]=%s", created)
+	}
+}
+
+func indexOf(ids []string, id string) int {
+	for i, v := range ids {
+		if v == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func eventsWithIDs(ids []string) []*Event {
+	events := make([]*Event, len(ids))
+	for i, id := range ids {
+		events[i] = &Event{ID: id, Type: "invoice.paid"}
+	}
+	return events
+}
+
+func TestWebhookProcessorBadSignature(t *testing.T) {
+	p := NewWebhookProcessor(testSecret, NewMemoryWebhookStore())
+	req := httptest.NewRequest("POST", "/webhook", strings.NewReader(string(testPayload)))
+	req.Header.Set("Stripe-Signature", signHeader(testPayload, "whsec_other", time.Now()))
+	w := httptest.NewRecorder()
+	p.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", w.Code)
+	}
+}
+
+// TestWebhookProcessorNotStarted will test that webhooks delivered before the
+// processor is started are refused rather than blocking, and that it can be
+// stopped more than once.
+func TestWebhookProcessorNotStarted(t *testing.T) {
+	store := NewMemoryWebhookStore()
+	p := NewWebhookProcessor(testSecret, store)
+	p.Stop()
+
+	req := httptest.NewRequest("POST", "/webhook", strings.NewReader(string(testPayload)))
+	req.Header.Set("Stripe-Signature", signHeader(testPayload, testSecret, time.Now()))
+	w := httptest.NewRecorder()
+	p.ServeHTTP(w, req)
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status 500, got %d", w.Code)
+	}
+	if err := p.Enqueue(&Event{ID: "evt_1"}); err != ProcessorNotStartedError {
+		t.Errorf("Expected ProcessorNotStartedError, got %v", err)
+	}
+	if pending, _ := store.Pending(); len(pending) != 0 {
+		t.Errorf("Expected no events to be saved, got %d", len(pending))
+	}
+
+	handled := make(chan struct{}, 1)
+	p.MaxAttempts = 0
+	p.Handle("*", func(e *Event) error {
+		handled <- struct{}{}
+		return nil
+	})
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Enqueue(&Event{ID: "evt_1", Type: "charge.succeeded"}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-handled:
+	case <-time.After(time.Second):
+		t.Error("Expected the handler to be called with MaxAttempts unset")
+	}
+	p.Stop()
+	p.Stop()
+}
+
+func TestParseThinEvent(t *testing.T) {
+	payload := []byte(`{"id":"evt_test_1","object":"v2.core.event","type":"v1.billing.meter.no_meter_found","created":"2024-09-17T06:20:52.246Z","livemode":false,"related_object":{"id":"mtr_1","type":"billing.meter","url":"/v1/billing/meters/mtr_1"}}`)
+	header := signHeader(payload, testSecret, time.Now())
+	event, err := ParseThinEvent(payload, header, testSecret)
+	if err != nil {
+		t.Fatalf("Expected valid signature, got Error %s", err.Error())
+	}
+	if event.RelatedObject == nil || event.RelatedObject.ID != "mtr_1" {
+		t.Fatalf("Expected Related Object mtr_1, got %v", event.RelatedObject)
+	}
+	if event.Created.Year() != 2024 {
+		t.Errorf("Expected Created in 2024, got %v", event.Created)
+	}
+	if err := (&ThinEvent{}).FetchRelatedObject(nil); err != RelatedObjectMissingError {
+		t.Errorf("Expected RelatedObjectMissingError, got %v", err)
+	}
+}
+
+// TestWriteAuthorizationResponse will test that a real-time authorization
+// request is answered in the webhook response body.
+func TestWriteAuthorizationResponse(t *testing.T) {
+	payload := []byte(`{"id":"evt_1","type":"issuing_authorization.request","data":{"object":{"id":"iauth_1","pending_request":{"amount":500,"currency":"usd"}}}}`)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		event, err := ParseEvent(body, r.Header.Get("Stripe-Signature"), testSecret)
+		if err != nil || event.Type != EventIssuingAuthorizationRequest {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		auth := IssuingAuthorization{}
+		event.Decode(&auth)
+		WriteAuthorizationResponse(w, auth.PendingRequest.Amount <= 1000, 0)
+	})
+
+	req := httptest.NewRequest("POST", "/webhook", bytes.NewReader(payload))
+	req.Header.Set("Stripe-Signature", signHeader(payload, testSecret, time.Now()))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", rec.Code)
+	}
+	if body := strings.TrimSpace(rec.Body.String()); body != `{"approved":true}` {
+		t.Errorf("Expected approval body, got %s", body)
+	}
+}
+
+// TestWebhookProcessorRestart will test that a stopped processor can be
+// started and stopped again, handling the events left pending by the first
+// run.
+func TestWebhookProcessorRestart(t *testing.T) {
+	store := NewMemoryWebhookStore()
+	p := NewWebhookProcessor(testSecret, store)
+	handled := make(chan string, 1)
+	p.Handle("*", func(e *Event) error {
+		handled <- e.ID
+		return nil
+	})
+
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	p.Stop()
+	store.Save(&Event{ID: "evt_1", Type: "charge.succeeded"})
+
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case id := <-handled:
+		if id != "evt_1" {
+			t.Errorf("Expected evt_1 to be handled, got %s", id)
+		}
+	case <-time.After(time.Second):
+		t.Error("Expected the pending event to be handled after restarting")
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		p.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the restarted processor to stop, timed out")
+	}
+}
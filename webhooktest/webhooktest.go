@@ -0,0 +1,155 @@
+// Package webhooktest generates signed webhook payloads and delivers them to
+// handlers over httptest, so that handlers built on stripe.ParseEvent can be
+// unit tested end to end without sending real events from Stripe.
+package webhooktest
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cupcake/stripe"
+)
+
+// GenerateHeader returns a valid Stripe-Signature header for the payload,
+// signed with the given endpoint secret at the current time of the Clock set
+// with stripe.SetClock, so it is within the tolerance of stripe.ParseEvent
+// however the Clock is moved.
+func GenerateHeader(payload []byte, secret string) string {
+	return GenerateHeaderAt(payload, secret, stripe.Now())
+}
+
+// GenerateHeaderAt returns a valid Stripe-Signature header for the payload,
+// signed with the given endpoint secret at time t. Passing a time in the past
+// can be used to test that handlers reject stale signatures.
+func GenerateHeaderAt(payload []byte, secret string, t time.Time) string {
+	ts := t.Unix()
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", ts)
+	mac.Write(payload)
+	return fmt.Sprintf("t=%d,v1=%s", ts, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// NewRequest returns a POST request to url carrying the payload and a valid
+// Stripe-Signature header, suitable for passing to an http.Handler directly
+// or sending to an httptest.Server.
+func NewRequest(url string, payload []byte, secret string) (*http.Request, error) {
+	req, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Stripe-Signature", GenerateHeader(payload, secret))
+	return req, nil
+}
+
+// samples holds a representative object for each type of object events are
+// about, keyed by the event type prefix that identifies it.
+var samples = []struct {
+	prefix string
+	object string
+}{
+	{"customer.subscription.", `{"id":"sub_1","object":"subscription","customer":"cus_1","status":"active","plan":{"id":"gold","object":"plan","amount":2000,"currency":"usd","interval":"month","interval_count":1},"quantity":1,"start":1700000000,"current_period_start":1700000000,"current_period_end":1702592000,"cancel_at_period_end":false}`},
+	{"customer.source.", `{"id":"card_1","object":"card","customer":"cus_1","type":"Visa","last4":"4242","exp_month":12,"exp_year":2030}`},
+	{"customer.", `{"id":"cus_1","object":"customer","email":"jenny.rosen@example.com","created":1700000000,"currency":"usd","livemode":false}`},
+	{"charge.dispute.", `{"id":"dp_1","object":"dispute","charge":"ch_1","amount":2000,"currency":"usd","reason":"fraudulent","status":"needs_response","created":1700000000}`},
+	{"charge.", `{"id":"ch_1","object":"charge","amount":2000,"currency":"usd","customer":"cus_1","paid":true,"created":1700000000,"card":{"id":"card_1","object":"card","type":"Visa","last4":"4242","exp_month":12,"exp_year":2030},"livemode":false}`},
+	{"invoiceitem.", `{"id":"ii_1","object":"invoiceitem","customer":"cus_1","amount":2000,"currency":"usd","date":1700000000}`},
+	{"invoice.", `{"id":"in_1","object":"invoice","customer":"cus_1","charge":"ch_1","amount_due":2000,"subtotal":2000,"total":2000,"currency":"usd","paid":true,"attempted":true,"attempt_count":1,"date":1700000000,"period_start":1700000000,"period_end":1702592000,"livemode":false}`},
+	{"mandate.", `{"id":"mandate_1","object":"mandate","status":"active","type":"multi_use","payment_method":"pm_1","customer_acceptance":{"type":"offline","accepted_at":1700000000},"payment_method_details":{"type":"bacs_debit","bacs_debit":{"network_status":"accepted","reference":"REF-1","url":"https://pay.stripe.com/bacs_mandate/1"}},"livemode":false}`},
+	{"payout.", `{"id":"po_1","object":"payout","amount":2000,"currency":"usd","status":"paid","arrival_date":1700000000}`},
+	{"plan.", `{"id":"gold","object":"plan","amount":2000,"currency":"usd","interval":"month","interval_count":1}`},
+	{"transfer.", `{"id":"tr_1","object":"transfer","amount":2000,"currency":"usd","destination":"acct_1","created":1700000000}`},
+}
+
+// SampleEvent returns the payload of an event of the given type, e.g.
+// "invoice.paid", about a representative object of the type the event is
+// for. Event types whose objects are not known are sent with an object
+// holding only an ID.
+func SampleEvent(eventType string) []byte {
+	object := `{"id":"obj_1"}`
+	for _, s := range samples {
+		if strings.HasPrefix(eventType, s.prefix) {
+			object = s.object
+			break
+		}
+	}
+	return []byte(fmt.Sprintf(`{"id":"evt_%d","object":"event","type":%q,"created":%d,"livemode":false,"pending_webhooks":1,"data":{"object":%s}}`,
+		atomic.AddInt64(&eventIDs, 1), eventType, stripe.Now().Unix(), object))
+}
+
+var eventIDs int64
+
+// Server serves a webhook handler over httptest, so signed events can be
+// delivered to it as Stripe would send them.
+type Server struct {
+	*httptest.Server
+
+	// The endpoint secret events are signed with.
+	Secret string
+}
+
+// NewServer starts and returns a new Server delivering events signed with
+// the given endpoint secret to h. The caller should call Close when
+// finished, to shut it down.
+func NewServer(h http.Handler, secret string) *Server {
+	return &Server{Server: httptest.NewServer(h), Secret: secret}
+}
+
+// Send delivers a sample event of the given type to the handler, as returned
+// by SampleEvent, and returns the handler's response.
+func (s *Server) Send(eventType string) (*http.Response, error) {
+	return s.SendPayload(SampleEvent(eventType))
+}
+
+// SendPayload delivers the given event payload to the handler, signed with
+// the Server's secret, and returns the handler's response.
+func (s *Server) SendPayload(payload []byte) (*http.Response, error) {
+	req, err := NewRequest(s.URL, payload, s.Secret)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.Client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	return res, nil
+}
+
+// Expect delivers a sample event of the given type to the handler and fails
+// the test unless the handler responds with the given status code.
+func (s *Server) Expect(t testing.TB, eventType string, status int) {
+	t.Helper()
+	res, err := s.Send(eventType)
+	if err != nil {
+		t.Fatalf("Expected %s event to be delivered, got Error %s", eventType, err.Error())
+	}
+	if res.StatusCode != status {
+		t.Errorf("Expected status %d for %s event, got %d", status, eventType, res.StatusCode)
+	}
+}
+
+// ExpectStatus serves h, delivers a sample event of each of the given types
+// to it signed with secret, and fails the test unless the handler responds
+// to every one with the given status code:
+//
+//	webhooktest.ExpectStatus(t, handler, "whsec_test", http.StatusOK, "invoice.paid", "charge.refunded")
+func ExpectStatus(t testing.TB, h http.Handler, secret string, status int, eventTypes ...string) {
+	t.Helper()
+	s := NewServer(h, secret)
+	defer s.Close()
+	for _, eventType := range eventTypes {
+		s.Expect(t, eventType, status)
+	}
+}
@@ -0,0 +1,93 @@
+package webhooktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cupcake/stripe"
+	"github.com/cupcake/stripe/stripetest"
+)
+
+var payload = []byte(`{"id":"evt_1","type":"customer.created","data":{"object":{"id":"cus_1"}}}`)
+
+func TestGenerateHeader(t *testing.T) {
+	event, err := stripe.ParseEvent(payload, GenerateHeader(payload, "whsec_1"), "whsec_1")
+	if err != nil {
+		t.Fatalf("Expected valid signature, got Error %s", err.Error())
+	}
+	if event.Type != "customer.created" {
+		t.Errorf("Expected Event Type customer.created, got %s", event.Type)
+	}
+}
+
+// TestGenerateHeaderClock will test that headers are signed at the time of
+// the stripe package's Clock, so they verify once it is moved.
+func TestGenerateHeaderClock(t *testing.T) {
+	stripe.SetClock(stripetest.NewClock(time.Now().Add(48 * time.Hour)))
+	defer stripe.SetClock(nil)
+	if _, err := stripe.ParseEvent(payload, GenerateHeader(payload, "whsec_1"), "whsec_1"); err != nil {
+		t.Errorf("Expected a signature at the Clock's time to be valid, got %v", err)
+	}
+}
+
+func TestGenerateHeaderAt(t *testing.T) {
+	header := GenerateHeaderAt(payload, "whsec_1", time.Now().Add(-time.Hour))
+	err := stripe.VerifySignature(payload, header, "whsec_1", stripe.DefaultTolerance)
+	if err != stripe.SignatureExpiredError {
+		t.Errorf("Expected SignatureExpiredError, got %v", err)
+	}
+}
+
+func TestNewRequest(t *testing.T) {
+	req, err := NewRequest("http://localhost/webhook", payload, "whsec_1")
+	if err != nil {
+		t.Fatalf("Expected Request, got Error %s", err.Error())
+	}
+	if req.Header.Get("Stripe-Signature") == "" {
+		t.Errorf("Expected Stripe-Signature header to be set")
+	}
+}
+
+func TestExpectStatus(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, _ := ioutil.ReadAll(r.Body)
+		event, err := stripe.ParseEvent(payload, r.Header.Get("Stripe-Signature"), "whsec_1")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		invoice := stripe.Invoice{}
+		if err := event.Decode(&invoice); err != nil || invoice.Customer.ID() != "cus_1" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+	})
+	ExpectStatus(t, handler, "whsec_1", http.StatusOK, "invoice.paid", "invoice.payment_failed")
+
+	s := NewServer(handler, "whsec_2")
+	defer s.Close()
+	s.Expect(t, "invoice.paid", http.StatusBadRequest)
+}
+
+func TestSampleEvent(t *testing.T) {
+	event := stripe.Event{}
+	if err := json.Unmarshal(SampleEvent("customer.subscription.deleted"), &event); err != nil {
+		t.Fatalf("Expected Event, got Error %s", err.Error())
+	}
+	sub := stripe.Subscription{}
+	if err := event.Decode(&sub); err != nil || sub.Plan == nil || sub.Plan.ID != "gold" {
+		t.Errorf("Expected a Subscription to the gold plan, got %+v, %v", sub, err)
+	}
+	mandate := stripe.Mandate{}
+	json.Unmarshal(SampleEvent("mandate.updated"), &event)
+	if err := event.Decode(&mandate); err != nil || mandate.PaymentMethodDetails.BACSDebit.NetworkStatus != stripe.BACSMandateAccepted {
+		t.Errorf("Expected an accepted Bacs Mandate, got %+v, %v", mandate, err)
+	}
+	if other := SampleEvent("customer.subscription.deleted"); bytes.Equal(other, SampleEvent("customer.subscription.deleted")) {
+		t.Errorf("Expected sample events to have unique IDs")
+	}
+}
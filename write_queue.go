@@ -0,0 +1,199 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+)
+
+// QueuedWrite is a POST request to the Stripe API that can be held in a
+// WriteStore until Stripe is reachable.
+type QueuedWrite struct {
+	// The Idempotency-Key the write is sent with, so that replaying it more
+	// than once has no further effect. Generated if empty.
+	ID string `json:"id"`
+
+	// The path of the request, e.g. "/customers/cus_1".
+	Path string `json:"path"`
+
+	// The form parameters of the request.
+	Params url.Values `json:"params"`
+
+	// (Optional) The connected account the write is made on behalf of.
+	Account string `json:"account,omitempty"`
+
+	// When the write was first attempted.
+	Queued time.Time `json:"queued"`
+}
+
+// WriteStore durably records writes that could not be sent to Stripe until
+// they are replayed by a WriteQueue.
+type WriteStore interface {
+	// Save records the write. It may be called more than once for the same
+	// write ID.
+	Save(w *QueuedWrite) error
+
+	// Delete forgets a write that was replayed.
+	Delete(id string) error
+
+	// Pending returns all writes that were saved but not deleted.
+	Pending() ([]*QueuedWrite, error)
+}
+
+// WriteQueue sends writes that are not urgent, such as usage records and
+// metadata updates, and holds on to them while Stripe is unreachable:
+// writes that fail with a network error or a 5xx response are saved to a
+// WriteStore, and sent again by Replay with the same Idempotency-Key once
+// connectivity returns. Writes are sent in the order they were made, so once
+// one is queued, later writes are queued behind it.
+type WriteQueue struct {
+	// (Optional) Called when Stripe rejects a replayed write, e.g. because
+	// the object no longer exists. The write is then dropped.
+	OnFailure func(w *QueuedWrite, err error)
+
+	scope   scope
+	store   WriteStore
+	mu      sync.Mutex
+	checked bool
+	backlog bool
+}
+
+// NewWriteQueue returns a WriteQueue that saves writes to store, and sends
+// them with the key set with SetKey, as Client.NewWriteQueue does for a
+// Client.
+func NewWriteQueue(store WriteStore) *WriteQueue {
+	return (&Client{}).NewWriteQueue(store)
+}
+
+// NewWriteQueue returns a WriteQueue that saves writes to store, and sends
+// them with the Client's key and configuration.
+func (c *Client) NewWriteQueue(store WriteStore) *WriteQueue {
+	return &WriteQueue{scope: c.scope, store: store}
+}
+
+// Write sends the write to Stripe, or saves it for Replay if Stripe cannot be
+// reached or earlier writes are still queued, in which case queued is true.
+// Errors from Stripe, such as invalid parameters, are returned and the write
+// is not queued.
+func (q *WriteQueue) Write(w *QueuedWrite) (queued bool, err error) {
+	if w.ID == "" {
+		w.ID = newIdempotencyKey()
+	}
+	if w.Queued.IsZero() {
+		w.Queued = _clock.Now()
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.checked {
+		pending, err := q.store.Pending()
+		if err != nil {
+			return false, err
+		}
+		q.checked, q.backlog = true, len(pending) > 0
+	}
+	if !q.backlog {
+		if err := q.send(w); !unreachable(err) {
+			return false, err
+		}
+	}
+	if err := q.store.Save(w); err != nil {
+		return false, err
+	}
+	q.backlog = true
+	return true, nil
+}
+
+// Replay sends the queued writes in the order they were made, deleting each
+// once Stripe has accepted or rejected it, and returns how many were sent. It
+// stops at the first write that still cannot reach Stripe, returning its
+// error, so it can simply be called again later.
+func (q *WriteQueue) Replay() (int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	pending, err := q.store.Pending()
+	if err != nil {
+		return 0, err
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].Queued.Before(pending[j].Queued)
+	})
+
+	for n, w := range pending {
+		err := q.send(w)
+		if unreachable(err) {
+			return n, err
+		}
+		if err != nil && q.OnFailure != nil {
+			q.OnFailure(w, err)
+		}
+		if err := q.store.Delete(w.ID); err != nil {
+			return n, err
+		}
+	}
+	q.checked, q.backlog = true, false
+	return len(pending), nil
+}
+
+// send makes the write's request with its Idempotency-Key.
+func (q *WriteQueue) send(w *QueuedWrite) error {
+	var res json.RawMessage
+	s := q.scope
+	if w.Account != "" {
+		s.account = w.Account
+	}
+	s.idempotencyKey = w.ID
+	return s.query("POST", w.Path, w.Params, &res)
+}
+
+// unreachable reports whether a request failed with err because Stripe could
+// not be reached or had an error of its own, so it can be tried again later.
+func unreachable(err error) bool {
+	switch e := err.(type) {
+	case nil:
+		return false
+	case *Error:
+		return e.Code >= 500
+	case *url.Error:
+		return true
+	}
+	return false
+}
+
+// MemoryWriteStore is a WriteStore that keeps writes in memory. It does not
+// survive restarts and is intended for development and testing.
+type MemoryWriteStore struct {
+	mu     sync.Mutex
+	writes map[string]*QueuedWrite
+}
+
+// NewMemoryWriteStore returns an empty MemoryWriteStore.
+func NewMemoryWriteStore() *MemoryWriteStore {
+	return &MemoryWriteStore{writes: make(map[string]*QueuedWrite)}
+}
+
+func (s *MemoryWriteStore) Save(w *QueuedWrite) error {
+	s.mu.Lock()
+	s.writes[w.ID] = w
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *MemoryWriteStore) Delete(id string) error {
+	s.mu.Lock()
+	delete(s.writes, id)
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *MemoryWriteStore) Pending() ([]*QueuedWrite, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	writes := make([]*QueuedWrite, 0, len(s.writes))
+	for _, w := range s.writes {
+		writes = append(writes, w)
+	}
+	return writes, nil
+}
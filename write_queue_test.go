@@ -0,0 +1,70 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// TestWriteQueue will test that writes are queued while Stripe is down, are
+// replayed in order with their idempotency keys and the Client's key, and that
+// writes rejected by Stripe are dropped.
+func TestWriteQueue(t *testing.T) {
+	var down bool
+	var sent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "Bearer sk_test_queue" {
+			t.Errorf("Expected the write to be sent with the Client's key, got %s", auth)
+		}
+		if down {
+			w.WriteHeader(503)
+			w.Write([]byte(`{"error":{"type":"api_error","message":"Stripe is down"}}`))
+			return
+		}
+		r.ParseForm()
+		sent = append(sent, r.URL.Path+" "+r.Form.Get("metadata[step]")+" "+r.Header.Get("Idempotency-Key"))
+		if r.URL.Path == "/v1/customers/cus_deleted" {
+			w.WriteHeader(404)
+			w.Write([]byte(`{"error":{"type":"invalid_request_error","message":"No such customer"}}`))
+			return
+		}
+		w.Write([]byte(`{"id":"cus_1"}`))
+	}))
+	defer server.Close()
+
+	var failed []string
+	q := NewClient("sk_test_queue", WithBaseURL(server.URL)).NewWriteQueue(NewMemoryWriteStore())
+	q.OnFailure = func(w *QueuedWrite, err error) { failed = append(failed, w.Path) }
+
+	down = true
+	for i, path := range []string{"/customers/cus_1", "/customers/cus_deleted"} {
+		queued, err := q.Write(&QueuedWrite{ID: "key_" + string(rune('1'+i)), Path: path, Params: url.Values{"metadata[step]": {string(rune('1' + i))}}})
+		if !queued || err != nil {
+			t.Fatalf("Expected write to be queued while Stripe is down, got %v and %v", queued, err)
+		}
+	}
+	if n, err := q.Replay(); n != 0 || err == nil {
+		t.Errorf("Expected replay to stop while Stripe is down, got %d and %v", n, err)
+	}
+
+	down = false
+	queued, _ := q.Write(&QueuedWrite{ID: "key_3", Path: "/customers/cus_1", Params: url.Values{"metadata[step]": {"3"}}})
+	if !queued || len(sent) != 0 {
+		t.Errorf("Expected a write to be queued behind the backlog, got %v after %v", queued, sent)
+	}
+	if n, err := q.Replay(); n != 3 || err != nil {
+		t.Fatalf("Expected 3 writes replayed, got %d and %v", n, err)
+	}
+	want := []string{"/v1/customers/cus_1 1 key_1", "/v1/customers/cus_deleted 2 key_2", "/v1/customers/cus_1 3 key_3"}
+	if len(sent) != 3 || sent[0] != want[0] || sent[1] != want[1] || sent[2] != want[2] {
+		t.Errorf("Expected writes %v in order, got %v", want, sent)
+	}
+	if len(failed) != 1 || failed[0] != "/customers/cus_deleted" {
+		t.Errorf("Expected the write to the deleted customer to fail, got %v", failed)
+	}
+
+	if queued, err := q.Write(&QueuedWrite{Path: "/customers/cus_1"}); queued || err != nil {
+		t.Errorf("Expected the write to be sent once the backlog is replayed, got %v and %v", queued, err)
+	}
+}